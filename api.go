@@ -0,0 +1,8 @@
+// Package orchestraapi holds the OpenAPI description of the service, so the server can check
+// requests against the same document the clients are generated from.
+package orchestraapi
+
+import _ "embed"
+
+//go:embed api.yaml
+var Spec []byte
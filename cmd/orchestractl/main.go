@@ -0,0 +1,17 @@
+package main
+
+import (
+	"context"
+	"github.com/Ilya-Repin/orchestra_api/internal/ctl"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	code := ctl.Run(ctx, os.Args[1:], os.Stdout, os.Stderr)
+	stop()
+
+	os.Exit(code)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/Ilya-Repin/orchestra_api/internal/config"
 	"github.com/Ilya-Repin/orchestra_api/internal/infra/metrics"
 	"github.com/Ilya-Repin/orchestra_api/internal/infra/storage/postgres"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/tracing"
 	"log/slog"
 	"net/http"
 	"os"
@@ -15,8 +16,11 @@ import (
 	"time"
 )
 
+// version is set at build time with -ldflags "-X main.version=...".
+var version = "dev"
+
 const (
-	envLocal = "local"
+	envLocal = config.EnvLocal
 	envDev   = "dev"
 	envProd  = "prod"
 )
@@ -32,14 +36,35 @@ func main() {
 	)
 	log.Debug("debug messages are enabled")
 
-	db, err := postgres.InitDB(&cfg.StorageConfig)
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.TracingConfig)
+	if err != nil {
+		panic(err)
+	}
+
+	db, err := postgres.InitDB(log, &cfg.StorageConfig)
+	if err != nil {
+		panic(err)
+	}
+
+	replicaDB, err := postgres.InitReplica(&cfg.StorageConfig)
 	if err != nil {
 		panic(err)
 	}
 
 	appMetrics := metrics.New()
+	appMetrics.RegisterDB(db, "primary")
+	if replicaDB != nil {
+		appMetrics.RegisterDB(replicaDB, "replica")
+	}
+
+	application, err := app.NewApp(log, cfg, db, replicaDB, appMetrics, version)
+	if err != nil {
+		panic(err)
+	}
 
-	application := app.NewApp(log, db, appMetrics)
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	application.Start(jobsCtx)
 
 	srv := &http.Server{
 		Addr:         ":" + cfg.HTTPServerConfig.Port,
@@ -48,6 +73,13 @@ func main() {
 		IdleTimeout:  cfg.HTTPServerConfig.IdleTimeout,
 		Handler:      application.Routes(),
 	}
+	internalSrv := &http.Server{
+		Addr:         ":" + cfg.HTTPServerConfig.InternalPort,
+		ReadTimeout:  cfg.HTTPServerConfig.Timeout,
+		WriteTimeout: cfg.HTTPServerConfig.Timeout,
+		IdleTimeout:  cfg.HTTPServerConfig.IdleTimeout,
+		Handler:      application.InternalRoutes(),
+	}
 
 	idleConnsClosed := make(chan struct{})
 	go func() {
@@ -56,6 +88,10 @@ func main() {
 		<-sigint
 
 		log.Info("shutting down server...")
+		application.Drain()
+		time.Sleep(cfg.HTTPServerConfig.ShutdownDelay)
+
+		stopJobs()
 
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
@@ -63,10 +99,27 @@ func main() {
 		if err := srv.Shutdown(ctx); err != nil {
 			log.Error("server shutdown failed", slog.Any("error", err))
 		}
+		if err := internalSrv.Shutdown(ctx); err != nil {
+			log.Error("internal server shutdown failed", slog.Any("error", err))
+		}
+
+		application.Wait()
 
+		flushCtx, cancelFlush := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancelFlush()
+
+		if err := shutdownTracing(flushCtx); err != nil {
+			log.Error("failed to flush traces", slog.Any("error", err))
+		}
 		close(idleConnsClosed)
 	}()
 
+	go func() {
+		if err := internalSrv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			log.Error("internal server failed", slog.Any("error", err))
+		}
+	}()
+
 	if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
 		log.Error("server failed", slog.Any("error", err))
 	}
@@ -81,19 +134,19 @@ func setupLogger(env string) *slog.Logger {
 	switch env {
 	case envLocal:
 		log = slog.New(
-			slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+			tracing.NewLogHandler(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
 		)
 	case envDev:
 		log = slog.New(
-			slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+			tracing.NewLogHandler(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
 		)
 	case envProd:
 		log = slog.New(
-			slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo}),
+			tracing.NewLogHandler(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo})),
 		)
 	default:
 		log = slog.New(
-			slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo}),
+			tracing.NewLogHandler(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo})),
 		)
 	}
 
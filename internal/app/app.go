@@ -1,21 +1,50 @@
 package app
 
 import (
-	"database/sql"
-	"encoding/json"
+	"context"
+	"fmt"
+	orchestraapi "github.com/Ilya-Repin/orchestra_api"
+	"github.com/Ilya-Repin/orchestra_api/internal/config"
 	"github.com/Ilya-Repin/orchestra_api/internal/handler"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/i18n"
 	"github.com/Ilya-Repin/orchestra_api/internal/infra/metrics"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/notify"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/payment"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/queue"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/ratelimit"
 	"github.com/Ilya-Repin/orchestra_api/internal/infra/storage/postgres"
-	"github.com/Ilya-Repin/orchestra_api/internal/openapi"
+	"github.com/Ilya-Repin/orchestra_api/internal/service"
+	"github.com/Ilya-Repin/orchestra_api/internal/service/account"
+	"github.com/Ilya-Repin/orchestra_api/internal/service/analytics"
 	"github.com/Ilya-Repin/orchestra_api/internal/service/auxiliary"
 	"github.com/Ilya-Repin/orchestra_api/internal/service/events"
+	"github.com/Ilya-Repin/orchestra_api/internal/service/health"
+	"github.com/Ilya-Repin/orchestra_api/internal/service/jobs"
 	"github.com/Ilya-Repin/orchestra_api/internal/service/members"
+	"github.com/Ilya-Repin/orchestra_api/internal/service/membership"
 	"github.com/Ilya-Repin/orchestra_api/internal/service/registrations"
+	"github.com/Ilya-Repin/orchestra_api/internal/service/reminders"
+	"github.com/Ilya-Repin/orchestra_api/internal/service/verification"
+	"github.com/Ilya-Repin/orchestra_api/storage"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"log/slog"
 	"net/http"
+	"time"
+	_ "time/tzdata"
+)
+
+// Kinds of the background jobs.
+const (
+	jobExpireMemberships = "membership.expire"
+	jobProcessPayments   = "payments.process"
+	jobSendReminders     = "reminders.send"
+	jobCleanup           = "maintenance.cleanup"
+	jobRefreshAnalytics  = "analytics.refresh"
+	jobUpdateGauges      = "analytics.gauges"
+	jobReloadCatalog     = "i18n.reload"
 )
 
 type App struct {
@@ -24,37 +53,225 @@ type App struct {
 	eventService        *events.Service
 	registrationService *registrations.Service
 	auxService          *auxiliary.Service
+	verificationService *verification.Service
+	accountService      *account.Service
+	membershipService   *membership.Service
+	reminderService     *reminders.Service
+	jobsService         *jobs.Service
+	analyticsService    *analytics.Service
+	healthService       *health.Service
+	runner              *queue.Runner
+	replica             *postgres.Replica
+	jobsDone            chan struct{}
+	limiter             *handler.RateLimiter
+	idempotency         *handler.Idempotency
+	ifMatch             func(http.Handler) http.Handler
+	validator           *handler.RequestValidator
+	language            *handler.Language
+	reloadCatalog       func(ctx context.Context) error
+	requestMetrics      *handler.RequestMetrics
 	metrics             *metrics.Metrics
 }
 
-func NewApp(log *slog.Logger, db *sql.DB, appMetrics *metrics.Metrics) *App {
-	storage := postgres.New(db)
+func NewApp(log *slog.Logger, cfg *config.Config, db, replicaDB *pgxpool.Pool, appMetrics *metrics.Metrics, version string) (*App, error) {
+	schemaVersion, err := storage.LatestMigration()
+	if err != nil {
+		return nil, err
+	}
+
+	var replica *postgres.Replica
+	if replicaDB != nil {
+		replica = postgres.NewReplica(log, replicaDB, cfg.StorageConfig.Replica.CheckInterval)
+	}
+	storage := postgres.New(db, replica, appMetrics)
+
+	smsProvider, err := notify.NewSMSProvider(cfg.NotifyConfig.SMS, log)
+	if err != nil {
+		return nil, err
+	}
+	mailer := notify.NewMailer(cfg.NotifyConfig.SMTP, log)
+
+	payments, err := payment.NewProvider(cfg.PaymentsConfig, cfg.Env, log)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.VerificationConfig.Secret == "" && cfg.Env != config.EnvLocal {
+		return nil, service.ErrNoCodeSecret
+	}
+
+	reminderZone, err := time.LoadLocation(cfg.RemindersConfig.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	var limiterStore ratelimit.Store
+	switch cfg.RateLimitConfig.Backend {
+	case "", "postgres":
+		limiterStore = storage
+	case "memory":
+		limiterStore = ratelimit.NewMemory()
+	default:
+		return nil, fmt.Errorf("%q: %w", cfg.RateLimitConfig.Backend, ratelimit.ErrUnknownBackend)
+	}
+
+	limiter, err := handler.NewRateLimiter(log, limiterStore, cfg.RateLimitConfig, appMetrics)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := handler.NewRequestValidator(log, orchestraapi.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	catalog := i18n.NewCatalog()
+	if err := catalog.Load(context.Background(), storage); err != nil {
+		return nil, err
+	}
 
-	return &App{
+	reminderSender := reminders.NewNotifySender(log, mailer, smsProvider, catalog, reminderZone)
+	registrationService := registrations.New(log, storage, storage, storage, payments, reminderSender, cfg.PaymentsConfig)
+	memberService := members.New(log, storage, registrationService)
+	runner := queue.NewRunner(log, storage, cfg.JobsConfig, appMetrics)
+
+	a := &App{
 		log:                 log.With("component", "app"),
-		memberService:       members.New(log, storage),
-		eventService:        events.New(log, storage, storage),
-		registrationService: registrations.New(log, storage, storage),
+		memberService:       memberService,
+		eventService:        events.New(log, storage, storage, runner),
+		registrationService: registrationService,
 		auxService:          auxiliary.New(log, storage),
+		verificationService: verification.New(log, storage, mailer, smsProvider, catalog, cfg.VerificationConfig),
+		accountService:      account.New(log, storage, mailer, registrationService, catalog, cfg.VerificationConfig),
+		membershipService:   membership.New(log, storage, cfg.MembershipConfig),
+		reminderService:     reminders.New(log, storage, reminderSender, cfg.RemindersConfig),
+		jobsService:         jobs.New(log, storage, cfg.JobsConfig),
+		analyticsService:    analytics.New(log, storage, appMetrics),
+		healthService:       health.New(log, storage, cfg, version, schemaVersion),
+		runner:              runner,
+		replica:             replica,
+		limiter:             limiter,
+		idempotency:         handler.NewIdempotency(log, storage, cfg.IdempotencyConfig),
+		ifMatch:             handler.RequireIfMatch(cfg.HTTPServerConfig.RequireIfMatch),
+		validator:           validator,
+		language:            handler.NewLanguage(log, catalog, memberService, cfg.I18nConfig.MemberCacheTTL),
+		reloadCatalog:       func(ctx context.Context) error { return catalog.Load(ctx, storage) },
+		requestMetrics:      handler.NewRequestMetrics(appMetrics),
 		metrics:             appMetrics,
 	}
+
+	if err := a.registerJobs(cfg); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// registerJobs sets up the handlers of the background jobs and the schedules of the periodic
+// ones. The intervals come from the sections of the features; jobs.schedules overrides them.
+func (a *App) registerJobs(cfg *config.Config) error {
+	queue.HandleFunc(a.runner, jobExpireMemberships, a.membershipService.RunExpiryJob)
+	queue.HandleFunc(a.runner, jobProcessPayments, a.registrationService.RunPaymentJob)
+	queue.HandleFunc(a.runner, jobSendReminders, a.reminderService.RunReminderJob)
+	queue.HandleFunc(a.runner, jobCleanup, a.jobsService.RunCleanupJob)
+	queue.HandleFunc(a.runner, jobRefreshAnalytics, a.analyticsService.RunRefreshJob)
+	queue.HandleFunc(a.runner, jobUpdateGauges, a.analyticsService.RunGaugesJob)
+	queue.HandleFunc(a.runner, jobReloadCatalog, a.reloadCatalog)
+	queue.Handle(a.runner, events.JobSeatsBumped, a.registrationService.HandleSeatsBumped)
+	queue.Handle(a.runner, events.JobSeatsFreed, a.registrationService.HandleSeatsFreed)
+
+	schedules := map[string]string{
+		jobExpireMemberships: "@every " + cfg.MembershipConfig.ExpiryInterval.String(),
+		jobProcessPayments:   "@every " + cfg.PaymentsConfig.JobInterval.String(),
+		jobCleanup:           "@hourly",
+		jobRefreshAnalytics:  "@every " + cfg.AnalyticsConfig.RefreshInterval.String(),
+		jobUpdateGauges:      "@every " + cfg.AnalyticsConfig.GaugesInterval.String(),
+		jobReloadCatalog:     "@every " + cfg.I18nConfig.ReloadInterval.String(),
+	}
+	if len(cfg.RemindersConfig.Offsets) > 0 {
+		schedules[jobSendReminders] = "@every " + cfg.RemindersConfig.Interval.String()
+	}
+
+	for kind, spec := range schedules {
+		if err := a.runner.Schedule(kind, spec); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Start runs the background jobs, and the health checks of the read replica if there is one,
+// until ctx is cancelled.
+func (a *App) Start(ctx context.Context) {
+	a.jobsDone = make(chan struct{})
+	go func() {
+		defer close(a.jobsDone)
+		a.runner.Run(ctx)
+	}()
+
+	if a.replica != nil {
+		go a.replica.Monitor(ctx)
+	}
+}
+
+// Drain makes the replica report itself as not ready, so the balancer stops sending it traffic
+// before the server shuts down.
+func (a *App) Drain() {
+	a.healthService.Drain()
+}
+
+// Wait blocks until the background jobs started by Start have stopped.
+func (a *App) Wait() {
+	if a.jobsDone != nil {
+		<-a.jobsDone
+	}
+}
+
+// InternalRoutes serve the diagnostics of the replica on the internal listener, which only
+// the operators' network reaches.
+func (a *App) InternalRoutes() http.Handler {
+	r := chi.NewRouter()
+
+	r.Use(middleware.RequestID)
+	r.Use(middleware.Logger)
+	r.Use(middleware.Recoverer)
+
+	healthHandler := handler.NewHealthHandler(a.log, a.healthService)
+	r.Get("/debug/status", healthHandler.HandleGetStatus)
+
+	return r
 }
 
 func (a *App) Routes() http.Handler {
 	r := chi.NewRouter()
 
 	r.Use(middleware.RequestID)
+	r.Use(handler.Trace)
+	r.Use(a.requestMetrics.Middleware)
 	r.Use(middleware.Logger)
 	r.Use(middleware.Recoverer)
 
 	r.Handle("/metrics", promhttp.Handler())
 
+	healthHandler := handler.NewHealthHandler(a.log, a.healthService)
+	r.Get("/healthz", healthHandler.HandleLive)
+	r.Get("/readyz", healthHandler.HandleReady)
+
 	r.Route("/v1", func(r chi.Router) {
+		r.Use(a.language.Middleware)
+		r.Use(a.validator.Middleware)
+
 		r.Mount("/members", a.membersRoutes())
+		r.Mount("/me", a.meRoutes())
+		r.Mount("/memberships", a.membershipRoutes())
 		r.Mount("/events", a.eventsRoutes())
+		r.Mount("/payments", a.paymentRoutes())
 		r.Mount("/locations", a.locRoutes())
 		r.Mount("/types", a.eventTypeRoutes())
 		r.Mount("/info", a.infoRoutes())
+		r.Mount("/jobs", a.jobsRoutes())
+		r.Mount("/analytics", a.analyticsRoutes())
 	})
 
 	return r
@@ -64,26 +281,84 @@ func (a *App) membersRoutes() http.Handler {
 	r := chi.NewRouter()
 
 	membersHandler := handler.NewMembersHandler(a.log, a.memberService, a.metrics)
+	verificationHandler := handler.NewVerificationHandler(a.log, a.verificationService)
+	membershipHandler := handler.NewMembershipHandler(a.log, a.membershipService)
 
 	r.Get("/", membersHandler.HandleGetMembers)
-	r.Post("/", membersHandler.HandleCreateMember)
+	r.With(a.limiter.Limit("signup"), a.idempotency.Middleware).Post("/", membersHandler.HandleCreateMember)
+	r.Get("/export", membersHandler.HandleExportMembers)
+	r.With(a.idempotency.Middleware).Post("/import", membersHandler.HandleImportMembers)
 	r.Route("/{memberId}", func(r chi.Router) {
 		r.Get("/", membersHandler.HandleGetMember)
-		r.Put("/", membersHandler.HandleUpdateMemberProfile)
-		r.Patch("/", membersHandler.HandleUpdateMemberStatus)
-		r.Delete("/", membersHandler.HandleDeleteMember)
+		r.With(a.ifMatch).Put("/", membersHandler.HandleUpdateMemberProfile)
+		r.With(a.ifMatch).Patch("/", membersHandler.HandleUpdateMemberStatus)
+		r.With(a.ifMatch).Patch("/profile", membersHandler.HandlePatchMemberProfile)
+		r.With(a.ifMatch).Delete("/", membersHandler.HandleDeleteMember)
+		r.Get("/membership", membershipHandler.HandleGetMembership)
+		r.With(a.idempotency.Middleware).Post("/membership/payments", membershipHandler.HandleRecordPayment)
+		r.With(a.limiter.Limit("verification")).Post("/verification/{channel}", verificationHandler.HandleSendCode)
+		r.With(a.limiter.Limit("verification")).Post("/verification/{channel}/confirm", verificationHandler.HandleConfirmCode)
 	})
 
 	return r
 }
 
+// meRoutes serve the member identified by X-Member-Id. Profile changes go through the
+// member handler, so they follow the same rules as /members/{memberId}/profile.
+func (a *App) meRoutes() http.Handler {
+	r := chi.NewRouter()
+
+	accountHandler := handler.NewAccountHandler(a.log, a.accountService)
+	membersHandler := handler.NewMembersHandler(a.log, a.memberService, a.metrics)
+	membershipHandler := handler.NewMembershipHandler(a.log, a.membershipService)
+
+	r.Use(accountHandler.CurrentMember)
+
+	r.Get("/", accountHandler.HandleGetMe)
+	r.With(a.ifMatch).Patch("/", membersHandler.HandlePatchMemberProfile)
+	r.Get("/registrations", accountHandler.HandleGetMyRegistrations)
+	r.Get("/membership", membershipHandler.HandleGetMembership)
+	r.Put("/notifications", accountHandler.HandleSetNotifications)
+	r.With(a.limiter.Limit("verification")).Post("/export", accountHandler.HandleRequestExport)
+	r.With(a.limiter.Limit("verification")).Post("/export/confirm", accountHandler.HandleExportMyData)
+	r.With(a.limiter.Limit("verification")).Post("/erasure", accountHandler.HandleRequestErasure)
+	r.With(a.limiter.Limit("verification")).Post("/erasure/confirm", accountHandler.HandleConfirmErasure)
+
+	return r
+}
+
+func (a *App) membershipRoutes() http.Handler {
+	r := chi.NewRouter()
+
+	membershipHandler := handler.NewMembershipHandler(a.log, a.membershipService)
+
+	r.With(a.idempotency.Middleware).Post("/payments/import", membershipHandler.HandleImportPayments)
+	r.Get("/renewals", membershipHandler.HandleGetRenewals)
+	r.Get("/renewals/export", membershipHandler.HandleExportRenewals)
+
+	return r
+}
+
+// paymentRoutes receive provider webhooks. They are neither rate limited nor idempotent by
+// key: the provider retries on its own and repeated notifications are recognised by content.
+func (a *App) paymentRoutes() http.Handler {
+	r := chi.NewRouter()
+
+	paymentsHandler := handler.NewPaymentsHandler(a.log, a.registrationService)
+
+	r.Post("/webhook", paymentsHandler.HandleWebhook)
+
+	return r
+}
+
 func (a *App) locRoutes() http.Handler {
 	r := chi.NewRouter()
 
-	auxHandler := handler.NewAuxHandler(a.log, a.auxService, a.metrics)
+	auxHandler := handler.NewAuxHandler(a.log, a.auxService)
 
 	r.Get("/", auxHandler.HandleGetLocations)
-	r.Post("/", auxHandler.HandleCreateLocation)
+	r.With(a.idempotency.Middleware).Post("/", auxHandler.HandleCreateLocation)
+	r.Put("/{locationId}/translations/{lang}", auxHandler.HandleSetLocationTranslation)
 
 	return r
 }
@@ -91,10 +366,11 @@ func (a *App) locRoutes() http.Handler {
 func (a *App) eventTypeRoutes() http.Handler {
 	r := chi.NewRouter()
 
-	auxHandler := handler.NewAuxHandler(a.log, a.auxService, a.metrics)
+	auxHandler := handler.NewAuxHandler(a.log, a.auxService)
 
 	r.Get("/", auxHandler.HandleGetEventTypes)
-	r.Post("/", auxHandler.HandleCreateEventType)
+	r.With(a.idempotency.Middleware).Post("/", auxHandler.HandleCreateEventType)
+	r.Put("/{typeId}/translations/{lang}", auxHandler.HandleSetEventTypeTranslation)
 
 	return r
 }
@@ -102,9 +378,39 @@ func (a *App) eventTypeRoutes() http.Handler {
 func (a *App) infoRoutes() http.Handler {
 	r := chi.NewRouter()
 
-	auxHandler := handler.NewAuxHandler(a.log, a.auxService, a.metrics)
+	auxHandler := handler.NewAuxHandler(a.log, a.auxService)
+
+	r.Get("/{key}", auxHandler.HandleGetOrchestraInfo)
+	r.Put("/{key}", auxHandler.HandleSetOrchestraInfo)
+
+	return r
+}
+
+// jobsRoutes let an administrator look into the background job queue and retry dead jobs.
+func (a *App) jobsRoutes() http.Handler {
+	r := chi.NewRouter()
+
+	jobsHandler := handler.NewJobsHandler(a.log, a.jobsService)
 
-	r.Get("/", auxHandler.HandleGetOrchestraInfo)
+	r.Get("/", jobsHandler.HandleGetJobs)
+	r.Post("/{jobId}/retry", jobsHandler.HandleRetryJob)
+
+	return r
+}
+
+// analyticsRoutes report on events and the membership over a date range.
+func (a *App) analyticsRoutes() http.Handler {
+	r := chi.NewRouter()
+
+	analyticsHandler := handler.NewAnalyticsHandler(a.log, a.analyticsService)
+
+	r.Get("/summary", analyticsHandler.HandleGetSummary)
+	r.Get("/events", analyticsHandler.HandleGetEventStats)
+	r.Get("/event-types", analyticsHandler.HandleGetEventTypeStats)
+	r.Get("/locations", analyticsHandler.HandleGetLocationStats)
+	r.Get("/members/growth", analyticsHandler.HandleGetMemberGrowth)
+	r.Get("/members/funnel", analyticsHandler.HandleGetMemberFunnel)
+	r.Get("/members/top", analyticsHandler.HandleGetActiveMembers)
 
 	return r
 }
@@ -112,38 +418,34 @@ func (a *App) infoRoutes() http.Handler {
 func (a *App) eventsRoutes() http.Handler {
 	r := chi.NewRouter()
 
-	eventsHandler := handler.NewEventsHandler(a.log, a.eventService, a.metrics)
+	eventsHandler := handler.NewEventsHandler(a.log, a.eventService)
 	registrationHandler := handler.NewRegistrationsHandler(a.log, a.registrationService, a.metrics)
 
 	r.Get("/", eventsHandler.HandleGetEvents)
-	r.Post("/", eventsHandler.HandleCreateEvent)
+	r.With(a.idempotency.Middleware).Post("/", eventsHandler.HandleCreateEvent)
+	r.Get("/export", eventsHandler.HandleExportEvents)
 	r.Get("/upcoming", eventsHandler.HandleGetUpcomingEvents)
 	r.Get("/available", eventsHandler.HandleGetAvailableEvents)
 	r.Get("/registered", eventsHandler.HandleGetRegisteredEvents)
 	//r.Post("/", a.handleCreateMember)
 	r.Route("/{eventId}", func(r chi.Router) {
 		r.Get("/", eventsHandler.HandleGetEvent)
-		r.Put("/", eventsHandler.HandleUpdateEvent)
-		r.Delete("/", eventsHandler.HandleDeleteEvent)
+		r.With(a.ifMatch).Put("/", eventsHandler.HandleUpdateEvent)
+		r.With(a.ifMatch).Patch("/", eventsHandler.HandlePatchEvent)
+		r.With(a.ifMatch).Delete("/", eventsHandler.HandleDeleteEvent)
+		r.With(a.ifMatch).Put("/access", eventsHandler.HandleSetEventAccess)
+		r.With(a.ifMatch).Put("/price", eventsHandler.HandleSetEventPrice)
+		r.With(a.ifMatch).Put("/capacity", eventsHandler.HandleSetEventCapacity)
+		r.Get("/registrations", registrationHandler.HandleGetRoster)
+		r.Get("/registrations/export", registrationHandler.HandleExportRoster)
+		r.Put("/attendance", registrationHandler.HandleRecordAttendance)
 		r.Route("/registration", func(r chi.Router) {
 			r.Get("/", registrationHandler.HandleCheckRegistration)
-			r.Post("/", registrationHandler.HandleRegister)
-			r.Delete("/", registrationHandler.HandleCancel)
+			r.With(a.limiter.Limit("registration"), a.idempotency.Middleware).Post("/", registrationHandler.HandleRegister)
+			r.With(a.limiter.Limit("registration")).Delete("/", registrationHandler.HandleCancel)
 
 		})
 	})
 
 	return r
 }
-
-func writeError(w http.ResponseWriter, code int, message string) {
-	writeJSON(w, code, openapi.ErrorResponse{Message: &message})
-}
-
-func writeJSON(w http.ResponseWriter, code int, v interface{}) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(code)
-	if err := json.NewEncoder(w).Encode(v); err != nil {
-		writeError(w, http.StatusInternalServerError, err.Error())
-	}
-}
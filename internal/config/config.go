@@ -8,26 +8,241 @@ import (
 	"github.com/ilyakaznacheev/cleanenv"
 )
 
+// EnvLocal is the environment of a developer machine. Only there the fakes of external
+// services may stand in by default and webhooks may come unsigned.
+const EnvLocal = "local"
+
 type Config struct {
-	Env              string `yaml:"env" env-default:"local"`
-	StorageConfig    `yaml:"storage"`
-	HTTPServerConfig `yaml:"http_server"`
+	Env                string `yaml:"env" env-default:"local"`
+	StorageConfig      `yaml:"storage"`
+	HTTPServerConfig   `yaml:"http_server"`
+	NotifyConfig       `yaml:"notify"`
+	VerificationConfig `yaml:"verification"`
+	RateLimitConfig    `yaml:"rate_limit"`
+	IdempotencyConfig  `yaml:"idempotency"`
+	MembershipConfig   `yaml:"membership"`
+	PaymentsConfig     `yaml:"payments"`
+	RemindersConfig    `yaml:"reminders"`
+	JobsConfig         `yaml:"jobs"`
+	AnalyticsConfig    `yaml:"analytics"`
+	I18nConfig         `yaml:"i18n"`
+	TracingConfig      `yaml:"tracing"`
+	HealthConfig       `yaml:"health"`
 }
 
+// StorageConfig points at the primary database. URL, a postgres:// URL, takes precedence over
+// the separate parts. The password is read from PasswordFile when set and from Password, which
+// DB_PASSWORD overrides, otherwise; it is filled into a URL that carries none. The pool keeps
+// between MinConns and MaxConns connections; a connection is closed after ConnMaxLifetime, or
+// after ConnMaxIdleTime unused. Each connection prepares the statements it runs and keeps up to
+// StatementCacheCapacity of them for reuse. At startup the database is pinged up to
+// ConnectAttempts times, waiting ConnectBackoff after the first failure and doubling the wait up
+// to ConnectMaxBackoff.
 type StorageConfig struct {
-	Driver   string `yaml:"driver"`
-	Host     string `yaml:"host"`
-	Port     int    `yaml:"port"`
-	Dbname   string `yaml:"dbname"`
-	User     string `yaml:"user"`
-	Password string `yaml:"password"`
-	Sslmode  string `yaml:"sslmode"`
+	Driver                 string        `yaml:"driver"`
+	URL                    string        `yaml:"url" env:"DATABASE_URL"`
+	Host                   string        `yaml:"host"`
+	Port                   int           `yaml:"port" env-default:"5432"`
+	Dbname                 string        `yaml:"dbname"`
+	User                   string        `yaml:"user"`
+	Password               string        `yaml:"password" env:"DB_PASSWORD"`
+	PasswordFile           string        `yaml:"password_file" env:"DB_PASSWORD_FILE"`
+	Sslmode                string        `yaml:"sslmode"`
+	MaxConns               int           `yaml:"max_conns" env-default:"25"`
+	MinConns               int           `yaml:"min_conns" env-default:"2"`
+	ConnMaxLifetime        time.Duration `yaml:"conn_max_lifetime" env-default:"30m"`
+	ConnMaxIdleTime        time.Duration `yaml:"conn_max_idle_time" env-default:"5m"`
+	StatementCacheCapacity int           `yaml:"statement_cache_capacity" env-default:"512"`
+	ConnectAttempts        int           `yaml:"connect_attempts" env-default:"10"`
+	ConnectBackoff         time.Duration `yaml:"connect_backoff" env-default:"500ms"`
+	ConnectMaxBackoff      time.Duration `yaml:"connect_max_backoff" env-default:"10s"`
+	Replica                ReplicaConfig `yaml:"replica"`
+}
+
+// ReplicaConfig points at an optional read replica, by URL or by Host and Port with the
+// credentials, database and pool settings of the primary. Reads of the Get* family go to the
+// replica while it answers a ping every CheckInterval and to the primary otherwise; with
+// replication lag they may miss the latest writes.
+type ReplicaConfig struct {
+	URL           string        `yaml:"url" env:"DATABASE_REPLICA_URL"`
+	Host          string        `yaml:"host"`
+	Port          int           `yaml:"port" env-default:"5432"`
+	CheckInterval time.Duration `yaml:"check_interval" env-default:"5s"`
+}
+
+// Enabled tells whether a replica is configured.
+func (c ReplicaConfig) Enabled() bool {
+	return c.URL != "" || c.Host != ""
 }
 
+// HTTPServerConfig sets up the server. On shutdown the replica reports itself as not ready
+// for ShutdownDelay before it stops accepting connections, so the balancer can move away.
+// RequireIfMatch makes writes of versioned resources without If-Match fail with 428; unset,
+// they overwrite whatever version is current. InternalPort serves the diagnostics that must
+// not be reachable through the balancer.
 type HTTPServerConfig struct {
-	Port        string        `yaml:"port" env-default:"8080"`
-	Timeout     time.Duration `yaml:"timeout" env-default:"4s"`
-	IdleTimeout time.Duration `yaml:"idle_timeout" env-default:"60s"`
+	Port           string        `yaml:"port" env-default:"8080"`
+	InternalPort   string        `yaml:"internal_port" env-default:"8090"`
+	Timeout        time.Duration `yaml:"timeout" env-default:"4s"`
+	IdleTimeout    time.Duration `yaml:"idle_timeout" env-default:"60s"`
+	ShutdownDelay  time.Duration `yaml:"shutdown_delay" env-default:"3s"`
+	RequireIfMatch bool          `yaml:"require_if_match" env:"REQUIRE_IF_MATCH" env-default:"true"`
+}
+
+type NotifyConfig struct {
+	SMTP SMTPConfig `yaml:"smtp"`
+	SMS  SMSConfig  `yaml:"sms"`
+}
+
+// SMTPConfig configures outgoing mail. With an empty host mail is only logged. Sending a
+// message takes at most Timeout, so a stalled server can not hold up its caller.
+type SMTPConfig struct {
+	Host     string        `yaml:"host"`
+	Port     int           `yaml:"port" env-default:"587"`
+	Username string        `yaml:"username"`
+	Password string        `yaml:"password"`
+	From     string        `yaml:"from"`
+	TLS      bool          `yaml:"tls"`
+	Timeout  time.Duration `yaml:"timeout" env-default:"30s"`
+}
+
+// SMSConfig selects the sms provider: "fake" only logs messages, "smsru" sends them through sms.ru.
+type SMSConfig struct {
+	Provider string `yaml:"provider" env-default:"fake"`
+	APIKey   string `yaml:"api_key"`
+	Sender   string `yaml:"sender"`
+}
+
+// VerificationConfig limits the one-time codes sent to members. Codes are stored as an HMAC
+// under Secret, which must be set outside the local environment.
+type VerificationConfig struct {
+	CodeTTL     time.Duration `yaml:"code_ttl" env-default:"15m"`
+	MaxAttempts int           `yaml:"max_attempts" env-default:"5"`
+	ResendAfter time.Duration `yaml:"resend_after" env-default:"1m"`
+	Secret      string        `yaml:"secret" env:"VERIFICATION_SECRET"`
+}
+
+// RateLimitConfig holds token buckets per route group. Backend "postgres" shares the
+// buckets between replicas, "memory" keeps them in the process. The X-Real-IP header is
+// taken as the client address only from peers within TrustedProxies, a list of CIDRs
+// such as "172.16.0.0/12".
+type RateLimitConfig struct {
+	Enabled        bool                      `yaml:"enabled"`
+	Backend        string                    `yaml:"backend" env-default:"postgres"`
+	TrustedProxies []string                  `yaml:"trusted_proxies"`
+	Groups         map[string]RateLimitGroup `yaml:"groups"`
+}
+
+type RateLimitGroup struct {
+	PerIP     BucketConfig `yaml:"per_ip"`
+	PerMember BucketConfig `yaml:"per_member"`
+}
+
+// BucketConfig allows Requests per Period with bursts of up to Burst requests.
+// A bucket with zero Requests is not checked.
+type BucketConfig struct {
+	Requests int           `yaml:"requests"`
+	Period   time.Duration `yaml:"period"`
+	Burst    int           `yaml:"burst"`
+}
+
+// IdempotencyConfig keeps the responses to requests with an Idempotency-Key for TTL. A
+// request holds its key for Lease; a key left without a response past it, by a replica that
+// went down mid-request, is taken over by the next request with it.
+type IdempotencyConfig struct {
+	TTL   time.Duration `yaml:"ttl" env-default:"24h"`
+	Lease time.Duration `yaml:"lease" env-default:"30s"`
+}
+
+// MembershipConfig controls the membership expiry job and the renewal report: members whose
+// membership ends within RenewalWindow are reported as due for renewal.
+type MembershipConfig struct {
+	ExpiryInterval time.Duration `yaml:"expiry_interval" env-default:"1h"`
+	RenewalWindow  time.Duration `yaml:"renewal_window" env-default:"720h"`
+}
+
+// PaymentsConfig selects the payment provider for paid events: "fake" creates payments locally
+// and waits for webhooks, "yookassa" goes through the YooKassa api. Without a provider the fake
+// is used, in the local environment only. Webhooks must carry an HMAC-SHA256 signature made
+// with WebhookSecret, which is required outside the local environment. Unpaid seats are
+// released after HoldFor; a cancelled registration is refunded if the event is more than
+// RefundBefore away.
+type PaymentsConfig struct {
+	Provider      string        `yaml:"provider" env:"PAYMENTS_PROVIDER"`
+	ShopID        string        `yaml:"shop_id" env:"YOOKASSA_SHOP_ID"`
+	SecretKey     string        `yaml:"secret_key" env:"YOOKASSA_SECRET_KEY"`
+	WebhookSecret string        `yaml:"webhook_secret" env:"PAYMENTS_WEBHOOK_SECRET"`
+	ReturnURL     string        `yaml:"return_url"`
+	HoldFor       time.Duration `yaml:"hold_for" env-default:"30m"`
+	RefundBefore  time.Duration `yaml:"refund_before" env-default:"48h"`
+	JobInterval   time.Duration `yaml:"job_interval" env-default:"1m"`
+}
+
+// RemindersConfig schedules reminders about upcoming events: a registered member is reminded
+// at each of Offsets before the event. Every Interval the job claims up to BatchSize due
+// reminders at a time; one that could not be delivered is retried after RetryAfter, at most
+// MaxAttempts times. Dates in reminders are given in TimeZone.
+type RemindersConfig struct {
+	Offsets     []time.Duration `yaml:"offsets" env-default:"72h,3h"`
+	Interval    time.Duration   `yaml:"interval" env-default:"1m"`
+	BatchSize   int             `yaml:"batch_size" env-default:"100"`
+	RetryAfter  time.Duration   `yaml:"retry_after" env-default:"10m"`
+	MaxAttempts int             `yaml:"max_attempts" env-default:"5"`
+	TimeZone    string          `yaml:"time_zone" env-default:"Europe/Moscow"`
+}
+
+// JobsConfig drives the background job runner. Every replica runs Workers jobs at once and
+// polls the queue every PollInterval. A job is cancelled after Timeout; one still marked
+// running well past it is taken as lost with its replica and run again. A failed job is
+// retried after RetryBackoff, doubling up to MaxBackoff, until it has had MaxAttempts
+// attempts and is left dead. Succeeded jobs are deleted after Retention. On shutdown running
+// jobs get ShutdownTimeout to finish. Schedules overrides the schedule of periodic jobs by
+// kind, e.g. "membership.expire": "0 3 * * *".
+type JobsConfig struct {
+	Workers         int               `yaml:"workers" env-default:"2"`
+	PollInterval    time.Duration     `yaml:"poll_interval" env-default:"1s"`
+	Timeout         time.Duration     `yaml:"timeout" env-default:"5m"`
+	MaxAttempts     int               `yaml:"max_attempts" env-default:"5"`
+	RetryBackoff    time.Duration     `yaml:"retry_backoff" env-default:"10s"`
+	MaxBackoff      time.Duration     `yaml:"max_backoff" env-default:"1h"`
+	Retention       time.Duration     `yaml:"retention" env-default:"168h"`
+	ShutdownTimeout time.Duration     `yaml:"shutdown_timeout" env-default:"20s"`
+	Schedules       map[string]string `yaml:"schedules"`
+}
+
+// AnalyticsConfig sets how often the registration figures behind the analytics are
+// recomputed and how often the club gauges exported as metrics are updated. Milestones
+// such as sell-outs and membership figures are always current.
+type AnalyticsConfig struct {
+	RefreshInterval time.Duration `yaml:"refresh_interval" env-default:"10m"`
+	GaugesInterval  time.Duration `yaml:"gauges_interval" env-default:"1m"`
+}
+
+// I18nConfig sets how long the language preference of a member is remembered between
+// requests and how often the translations kept in the database are reloaded.
+type I18nConfig struct {
+	MemberCacheTTL time.Duration `yaml:"member_cache_ttl" env-default:"1m"`
+	ReloadInterval time.Duration `yaml:"reload_interval" env-default:"5m"`
+}
+
+// TracingConfig sets up OpenTelemetry tracing. Exporter "otlp" sends spans over OTLP/HTTP to
+// Endpoint, a URL such as "http://collector:4318"; without one the standard OTEL_EXPORTER_OTLP_*
+// variables apply. "stdout" prints spans for local use and "file" appends them to File.
+// SampleRatio is the share of new traces recorded; incoming traces keep the caller's decision.
+type TracingConfig struct {
+	Enabled     bool    `yaml:"enabled"`
+	Exporter    string  `yaml:"exporter" env-default:"otlp"`
+	Endpoint    string  `yaml:"endpoint"`
+	File        string  `yaml:"file" env-default:"traces.json"`
+	ServiceName string  `yaml:"service_name" env-default:"orchestra-api"`
+	SampleRatio float64 `yaml:"sample_ratio" env-default:"1"`
+}
+
+// HealthConfig bounds the readiness checks: each check gets CheckTimeout, and the replica is
+// not ready while more than MaxPoolUsage of the database connections are in use.
+type HealthConfig struct {
+	CheckTimeout time.Duration `yaml:"check_timeout" env-default:"2s"`
+	MaxPoolUsage float64       `yaml:"max_pool_usage" env-default:"0.9"`
 }
 
 func MustLoad() *Config {
@@ -0,0 +1,82 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// redactedValue replaces the value of a secret in the redacted config.
+const redactedValue = "[redacted]"
+
+// secretFields are the words that mark a field as a secret, matched against the lower-cased
+// field name.
+var secretFields = []string{"password", "secret", "apikey", "token"}
+
+// Redacted returns the config keyed by the yaml names of its fields, with the secrets that are
+// set and the passwords in URLs replaced, so it can be shown in diagnostics.
+func (c *Config) Redacted() map[string]any {
+	return redact(reflect.ValueOf(*c)).(map[string]any)
+}
+
+func redact(v reflect.Value) any {
+	if d, ok := v.Interface().(time.Duration); ok {
+		return d.String()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		out := make(map[string]any, v.NumField())
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+
+			name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+			if name == "" {
+				name = field.Name
+			}
+
+			if isSecret(field.Name) && !v.Field(i).IsZero() {
+				out[name] = redactedValue
+				continue
+			}
+			out[name] = redact(v.Field(i))
+		}
+		return out
+	case reflect.Map:
+		out := make(map[string]any, v.Len())
+		for it := v.MapRange(); it.Next(); {
+			out[fmt.Sprint(it.Key().Interface())] = redact(it.Value())
+		}
+		return out
+	case reflect.Slice:
+		out := make([]any, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			out = append(out, redact(v.Index(i)))
+		}
+		return out
+	case reflect.String:
+		// Connection URLs may carry a password of their own.
+		if u, err := url.Parse(v.String()); err == nil && u.User != nil {
+			return u.Redacted()
+		}
+		return v.String()
+	default:
+		return v.Interface()
+	}
+}
+
+func isSecret(name string) bool {
+	name = strings.ToLower(name)
+	for _, word := range secretFields {
+		if strings.Contains(name, word) {
+			return true
+		}
+	}
+
+	return false
+}
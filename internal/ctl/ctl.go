@@ -0,0 +1,154 @@
+// Package ctl implements orchestractl, the admin command line client for the orchestra api.
+package ctl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/openapi"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const usage = `usage: orchestractl [--config path] [--profile name] [-o table|json] <command> [args]
+
+commands:
+  members list [--status pending|approved|declined] [--email-verified true|false] [--phone-verified true|false]
+  members approve [--all-pending] [memberId...]
+  members decline [memberId...]
+  members import -f members.csv [--commit] [--skip-invalid]
+  memberships renewals [--within-days N]
+  memberships import -f payments.csv [--commit] [--skip-invalid]
+  events create -f events.yaml
+  events roster <eventId>
+  info get <key>
+  info set <key> <value>
+  types list
+  types create --name <name> --description <description>
+  locations list
+  locations create --name <name> --route <route> --features <features>
+  jobs list [--status pending|running|succeeded|dead] [--kind kind] [--limit N]
+  jobs retry <jobId>...
+`
+
+var ErrUsage = errors.New("invalid usage")
+
+type env struct {
+	client *openapi.APIClient
+	out    *printer
+	stdout io.Writer
+}
+
+type command func(ctx context.Context, e *env, args []string) error
+
+var commands = map[string]map[string]command{
+	"members": {
+		"list":    membersList,
+		"approve": membersApprove,
+		"decline": membersDecline,
+		"import":  membersImport,
+	},
+	"memberships": {
+		"renewals": membershipsRenewals,
+		"import":   membershipsImport,
+	},
+	"events": {
+		"create": eventsCreate,
+		"roster": eventsRoster,
+	},
+	"info": {
+		"get": infoGet,
+		"set": infoSet,
+	},
+	"types": {
+		"list":   typesList,
+		"create": typesCreate,
+	},
+	"locations": {
+		"list":   locationsList,
+		"create": locationsCreate,
+	},
+	"jobs": {
+		"list":  jobsList,
+		"retry": jobsRetry,
+	},
+}
+
+// Run executes orchestractl with the given arguments and returns the process exit code.
+func Run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("orchestractl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() { fmt.Fprint(stderr, usage) }
+
+	configPath := fs.String("config", defaultConfigPath(), "path to the profile file")
+	profileName := fs.String("profile", "", "profile to use (defaults to the file's current profile)")
+	format := fs.String("o", formatTable, "output format: table or json")
+
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	rest := fs.Args()
+	if len(rest) < 2 {
+		fs.Usage()
+		return 2
+	}
+
+	cmd, ok := commands[rest[0]][rest[1]]
+	if !ok {
+		fmt.Fprintf(stderr, "unknown command %q\n", strings.Join(rest[:2], " "))
+		fs.Usage()
+		return 2
+	}
+
+	out, err := newPrinter(stdout, *format)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	profile, err := loadProfile(*configPath, *profileName)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	e := &env{client: profile.newClient(), out: out, stdout: stdout}
+	if err := cmd(ctx, e, rest[2:]); err != nil {
+		fmt.Fprintln(stderr, err)
+		if errors.Is(err, ErrUsage) {
+			return 2
+		}
+		return 1
+	}
+
+	return 0
+}
+
+// responseID extracts the id of a created or updated object. The server answers
+// with a bare json value instead of the {"id": ...} object described in api.yaml,
+// so the generated client reports a decode error for an otherwise successful call.
+func responseID(resp *http.Response, err error) (string, error) {
+	if err == nil {
+		return "", nil
+	}
+
+	var apiErr *openapi.GenericOpenAPIError
+	if resp == nil || resp.StatusCode >= http.StatusMultipleChoices || !errors.As(err, &apiErr) {
+		return "", err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(apiErr.Body()))
+	dec.UseNumber()
+
+	var v interface{}
+	if decodeErr := dec.Decode(&v); decodeErr != nil {
+		return "", err
+	}
+
+	return fmt.Sprint(v), nil
+}
@@ -0,0 +1,118 @@
+package ctl
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/openapi"
+	"gopkg.in/yaml.v3"
+	"os"
+	"strconv"
+	"time"
+)
+
+var ErrNoEvents = errors.New("no events in file")
+
+// eventSpec is one entry of the events file accepted by "events create".
+type eventSpec struct {
+	Title       string    `yaml:"title"`
+	Description string    `yaml:"description"`
+	Type        int32     `yaml:"type"`
+	Date        time.Time `yaml:"date"`
+	Location    int32     `yaml:"location"`
+	Capacity    int32     `yaml:"capacity"`
+}
+
+type eventsFile struct {
+	Events []eventSpec `yaml:"events"`
+}
+
+type createdEvent struct {
+	Title string `json:"title"`
+	ID    string `json:"id,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+func eventsCreate(ctx context.Context, e *env, args []string) error {
+	const op = "ctl.eventsCreate"
+
+	fs := flag.NewFlagSet("events create", flag.ContinueOnError)
+	file := fs.String("f", "", "yaml file with the events to create")
+	if err := fs.Parse(args); err != nil || *file == "" {
+		return fmt.Errorf("%s: %w", op, ErrUsage)
+	}
+
+	data, err := os.ReadFile(*file)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var spec eventsFile
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if len(spec.Events) == 0 {
+		return fmt.Errorf("%s: %w", op, ErrNoEvents)
+	}
+
+	var (
+		results []createdEvent
+		failed  int
+	)
+
+	for _, ev := range spec.Events {
+		req := openapi.NewNewEventRequest(ev.Title, ev.Type, ev.Date, ev.Location, ev.Capacity)
+		if ev.Description != "" {
+			req.SetDescription(ev.Description)
+		}
+
+		created, resp, err := e.client.DefaultAPI.EventsPost(ctx).NewEventRequest(*req).Execute()
+		id, err := responseID(resp, err)
+		if created != nil && created.Id != nil {
+			id = strconv.Itoa(int(*created.Id))
+		}
+
+		result := createdEvent{Title: ev.Title, ID: id}
+		if err != nil {
+			result.Error = err.Error()
+			failed++
+		}
+		results = append(results, result)
+	}
+
+	rows := make([][]string, 0, len(results))
+	for _, r := range results {
+		rows = append(rows, []string{r.ID, r.Title, r.Error})
+	}
+
+	if err := e.out.print(results, []string{"ID", "TITLE", "ERROR"}, rows); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%s: %d of %d events failed", op, failed, len(spec.Events))
+	}
+
+	return nil
+}
+
+func eventsRoster(ctx context.Context, e *env, args []string) error {
+	const op = "ctl.eventsRoster"
+
+	if len(args) != 1 {
+		return fmt.Errorf("%s: %w", op, ErrUsage)
+	}
+
+	eventID, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("%s: invalid event id: %w", op, ErrUsage)
+	}
+
+	members, _, err := e.client.DefaultAPI.EventsEventIdRegistrationsGet(ctx, int32(eventID)).Execute()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return printMembers(e, members)
+}
@@ -0,0 +1,88 @@
+package ctl
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/openapi"
+	"strconv"
+	"time"
+)
+
+func jobsList(ctx context.Context, e *env, args []string) error {
+	const op = "ctl.jobsList"
+
+	fs := flag.NewFlagSet("jobs list", flag.ContinueOnError)
+	status := fs.String("status", "dead", "pending, running, succeeded or dead; empty for any")
+	kind := fs.String("kind", "", "only jobs of this kind")
+	limit := fs.Int("limit", 0, "at most this many jobs (defaults to the server's limit)")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%s: %w", op, ErrUsage)
+	}
+
+	req := e.client.DefaultAPI.JobsGet(ctx)
+	if *status != "" {
+		req = req.Status(*status)
+	}
+	if *kind != "" {
+		req = req.Kind(*kind)
+	}
+	if *limit > 0 {
+		req = req.Limit(int32(*limit))
+	}
+
+	jobs, _, err := req.Execute()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows := make([][]string, 0, len(jobs))
+	for _, j := range jobs {
+		rows = append(rows, jobRow(j))
+	}
+
+	return e.out.print(jobs, jobHeader, rows)
+}
+
+// jobsRetry puts dead jobs back to the queue.
+func jobsRetry(ctx context.Context, e *env, args []string) error {
+	const op = "ctl.jobsRetry"
+
+	if len(args) == 0 {
+		return fmt.Errorf("%s: %w", op, ErrUsage)
+	}
+
+	retried := make([]openapi.JobResponse, 0, len(args))
+	for _, arg := range args {
+		jobID, err := strconv.ParseInt(arg, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%s: invalid job id %q: %w", op, arg, ErrUsage)
+		}
+
+		job, _, err := e.client.DefaultAPI.JobsJobIdRetryPost(ctx, jobID).Execute()
+		if err != nil {
+			return fmt.Errorf("%s: job %d: %w", op, jobID, err)
+		}
+		retried = append(retried, *job)
+	}
+
+	rows := make([][]string, 0, len(retried))
+	for _, j := range retried {
+		rows = append(rows, jobRow(j))
+	}
+
+	return e.out.print(retried, jobHeader, rows)
+}
+
+var jobHeader = []string{"ID", "KIND", "STATUS", "ATTEMPTS", "RUN AT", "LAST ERROR"}
+
+func jobRow(j openapi.JobResponse) []string {
+	return []string{
+		strconv.FormatInt(j.Id, 10),
+		j.Kind,
+		j.Status,
+		fmt.Sprintf("%d/%d", j.Attempts, j.MaxAttempts),
+		j.RunAt.Format(time.DateTime),
+		j.GetLastError(),
+	}
+}
@@ -0,0 +1,242 @@
+package ctl
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/openapi"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type memberDecision struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+func membersList(ctx context.Context, e *env, args []string) error {
+	const op = "ctl.membersList"
+
+	fs := flag.NewFlagSet("members list", flag.ContinueOnError)
+	status := fs.String("status", "", "filter by status: pending, approved or declined")
+	emailVerified := fs.String("email-verified", "", "filter by confirmed email: true or false")
+	phoneVerified := fs.String("phone-verified", "", "filter by confirmed phone: true or false")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%s: %w", op, ErrUsage)
+	}
+
+	req := e.client.DefaultAPI.MembersGet(ctx)
+	if *status != "" {
+		req = req.Status(*status)
+	}
+	if *emailVerified != "" {
+		verified, err := strconv.ParseBool(*emailVerified)
+		if err != nil {
+			return fmt.Errorf("%s: --email-verified: %w", op, ErrUsage)
+		}
+		req = req.EmailVerified(verified)
+	}
+	if *phoneVerified != "" {
+		verified, err := strconv.ParseBool(*phoneVerified)
+		if err != nil {
+			return fmt.Errorf("%s: --phone-verified: %w", op, ErrUsage)
+		}
+		req = req.PhoneVerified(verified)
+	}
+
+	members, _, err := req.Execute()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return printMembers(e, members)
+}
+
+func membersApprove(ctx context.Context, e *env, args []string) error {
+	const op = "ctl.membersApprove"
+
+	fs := flag.NewFlagSet("members approve", flag.ContinueOnError)
+	allPending := fs.Bool("all-pending", false, "approve every pending member")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%s: %w", op, ErrUsage)
+	}
+
+	ids := fs.Args()
+	if *allPending {
+		pending, _, err := e.client.DefaultAPI.MembersGet(ctx).Status("pending").Execute()
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		for _, m := range pending {
+			ids = append(ids, m.GetId())
+		}
+	}
+
+	return decideMembers(ctx, e, ids, "approved")
+}
+
+func membersDecline(ctx context.Context, e *env, args []string) error {
+	return decideMembers(ctx, e, args, "declined")
+}
+
+// decideMembers sets status for every member in ids and reports the outcome per member.
+// A failure for one member does not stop the rest of the batch.
+func decideMembers(ctx context.Context, e *env, ids []string, status string) error {
+	const op = "ctl.decideMembers"
+
+	if len(ids) == 0 {
+		return fmt.Errorf("%s: no members given: %w", op, ErrUsage)
+	}
+
+	var (
+		results []memberDecision
+		failed  int
+	)
+
+	for _, id := range ids {
+		err := setMemberStatus(ctx, e, id, status)
+
+		result := memberDecision{ID: id, Status: status}
+		if err != nil {
+			result.Status = "failed"
+			result.Error = err.Error()
+			failed++
+		}
+		results = append(results, result)
+	}
+
+	rows := make([][]string, 0, len(results))
+	for _, r := range results {
+		rows = append(rows, []string{r.ID, r.Status, r.Error})
+	}
+
+	if err := e.out.print(results, []string{"ID", "STATUS", "ERROR"}, rows); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%s: %d of %d members failed", op, failed, len(ids))
+	}
+
+	return nil
+}
+
+// setMemberStatus changes the status of one member. The API requires If-Match on the change,
+// so the current ETag of the member is fetched first; a member changed in between is
+// reported as failed rather than overwritten.
+func setMemberStatus(ctx context.Context, e *env, id, status string) error {
+	_, resp, err := e.client.DefaultAPI.MembersMemberIdGet(ctx, id).Execute()
+	if err != nil {
+		return err
+	}
+
+	req := e.client.DefaultAPI.MembersMemberIdPatch(ctx, id).
+		UpdateMemberStatusRequest(*openapi.NewUpdateMemberStatusRequest(status))
+	if etag := resp.Header.Get("ETag"); etag != "" {
+		req = req.IfMatch(etag)
+	}
+
+	_, resp, err = req.Execute()
+	_, err = responseID(resp, err)
+
+	return err
+}
+
+func printMembers(e *env, members []openapi.MemberResponse) error {
+	rows := make([][]string, 0, len(members))
+	for _, m := range members {
+		rows = append(rows, []string{
+			m.GetId(),
+			m.GetFullName(),
+			m.GetEmail(),
+			m.GetPhone(),
+			m.GetStatus(),
+			verifiedContacts(m),
+			m.GetCreatedAt().Format(time.DateTime),
+		})
+	}
+
+	return e.out.print(members, []string{"ID", "NAME", "EMAIL", "PHONE", "STATUS", "VERIFIED", "CREATED"}, rows)
+}
+
+func verifiedContacts(m openapi.MemberResponse) string {
+	var verified []string
+	if m.HasEmailVerifiedAt() {
+		verified = append(verified, "email")
+	}
+	if m.HasPhoneVerifiedAt() {
+		verified = append(verified, "phone")
+	}
+	if len(verified) == 0 {
+		return "-"
+	}
+
+	return strings.Join(verified, ",")
+}
+
+// membersImport uploads a csv file of members. Without --commit the server only
+// checks the file, so the report can be reviewed before anything is saved.
+func membersImport(ctx context.Context, e *env, args []string) error {
+	const op = "ctl.membersImport"
+
+	fs := flag.NewFlagSet("members import", flag.ContinueOnError)
+	file := fs.String("f", "", "csv file with full_name, email, phone and optional status columns")
+	commit := fs.Bool("commit", false, "save the members instead of a dry run")
+	skipInvalid := fs.Bool("skip-invalid", false, "save valid rows even if some rows are invalid")
+	if err := fs.Parse(args); err != nil || *file == "" {
+		return fmt.Errorf("%s: %w", op, ErrUsage)
+	}
+
+	data, err := os.ReadFile(*file)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	report, _, err := e.client.DefaultAPI.MembersImportPost(ctx).
+		DryRun(!*commit).
+		SkipInvalid(*skipInvalid).
+		Body(string(data)).
+		Execute()
+	if err != nil {
+		var apiErr *openapi.GenericOpenAPIError
+		rejected, ok := openapi.MemberImportReport{}, false
+		if errors.As(err, &apiErr) {
+			rejected, ok = apiErr.Model().(openapi.MemberImportReport)
+		}
+		if !ok {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		report = &rejected
+	}
+
+	rows := make([][]string, 0, len(report.Results))
+	for _, r := range report.Results {
+		rows = append(rows, []string{strconv.Itoa(int(r.Line)), r.Outcome, r.FullName, r.Email, r.Phone, r.Status, r.GetId()})
+	}
+
+	if err := e.out.print(report, []string{"LINE", "OUTCOME", "NAME", "EMAIL", "PHONE", "STATUS", "ID"}, rows); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !report.Committed && report.Failed > 0 {
+		return fmt.Errorf("%s: %d of %d rows are invalid, nothing saved", op, report.Failed, report.Total)
+	}
+	if e.out.format == formatJSON {
+		return nil
+	}
+
+	switch {
+	case !report.Committed:
+		fmt.Fprintf(e.stdout, "%d rows are valid, run with --commit to save them\n", report.Total)
+	case report.Failed > 0:
+		fmt.Fprintf(e.stdout, "saved %d members, skipped %d invalid rows\n", report.Total-report.Failed, report.Failed)
+	default:
+		fmt.Fprintf(e.stdout, "saved %d members\n", report.Total)
+	}
+
+	return nil
+}
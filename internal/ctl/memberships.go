@@ -0,0 +1,126 @@
+package ctl
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/openapi"
+	"os"
+	"strconv"
+	"time"
+)
+
+func membershipsRenewals(ctx context.Context, e *env, args []string) error {
+	const op = "ctl.membershipsRenewals"
+
+	fs := flag.NewFlagSet("memberships renewals", flag.ContinueOnError)
+	withinDays := fs.Int("within-days", -1, "look this many days ahead (defaults to the server's renewal window)")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%s: %w", op, ErrUsage)
+	}
+
+	req := e.client.DefaultAPI.MembershipsRenewalsGet(ctx)
+	if *withinDays >= 0 {
+		req = req.WithinDays(int32(*withinDays))
+	}
+
+	renewals, _, err := req.Execute()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows := make([][]string, 0, len(renewals))
+	for _, r := range renewals {
+		lastPaid := "-"
+		if r.HasLastPaidAt() {
+			lastPaid = r.GetLastPaidAt().Format(time.DateOnly)
+		}
+		rows = append(rows, []string{
+			r.MemberId,
+			r.FullName,
+			r.Email,
+			r.Tier,
+			r.Status,
+			r.ExpiresAt.Format(time.DateOnly),
+			lastPaid,
+		})
+	}
+
+	return e.out.print(renewals, []string{"ID", "NAME", "EMAIL", "TIER", "STATUS", "EXPIRES", "LAST PAID"}, rows)
+}
+
+// membershipsImport uploads a csv file of membership dues. Like members import it is a
+// dry run unless --commit is given.
+func membershipsImport(ctx context.Context, e *env, args []string) error {
+	const op = "ctl.membershipsImport"
+
+	fs := flag.NewFlagSet("memberships import", flag.ContinueOnError)
+	file := fs.String("f", "", "csv file with member_id or email, tier, amount, paid_at and optional reference and note columns")
+	commit := fs.Bool("commit", false, "save the payments instead of a dry run")
+	skipInvalid := fs.Bool("skip-invalid", false, "save valid rows even if some rows are invalid")
+	if err := fs.Parse(args); err != nil || *file == "" {
+		return fmt.Errorf("%s: %w", op, ErrUsage)
+	}
+
+	data, err := os.ReadFile(*file)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	report, _, err := e.client.DefaultAPI.MembershipsPaymentsImportPost(ctx).
+		DryRun(!*commit).
+		SkipInvalid(*skipInvalid).
+		Body(string(data)).
+		Execute()
+	if err != nil {
+		var apiErr *openapi.GenericOpenAPIError
+		rejected, ok := openapi.PaymentImportReport{}, false
+		if errors.As(err, &apiErr) {
+			rejected, ok = apiErr.Model().(openapi.PaymentImportReport)
+		}
+		if !ok {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		report = &rejected
+	}
+
+	rows := make([][]string, 0, len(report.Results))
+	for _, r := range report.Results {
+		member := r.GetMemberId()
+		if member == "" {
+			member = r.GetEmail()
+		}
+		period := ""
+		if r.HasPeriodEnd() {
+			period = r.GetPeriodStart().Format(time.DateOnly) + " - " + r.GetPeriodEnd().Format(time.DateOnly)
+		}
+		id := ""
+		if r.HasId() {
+			id = strconv.Itoa(int(r.GetId()))
+		}
+		rows = append(rows, []string{strconv.Itoa(int(r.Line)), r.Outcome, member, r.GetTier(), period, r.GetReference(), id})
+	}
+
+	if err := e.out.print(report, []string{"LINE", "OUTCOME", "MEMBER", "TIER", "PERIOD", "REFERENCE", "ID"}, rows); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !report.Committed && report.Failed > 0 {
+		return fmt.Errorf("%s: %d of %d rows are invalid, nothing saved", op, report.Failed, report.Total)
+	}
+	if e.out.format == formatJSON {
+		return nil
+	}
+
+	switch {
+	case !report.Committed:
+		fmt.Fprintf(e.stdout, "%d rows are valid, run with --commit to save them\n", report.Total)
+	case report.Failed > 0:
+		fmt.Fprintf(e.stdout, "saved %d payments, skipped %d invalid rows\n", report.Total-report.Failed, report.Failed)
+	default:
+		fmt.Fprintf(e.stdout, "saved %d payments\n", report.Total)
+	}
+
+	return nil
+}
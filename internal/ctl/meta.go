@@ -0,0 +1,123 @@
+package ctl
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/openapi"
+	"strconv"
+)
+
+type createdMeta struct {
+	ID string `json:"id"`
+}
+
+func infoGet(ctx context.Context, e *env, args []string) error {
+	const op = "ctl.infoGet"
+
+	if len(args) != 1 {
+		return fmt.Errorf("%s: %w", op, ErrUsage)
+	}
+
+	info, _, err := e.client.DefaultAPI.InfoKeyGet(ctx, args[0]).Execute()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return e.out.print(info, []string{"KEY", "VALUE"}, [][]string{{info.GetKey(), info.GetValue()}})
+}
+
+func infoSet(ctx context.Context, e *env, args []string) error {
+	const op = "ctl.infoSet"
+
+	if len(args) != 2 {
+		return fmt.Errorf("%s: %w", op, ErrUsage)
+	}
+
+	_, err := e.client.DefaultAPI.InfoKeyPut(ctx, args[0]).
+		OrchestraInfoRequest(*openapi.NewOrchestraInfoRequest(args[1])).
+		Execute()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	info := openapi.OrchestraInfoResponse{Key: &args[0], Value: &args[1]}
+
+	return e.out.print(info, []string{"KEY", "VALUE"}, [][]string{{args[0], args[1]}})
+}
+
+func typesList(ctx context.Context, e *env, args []string) error {
+	const op = "ctl.typesList"
+
+	types, _, err := e.client.DefaultAPI.TypesGet(ctx).Execute()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows := make([][]string, 0, len(types))
+	for _, t := range types {
+		rows = append(rows, []string{strconv.Itoa(int(t.GetId())), t.GetName(), t.GetDescription()})
+	}
+
+	return e.out.print(types, []string{"ID", "NAME", "DESCRIPTION"}, rows)
+}
+
+func typesCreate(ctx context.Context, e *env, args []string) error {
+	const op = "ctl.typesCreate"
+
+	fs := flag.NewFlagSet("types create", flag.ContinueOnError)
+	name := fs.String("name", "", "event type name")
+	description := fs.String("description", "", "event type description")
+	if err := fs.Parse(args); err != nil || *name == "" || *description == "" {
+		return fmt.Errorf("%s: %w", op, ErrUsage)
+	}
+
+	_, resp, err := e.client.DefaultAPI.TypesPost(ctx).
+		NewEventTypeRequest(*openapi.NewNewEventTypeRequest(*name, *description)).
+		Execute()
+	id, err := responseID(resp, err)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return e.out.print(createdMeta{ID: id}, []string{"ID"}, [][]string{{id}})
+}
+
+func locationsList(ctx context.Context, e *env, args []string) error {
+	const op = "ctl.locationsList"
+
+	locations, _, err := e.client.DefaultAPI.LocationsGet(ctx).Execute()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows := make([][]string, 0, len(locations))
+	for _, l := range locations {
+		rows = append(rows, []string{strconv.Itoa(int(l.GetId())), l.GetName(), l.GetRoute(), l.GetFeatures()})
+	}
+
+	return e.out.print(locations, []string{"ID", "NAME", "ROUTE", "FEATURES"}, rows)
+}
+
+func locationsCreate(ctx context.Context, e *env, args []string) error {
+	const op = "ctl.locationsCreate"
+
+	fs := flag.NewFlagSet("locations create", flag.ContinueOnError)
+	name := fs.String("name", "", "location name")
+	route := fs.String("route", "", "how to get there")
+	features := fs.String("features", "", "location features")
+	if err := fs.Parse(args); err != nil || *name == "" || *route == "" || *features == "" {
+		return fmt.Errorf("%s: %w", op, ErrUsage)
+	}
+
+	req := openapi.NewNewLocationRequest(*name, *route)
+	req.SetFeatures(*features)
+
+	_, resp, err := e.client.DefaultAPI.LocationsPost(ctx).NewLocationRequest(*req).Execute()
+	id, err := responseID(resp, err)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return e.out.print(createdMeta{ID: id}, []string{"ID"}, [][]string{{id}})
+}
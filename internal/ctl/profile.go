@@ -0,0 +1,88 @@
+package ctl
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/openapi"
+	"gopkg.in/yaml.v3"
+	"os"
+	"path/filepath"
+)
+
+const defaultProfile = "default"
+
+var ErrProfileNotFound = errors.New("profile not found")
+
+// Profile describes how to reach one orchestra api deployment.
+type Profile struct {
+	Server   string `yaml:"server"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	Token    string `yaml:"token"`
+}
+
+type profileFile struct {
+	Current  string             `yaml:"current"`
+	Profiles map[string]Profile `yaml:"profiles"`
+}
+
+func defaultConfigPath() string {
+	if path := os.Getenv("ORCHESTRACTL_CONFIG"); path != "" {
+		return path
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ".orchestractl.yaml"
+	}
+
+	return filepath.Join(dir, "orchestractl", "config.yaml")
+}
+
+func loadProfile(path, name string) (Profile, error) {
+	const op = "ctl.loadProfile"
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Profile{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var file profileFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return Profile{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if name == "" {
+		name = file.Current
+	}
+	if name == "" {
+		name = defaultProfile
+	}
+
+	profile, ok := file.Profiles[name]
+	if !ok {
+		return Profile{}, fmt.Errorf("%s: %q: %w", op, name, ErrProfileNotFound)
+	}
+	if profile.Server == "" {
+		return Profile{}, fmt.Errorf("%s: profile %q has no server", op, name)
+	}
+
+	return profile, nil
+}
+
+func (p Profile) newClient() *openapi.APIClient {
+	cfg := openapi.NewConfiguration()
+	cfg.UserAgent = "orchestractl"
+	cfg.Servers = openapi.ServerConfigurations{{URL: p.Server}}
+
+	switch {
+	case p.Token != "":
+		cfg.AddDefaultHeader("Authorization", "Bearer "+p.Token)
+	case p.Username != "":
+		creds := base64.StdEncoding.EncodeToString([]byte(p.Username + ":" + p.Password))
+		cfg.AddDefaultHeader("Authorization", "Basic "+creds)
+	}
+
+	return openapi.NewAPIClient(cfg)
+}
@@ -0,0 +1,319 @@
+package handler
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/export"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/i18n"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"github.com/Ilya-Repin/orchestra_api/internal/openapi"
+	"github.com/Ilya-Repin/orchestra_api/internal/service/account"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// memberIDHeader names the member on whose behalf the bot calls the /me endpoints.
+const memberIDHeader = "X-Member-Id"
+
+const (
+	dataFormatJSON = "json"
+	dataFormatZIP  = "zip"
+)
+
+var memberRegistrationColumns = []export.Column[model.MemberRegistration]{
+	{Name: "event_id", Value: func(r model.MemberRegistration, _ export.DateFormatter) string { return strconv.Itoa(r.EventID) }},
+	{Name: "title", Value: func(r model.MemberRegistration, _ export.DateFormatter) string { return r.Title }},
+	{Name: "event_date", Value: func(r model.MemberRegistration, d export.DateFormatter) string { return d.Format(r.EventDate) }},
+	{Name: "location", Value: func(r model.MemberRegistration, _ export.DateFormatter) string { return r.Location }},
+	{Name: "status", Value: func(r model.MemberRegistration, _ export.DateFormatter) string { return r.Status }},
+	{Name: "registered_at", Value: func(r model.MemberRegistration, d export.DateFormatter) string { return d.Format(r.RegisteredAt) }},
+	{Name: "updated_at", Value: func(r model.MemberRegistration, d export.DateFormatter) string { return d.Format(r.UpdatedAt) }},
+}
+
+type AccountHandler struct {
+	log            *slog.Logger
+	accountService *account.Service
+}
+
+func NewAccountHandler(log *slog.Logger, accountService *account.Service) *AccountHandler {
+	return &AccountHandler{log: log, accountService: accountService}
+}
+
+// CurrentMember identifies the member of a /me request by X-Member-Id and exposes it as the
+// memberId route parameter, so the member handlers serve /me unchanged.
+func (ah *AccountHandler) CurrentMember(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(memberIDHeader)
+		if id == "" {
+			writeError(w, r, http.StatusUnauthorized, "X-Member-Id header is required")
+			return
+		}
+		if _, err := uuid.Parse(id); err != nil {
+			writeError(w, r, http.StatusBadRequest, "wrong format X-Member-Id")
+			return
+		}
+
+		if rctx := chi.RouteContext(r.Context()); rctx != nil {
+			rctx.URLParams.Add("memberId", id)
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (ah *AccountHandler) HandleGetMe(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.account.HandleGetMe"
+
+	memberID := currentMemberID(r)
+
+	member, err := ah.accountService.GetProfile(r.Context(), memberID)
+	if err != nil {
+		ah.log.ErrorContext(r.Context(), "failed to get member", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to get member")
+		return
+	}
+
+	if notModified(w, r, versionETag(member.Version)) {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, meResponse(member))
+}
+
+func (ah *AccountHandler) HandleGetMyRegistrations(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.account.HandleGetMyRegistrations"
+
+	memberID := currentMemberID(r)
+
+	registrations, err := ah.accountService.GetRegistrations(r.Context(), memberID)
+	if err != nil {
+		ah.log.ErrorContext(r.Context(), "failed to get registrations", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to get registrations")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, memberRegistrationResponses(registrations))
+}
+
+func (ah *AccountHandler) HandleSetNotifications(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.account.HandleSetNotifications"
+
+	memberID := currentMemberID(r)
+
+	var req openapi.NotificationPreferences
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	prefs := model.NotificationPreferences{Email: req.GetEmail(), SMS: req.GetSms()}
+	if err := ah.accountService.SetNotificationPreferences(r.Context(), memberID, prefs); err != nil {
+		ah.log.ErrorContext(r.Context(), "failed to save notification preferences", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to save notification preferences")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleRequestExport emails the member the code that HandleExportMyData asks for, so only
+// the owner of the mailbox gets the data, not anyone who knows the member's ID.
+func (ah *AccountHandler) HandleRequestExport(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.account.HandleRequestExport"
+
+	memberID := currentMemberID(r)
+
+	expiresAt, err := ah.accountService.RequestExport(r.Context(), memberID)
+	if err != nil {
+		ah.log.ErrorContext(r.Context(), "failed to request export", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to request data export")
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, openapi.VerificationSentResponse{ExpiresAt: expiresAt})
+}
+
+// HandleExportMyData hands over everything stored about the member, once the code emailed by
+// HandleRequestExport is confirmed: a JSON document, or a ZIP archive with the same document
+// and the registrations as a spreadsheet-friendly csv.
+func (ah *AccountHandler) HandleExportMyData(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.account.HandleExportMyData"
+
+	memberID := currentMemberID(r)
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = dataFormatJSON
+	}
+	if format != dataFormatJSON && format != dataFormatZIP {
+		writeError(w, r, http.StatusBadRequest, fmt.Errorf("%q: %w", format, export.ErrUnknownFormat).Error())
+		return
+	}
+
+	var req openapi.VerificationConfirmRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.GetCode() == "" {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	data, err := ah.accountService.ExportData(r.Context(), memberID, req.GetCode())
+	if err != nil {
+		ah.log.ErrorContext(r.Context(), "failed to export member data", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to export member data")
+		return
+	}
+
+	document := openapi.MemberDataExport{
+		ExportedAt:    data.ExportedAt,
+		Profile:       meResponse(data.Member),
+		Registrations: memberRegistrationResponses(data.Registrations),
+	}
+
+	if format == dataFormatJSON {
+		w.Header().Set("Content-Disposition", `attachment; filename="my-data.json"`)
+		writeJSON(w, http.StatusOK, document)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", `attachment; filename="my-data.zip"`)
+	w.WriteHeader(http.StatusOK)
+
+	if err := writeDataArchive(w, document, data.Registrations, i18n.FromContext(r.Context())); err != nil {
+		// the status line is already sent, the client sees a truncated archive
+		ah.log.ErrorContext(r.Context(), "failed to write archive", slog.String("op", op), slog.Any("err", err))
+	}
+}
+
+func (ah *AccountHandler) HandleRequestErasure(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.account.HandleRequestErasure"
+
+	memberID := currentMemberID(r)
+
+	expiresAt, err := ah.accountService.RequestErasure(r.Context(), memberID)
+	if err != nil {
+		ah.log.ErrorContext(r.Context(), "failed to request erasure", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to request erasure")
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, openapi.VerificationSentResponse{ExpiresAt: expiresAt})
+}
+
+func (ah *AccountHandler) HandleConfirmErasure(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.account.HandleConfirmErasure"
+
+	memberID := currentMemberID(r)
+
+	var req openapi.VerificationConfirmRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.GetCode() == "" {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := ah.accountService.ConfirmErasure(r.Context(), memberID, req.GetCode()); err != nil {
+		ah.log.WarnContext(r.Context(), "failed to confirm erasure", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to erase member data")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// currentMemberID returns the member identified by CurrentMember, which has already
+// validated the id.
+func currentMemberID(r *http.Request) uuid.UUID {
+	return uuid.MustParse(chi.URLParam(r, "memberId"))
+}
+
+func meResponse(m model.Member) openapi.MeResponse {
+	id := m.ID.String()
+	status := string(m.Status)
+
+	response := openapi.MeResponse{
+		Id:              &id,
+		FullName:        m.FullName,
+		Email:           m.Email,
+		Phone:           m.Phone,
+		Status:          &status,
+		EmailVerifiedAt: m.EmailVerifiedAt,
+		PhoneVerifiedAt: m.PhoneVerifiedAt,
+		CreatedAt:       &m.CreatedAt,
+		UpdatedAt:       &m.UpdatedAt,
+		Notifications:   openapi.NotificationPreferences{Email: m.Notifications.Email, Sms: m.Notifications.SMS},
+	}
+	if m.Language != "" {
+		response.SetLanguage(m.Language)
+	}
+
+	return response
+}
+
+func memberRegistrationResponses(registrations []model.MemberRegistration) []openapi.MemberRegistrationResponse {
+	responses := make([]openapi.MemberRegistrationResponse, 0, len(registrations))
+	for _, reg := range registrations {
+		eventID := int32(reg.EventID)
+
+		responses = append(responses, openapi.MemberRegistrationResponse{
+			EventId:      &eventID,
+			Title:        &reg.Title,
+			EventDate:    &reg.EventDate,
+			Location:     &reg.Location,
+			Status:       &reg.Status,
+			RegisteredAt: &reg.RegisteredAt,
+			UpdatedAt:    &reg.UpdatedAt,
+		})
+	}
+
+	return responses
+}
+
+// writeDataArchive writes the data export as a ZIP archive: my-data.json with the whole
+// document and registrations.csv with column titles in the language of the request.
+func writeDataArchive(w http.ResponseWriter, document openapi.MemberDataExport, registrations []model.MemberRegistration, loc i18n.Localizer) error {
+	archive := zip.NewWriter(w)
+
+	f, err := archive.CreateHeader(&zip.FileHeader{Name: "my-data.json", Method: zip.Deflate, Modified: document.ExportedAt})
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(document); err != nil {
+		return err
+	}
+
+	f, err = archive.CreateHeader(&zip.FileHeader{Name: "registrations.csv", Method: zip.Deflate, Modified: document.ExportedAt})
+	if err != nil {
+		return err
+	}
+	table, err := export.New(export.FormatCSV, f)
+	if err != nil {
+		return err
+	}
+
+	header := export.Header(memberRegistrationColumns)
+	for i, name := range header {
+		header[i] = columnTitle(loc, name)
+	}
+	if err := table.WriteRow(header); err != nil {
+		return err
+	}
+
+	dates := export.NewDateFormatter(string(loc.Lang), time.UTC)
+	for _, reg := range registrations {
+		if err := table.WriteRow(export.Row(memberRegistrationColumns, reg, dates)); err != nil {
+			return err
+		}
+	}
+	if err := table.Close(); err != nil {
+		return err
+	}
+
+	return archive.Close()
+}
@@ -0,0 +1,279 @@
+package handler
+
+import (
+	"context"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/i18n"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"github.com/Ilya-Repin/orchestra_api/internal/openapi"
+	"github.com/Ilya-Repin/orchestra_api/internal/service/analytics"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type AnalyticsHandler struct {
+	log              *slog.Logger
+	analyticsService *analytics.Service
+}
+
+func NewAnalyticsHandler(log *slog.Logger, as *analytics.Service) *AnalyticsHandler {
+	return &AnalyticsHandler{log: log, analyticsService: as}
+}
+
+func (ah *AnalyticsHandler) HandleGetSummary(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.analytics.HandleGetSummary"
+
+	from, to, err := parseDateRange(r)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	summary, err := ah.analyticsService.GetClubSummary(r.Context(), from, to)
+	if err != nil {
+		ah.log.ErrorContext(r.Context(), "failed to get club summary", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to get analytics")
+		return
+	}
+
+	resp := openapi.ClubSummaryResponse{
+		MembersApproved:  int32(summary.Members[model.StatusApproved]),
+		MembersPending:   int32(summary.Members[model.StatusPending]),
+		MembersDeclined:  int32(summary.Members[model.StatusDeclined]),
+		NewMembers:       int32(summary.NewMembers),
+		Events:           int32(summary.Events),
+		Capacity:         int32(summary.Capacity),
+		SoldOutEvents:    int32(summary.SoldOutEvents),
+		Registered:       int32(summary.Counts.Registered),
+		PendingPayment:   int32(summary.Counts.Pending),
+		Cancelled:        int32(summary.Counts.Cancelled),
+		Attended:         int32(summary.Counts.Attended),
+		NoShow:           int32(summary.Counts.NoShow),
+		FillRate:         rate(summary.Counts.FillRate(summary.Capacity)),
+		CancellationRate: rate(summary.Counts.CancellationRate()),
+		NoShowRate:       rate(summary.Counts.NoShowRate()),
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (ah *AnalyticsHandler) HandleGetEventStats(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.analytics.HandleGetEventStats"
+
+	eventType, from, to, err := parseEventFilters(r)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var location *int
+	if raw := r.URL.Query().Get("location"); raw != "" {
+		id, err := strconv.Atoi(raw)
+		if err != nil {
+			writeError(w, r, http.StatusBadRequest, "invalid location id")
+			return
+		}
+		location = &id
+	}
+
+	stats, err := ah.analyticsService.GetEventStats(r.Context(), from, to, eventType, location)
+	if err != nil {
+		ah.log.ErrorContext(r.Context(), "failed to get event stats", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to get analytics")
+		return
+	}
+
+	resp := make([]openapi.EventStatsResponse, 0, len(stats))
+	for _, st := range stats {
+		item := openapi.EventStatsResponse{
+			EventId:              int32(st.EventID),
+			Title:                st.Title,
+			EventDate:            st.EventDate,
+			EventType:            int32(st.EventType),
+			Location:             int32(st.Location),
+			Capacity:             int32(st.Capacity),
+			Registered:           int32(st.Counts.Registered),
+			PendingPayment:       int32(st.Counts.Pending),
+			Cancelled:            int32(st.Counts.Cancelled),
+			Attended:             int32(st.Counts.Attended),
+			NoShow:               int32(st.Counts.NoShow),
+			AttendanceRecorded:   st.AttendanceRecorded,
+			FillRate:             rate(st.Counts.FillRate(st.Capacity)),
+			CancellationRate:     rate(st.Counts.CancellationRate()),
+			NoShowRate:           rate(st.Counts.NoShowRate()),
+			SoldOutAt:            st.SoldOutAt,
+			TimeToSelloutSeconds: seconds(st.TimeToSellout()),
+		}
+		resp = append(resp, item)
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (ah *AnalyticsHandler) HandleGetEventTypeStats(w http.ResponseWriter, r *http.Request) {
+	ah.handleGroupStats(w, r, "handlers.analytics.HandleGetEventTypeStats", ah.analyticsService.GetEventTypeStats)
+}
+
+func (ah *AnalyticsHandler) HandleGetLocationStats(w http.ResponseWriter, r *http.Request) {
+	ah.handleGroupStats(w, r, "handlers.analytics.HandleGetLocationStats", ah.analyticsService.GetLocationStats)
+}
+
+// handleGroupStats answers with the event figures summed up by get.
+func (ah *AnalyticsHandler) handleGroupStats(
+	w http.ResponseWriter, r *http.Request, op string,
+	get func(ctx context.Context, from, to *time.Time, lang i18n.Lang) ([]model.GroupStats, error),
+) {
+	from, to, err := parseDateRange(r)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	stats, err := get(r.Context(), from, to, i18n.FromContext(r.Context()).Lang)
+	if err != nil {
+		ah.log.ErrorContext(r.Context(), "failed to get group stats", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to get analytics")
+		return
+	}
+
+	resp := make([]openapi.GroupStatsResponse, 0, len(stats))
+	for _, g := range stats {
+		resp = append(resp, openapi.GroupStatsResponse{
+			Id:                      int32(g.ID),
+			Name:                    g.Name,
+			Events:                  int32(g.Events),
+			Capacity:                int32(g.Capacity),
+			SoldOutEvents:           int32(g.SoldOut),
+			Registered:              int32(g.Counts.Registered),
+			PendingPayment:          int32(g.Counts.Pending),
+			Cancelled:               int32(g.Counts.Cancelled),
+			Attended:                int32(g.Counts.Attended),
+			NoShow:                  int32(g.Counts.NoShow),
+			FillRate:                rate(g.Counts.FillRate(g.Capacity)),
+			CancellationRate:        rate(g.Counts.CancellationRate()),
+			NoShowRate:              rate(g.Counts.NoShowRate()),
+			AvgTimeToSelloutSeconds: seconds(g.AvgTimeToSellout),
+		})
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (ah *AnalyticsHandler) HandleGetMemberGrowth(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.analytics.HandleGetMemberGrowth"
+
+	from, to, err := parseDateRange(r)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	interval := model.Interval(r.URL.Query().Get("interval"))
+	points, err := ah.analyticsService.GetMemberGrowth(r.Context(), from, to, interval)
+	if err != nil {
+		ah.log.ErrorContext(r.Context(), "failed to get member growth", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to get analytics")
+		return
+	}
+
+	resp := make([]openapi.GrowthPointResponse, 0, len(points))
+	for _, p := range points {
+		resp = append(resp, openapi.GrowthPointResponse{
+			PeriodStart: p.Start,
+			Applied:     int32(p.Applied),
+			Approved:    int32(p.Approved),
+			Declined:    int32(p.Declined),
+			Members:     int32(p.Members),
+		})
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (ah *AnalyticsHandler) HandleGetMemberFunnel(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.analytics.HandleGetMemberFunnel"
+
+	from, to, err := parseDateRange(r)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	funnel, err := ah.analyticsService.GetMemberFunnel(r.Context(), from, to)
+	if err != nil {
+		ah.log.ErrorContext(r.Context(), "failed to get member funnel", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to get analytics")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, openapi.FunnelResponse{
+		Applied:               int32(funnel.Applied),
+		Verified:              int32(funnel.Verified),
+		Pending:               int32(funnel.Pending),
+		Approved:              int32(funnel.Approved),
+		Declined:              int32(funnel.Declined),
+		UndatedDecisions:      int32(funnel.UndatedDecisions),
+		Registered:            int32(funnel.Registered),
+		Attended:              int32(funnel.Attended),
+		MedianDecisionSeconds: seconds(funnel.MedianDecision),
+	})
+}
+
+func (ah *AnalyticsHandler) HandleGetActiveMembers(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.analytics.HandleGetActiveMembers"
+
+	from, to, err := parseDateRange(r)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var limit int
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit <= 0 {
+			writeError(w, r, http.StatusBadRequest, "invalid limit")
+			return
+		}
+	}
+
+	members, err := ah.analyticsService.GetActiveMembers(r.Context(), from, to, limit)
+	if err != nil {
+		ah.log.ErrorContext(r.Context(), "failed to get active members", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to get analytics")
+		return
+	}
+
+	resp := make([]openapi.ActiveMemberResponse, 0, len(members))
+	for _, m := range members {
+		resp = append(resp, openapi.ActiveMemberResponse{
+			MemberId:      m.MemberID.String(),
+			FullName:      m.FullName,
+			Registrations: int32(m.Registrations),
+			Attended:      int32(m.Attended),
+			Cancelled:     int32(m.Cancelled),
+			LastEventDate: m.LastEventDate,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// rate converts a share for a response; a missing share stays missing.
+func rate(r *float64) *float32 {
+	if r == nil {
+		return nil
+	}
+	v := float32(*r)
+	return &v
+}
+
+// seconds converts a duration for a response; a missing duration stays missing.
+func seconds(d *time.Duration) *int64 {
+	if d == nil {
+		return nil
+	}
+	v := int64(d.Round(time.Second) / time.Second)
+	return &v
+}
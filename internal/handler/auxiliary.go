@@ -2,24 +2,22 @@ package handler
 
 import (
 	"encoding/json"
-	"errors"
-	"github.com/Ilya-Repin/orchestra_api/internal/infra/metrics"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/i18n"
 	"github.com/Ilya-Repin/orchestra_api/internal/openapi"
-	"github.com/Ilya-Repin/orchestra_api/internal/service"
 	"github.com/Ilya-Repin/orchestra_api/internal/service/auxiliary"
 	"github.com/go-chi/chi/v5"
 	"log/slog"
 	"net/http"
+	"strconv"
 )
 
 type AuxHandler struct {
 	log        *slog.Logger
 	auxService *auxiliary.Service
-	metrics    *metrics.Metrics
 }
 
-func NewAuxHandler(log *slog.Logger, as *auxiliary.Service, metrics *metrics.Metrics) *AuxHandler {
-	return &AuxHandler{log: log, auxService: as, metrics: metrics}
+func NewAuxHandler(log *slog.Logger, as *auxiliary.Service) *AuxHandler {
+	return &AuxHandler{log: log, auxService: as}
 }
 
 func (ah *AuxHandler) HandleGetEventTypes(w http.ResponseWriter, r *http.Request) {
@@ -28,12 +26,20 @@ func (ah *AuxHandler) HandleGetEventTypes(w http.ResponseWriter, r *http.Request
 	log := ah.log.With(slog.String("op", op))
 	ctx := r.Context()
 
-	eventTypes, err := ah.auxService.GetEventTypes(ctx)
+	lang := i18n.FromContext(ctx).Lang
+
+	eventTypes, err := ah.auxService.GetEventTypes(ctx, lang)
 	if err != nil {
-		log.Error("failed to get event types", slog.String("op", op), slog.Any("err", err))
-		writeError(w, http.StatusInternalServerError, "failed to get event types")
-		ah.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "500").Inc()
+		log.ErrorContext(r.Context(), "failed to get event types", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to get event types")
+		return
+	}
 
+	ids, versions := make([]int, len(eventTypes)), make([]int, len(eventTypes))
+	for i, e := range eventTypes {
+		ids[i], versions[i] = e.ID, e.Version
+	}
+	if notModified(w, r, collectionETag(string(lang), ids, versions)) {
 		return
 	}
 
@@ -49,7 +55,6 @@ func (ah *AuxHandler) HandleGetEventTypes(w http.ResponseWriter, r *http.Request
 	}
 
 	writeJSON(w, http.StatusOK, typeResponses)
-	ah.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "200").Inc()
 }
 
 func (ah *AuxHandler) HandleGetLocations(w http.ResponseWriter, r *http.Request) {
@@ -58,12 +63,20 @@ func (ah *AuxHandler) HandleGetLocations(w http.ResponseWriter, r *http.Request)
 	log := ah.log.With(slog.String("op", op))
 	ctx := r.Context()
 
-	readLocations, err := ah.auxService.GetLocations(ctx)
+	lang := i18n.FromContext(ctx).Lang
+
+	readLocations, err := ah.auxService.GetLocations(ctx, lang)
 	if err != nil {
-		log.Error("failed to get locations", slog.String("op", op), slog.Any("err", err))
-		writeError(w, http.StatusInternalServerError, "failed to get locations")
-		ah.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "500").Inc()
+		log.ErrorContext(r.Context(), "failed to get locations", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to get locations")
+		return
+	}
 
+	ids, versions := make([]int, len(readLocations)), make([]int, len(readLocations))
+	for i, m := range readLocations {
+		ids[i], versions[i] = m.ID, m.Version
+	}
+	if notModified(w, r, collectionETag(string(lang), ids, versions)) {
 		return
 	}
 
@@ -80,7 +93,6 @@ func (ah *AuxHandler) HandleGetLocations(w http.ResponseWriter, r *http.Request)
 	}
 
 	writeJSON(w, http.StatusOK, locResponses)
-	ah.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "200").Inc()
 }
 
 func (ah *AuxHandler) HandleCreateEventType(w http.ResponseWriter, r *http.Request) {
@@ -89,28 +101,24 @@ func (ah *AuxHandler) HandleCreateEventType(w http.ResponseWriter, r *http.Reque
 
 	var req openapi.NewEventTypeRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		ah.log.Warn("failed to decode request", slog.String("op", op), slog.Any("err", err))
-		writeError(w, http.StatusBadRequest, "invalid request body")
-		ah.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
+		ah.log.WarnContext(r.Context(), "failed to decode request", slog.String("op", op), slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
 	if req.GetName() == "" || req.GetDescription() == "" {
-		writeError(w, http.StatusBadRequest, "missing required fields")
-		ah.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
+		writeError(w, r, http.StatusBadRequest, "missing required fields")
 		return
 	}
 
 	id, err := ah.auxService.AddEventType(ctx, req.GetName(), req.GetDescription())
 	if err != nil {
-		ah.log.Error("failed to add event type", slog.String("op", op), slog.Any("err", err))
-		writeError(w, http.StatusInternalServerError, "failed to add event type")
-		ah.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "500").Inc()
+		ah.log.ErrorContext(r.Context(), "failed to add event type", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to add event type")
 		return
 	}
 
 	writeJSON(w, http.StatusCreated, id)
-	ah.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "201").Inc()
 }
 
 func (ah *AuxHandler) HandleCreateLocation(w http.ResponseWriter, r *http.Request) {
@@ -119,28 +127,24 @@ func (ah *AuxHandler) HandleCreateLocation(w http.ResponseWriter, r *http.Reques
 
 	var req openapi.NewLocationRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		ah.log.Warn("failed to decode request", slog.String("op", op), slog.Any("err", err))
-		writeError(w, http.StatusBadRequest, "invalid request body")
-		ah.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
+		ah.log.WarnContext(r.Context(), "failed to decode request", slog.String("op", op), slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
 	if req.GetName() == "" || req.GetRoute() == "" || req.GetFeatures() == "" {
-		writeError(w, http.StatusBadRequest, "missing required fields")
-		ah.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
+		writeError(w, r, http.StatusBadRequest, "missing required fields")
 		return
 	}
 
 	id, err := ah.auxService.AddLocation(ctx, req.GetName(), req.GetRoute(), req.GetFeatures())
 	if err != nil {
-		ah.log.Error("failed to add location", slog.String("op", op), slog.Any("err", err))
-		writeError(w, http.StatusInternalServerError, "failed to add location")
-		ah.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "500").Inc()
+		ah.log.ErrorContext(r.Context(), "failed to add location", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to add location")
 		return
 	}
 
 	writeJSON(w, http.StatusCreated, id)
-	ah.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "201").Inc()
 }
 
 func (ah *AuxHandler) HandleGetOrchestraInfo(w http.ResponseWriter, r *http.Request) {
@@ -153,15 +157,8 @@ func (ah *AuxHandler) HandleGetOrchestraInfo(w http.ResponseWriter, r *http.Requ
 
 	info, err := ah.auxService.GetOrchestraInfo(ctx, key)
 	if err != nil {
-		if errors.Is(err, service.ErrInfoNotFound) {
-			log.Error("info not found", slog.String("op", op), slog.Any("err", err))
-			writeError(w, http.StatusNotFound, "info not found")
-			ah.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "404").Inc()
-			return
-		}
-		log.Error("failed to get event", slog.String("error", err.Error()))
-		writeError(w, http.StatusInternalServerError, "failed to get info")
-		ah.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "500").Inc()
+		log.ErrorContext(r.Context(), "failed to get info", slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to get info")
 		return
 	}
 
@@ -171,5 +168,87 @@ func (ah *AuxHandler) HandleGetOrchestraInfo(w http.ResponseWriter, r *http.Requ
 	}
 
 	writeJSON(w, http.StatusOK, infoResponse)
-	ah.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "200").Inc()
+}
+
+func (ah *AuxHandler) HandleSetOrchestraInfo(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.auxiliary.HandleSetOrchestraInfo"
+	ctx := r.Context()
+
+	key := chi.URLParam(r, "key")
+
+	var req openapi.OrchestraInfoRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ah.log.WarnContext(r.Context(), "failed to decode request", slog.String("op", op), slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if key == "" || req.GetValue() == "" {
+		writeError(w, r, http.StatusBadRequest, "missing required fields")
+		return
+	}
+
+	if err := ah.auxService.AddOrchestraInfo(ctx, key, req.GetValue()); err != nil {
+		ah.log.ErrorContext(r.Context(), "failed to save info", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to save info")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (ah *AuxHandler) HandleSetEventTypeTranslation(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.auxiliary.HandleSetEventTypeTranslation"
+	ctx := r.Context()
+
+	typeID, err := strconv.Atoi(chi.URLParam(r, "typeId"))
+	if err != nil {
+		ah.log.WarnContext(r.Context(), "invalid event type id", slog.String("op", op), slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, "invalid event type")
+		return
+	}
+
+	var req openapi.NewEventTypeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ah.log.WarnContext(r.Context(), "failed to decode request", slog.String("op", op), slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	lang := chi.URLParam(r, "lang")
+	if err := ah.auxService.SetEventTypeTranslation(ctx, typeID, lang, req.GetName(), req.GetDescription()); err != nil {
+		ah.log.ErrorContext(r.Context(), "failed to save translation", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to save translation")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (ah *AuxHandler) HandleSetLocationTranslation(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.auxiliary.HandleSetLocationTranslation"
+	ctx := r.Context()
+
+	locationID, err := strconv.Atoi(chi.URLParam(r, "locationId"))
+	if err != nil {
+		ah.log.WarnContext(r.Context(), "invalid location id", slog.String("op", op), slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, "invalid location id")
+		return
+	}
+
+	var req openapi.NewLocationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ah.log.WarnContext(r.Context(), "failed to decode request", slog.String("op", op), slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	lang := chi.URLParam(r, "lang")
+	if err := ah.auxService.SetLocationTranslation(ctx, locationID, lang, req.GetName(), req.GetRoute(), req.GetFeatures()); err != nil {
+		ah.log.ErrorContext(r.Context(), "failed to save translation", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to save translation")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
 }
@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// versionETag is the strong entity tag of a single versioned resource.
+func versionETag(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+// collectionETag is a weak entity tag for a listing, derived from the id and version of every
+// item, so it changes whenever an item is added, removed or modified. variant distinguishes
+// representations of the same items, such as the language they are translated to.
+func collectionETag(variant string, ids, versions []int) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s;", variant)
+	for i := range ids {
+		fmt.Fprintf(h, "%d:%d;", ids[i], versions[i])
+	}
+
+	return `W/"` + hex.EncodeToString(h.Sum(nil))[:16] + `"`
+}
+
+// RequireIfMatch answers 428 to a write that carries no If-Match, so a client can not
+// overwrite a version it has not seen. With required unset the write goes on unconditional.
+func RequireIfMatch(required bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if !required {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if strings.TrimSpace(r.Header.Get("If-Match")) == "" {
+				writeProblem(w, r, http.StatusPreconditionRequired, "precondition_required", "If-Match header is required", nil)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// ifMatchVersion reads the version the client expects from If-Match. A missing header or "*"
+// yields 0, which means the write is unconditional; see RequireIfMatch. ok is false when the header can never
+// match a version, e.g. a weak tag or a list of tags, and the request must fail with 412.
+func ifMatchVersion(r *http.Request) (version int, ok bool) {
+	header := strings.TrimSpace(r.Header.Get("If-Match"))
+	if header == "" || header == "*" {
+		return 0, true
+	}
+
+	if len(header) < 2 || header[0] != '"' || header[len(header)-1] != '"' {
+		return 0, false
+	}
+
+	version, err := strconv.Atoi(header[1 : len(header)-1])
+	if err != nil || version <= 0 {
+		return 0, false
+	}
+
+	return version, true
+}
+
+// notModified sets the ETag header and, if it matches If-None-Match, answers 304 and reports
+// true so the caller skips the body. Tags are compared weakly, as RFC 9110 requires for GET.
+func notModified(w http.ResponseWriter, r *http.Request, etag string) bool {
+	w.Header().Set("ETag", etag)
+
+	header := r.Header.Get("If-None-Match")
+	if header == "" {
+		return false
+	}
+
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == strings.TrimPrefix(etag, "W/") {
+			w.WriteHeader(http.StatusNotModified)
+			return true
+		}
+	}
+
+	return false
+}
@@ -3,9 +3,9 @@ package handler
 import (
 	"encoding/json"
 	"errors"
-	"github.com/Ilya-Repin/orchestra_api/internal/infra/metrics"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/export"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
 	"github.com/Ilya-Repin/orchestra_api/internal/openapi"
-	"github.com/Ilya-Repin/orchestra_api/internal/service"
 	"github.com/Ilya-Repin/orchestra_api/internal/service/events"
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
@@ -18,11 +18,10 @@ import (
 type EventsHandler struct {
 	log          *slog.Logger
 	eventService *events.Service
-	metrics      *metrics.Metrics
 }
 
-func NewEventsHandler(log *slog.Logger, es *events.Service, metrics *metrics.Metrics) *EventsHandler {
-	return &EventsHandler{log: log, eventService: es, metrics: metrics}
+func NewEventsHandler(log *slog.Logger, es *events.Service) *EventsHandler {
+	return &EventsHandler{log: log, eventService: es}
 }
 
 func (eh *EventsHandler) HandleCreateEvent(w http.ResponseWriter, r *http.Request) {
@@ -32,23 +31,15 @@ func (eh *EventsHandler) HandleCreateEvent(w http.ResponseWriter, r *http.Reques
 
 	var req openapi.NewEventRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		log.Error("invalid request body", slog.String("op", op), slog.Any("err", err))
-		writeError(w, http.StatusBadRequest, "invalid request body")
-		eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
+		log.ErrorContext(r.Context(), "invalid request body", slog.String("op", op), slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
 	eventID, err := eh.eventService.AddEvent(ctx, req.GetTitle(), req.GetDescription(), int(req.GetEventType()), req.GetEventDate(), int(req.GetLocation()), int(req.GetCapacity()))
 	if err != nil {
-		if errors.Is(err, service.ErrEventNotFound) {
-			log.Error("event not found", slog.String("op", op), slog.Any("err", err))
-			writeError(w, http.StatusNotFound, "event not found")
-			eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "404").Inc()
-			return
-		}
-		log.Error("failed to add event", slog.String("error", err.Error()))
-		writeError(w, http.StatusInternalServerError, "failed to add event")
-		eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "500").Inc()
+		log.ErrorContext(r.Context(), "failed to add event", slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to add event")
 		return
 	}
 
@@ -61,56 +52,18 @@ func (eh *EventsHandler) HandleGetEvents(w http.ResponseWriter, r *http.Request)
 	log := eh.log.With(slog.String("op", op))
 	ctx := r.Context()
 
-	eventTypeStr := r.URL.Query().Get("type")
-	dateFromStr := r.URL.Query().Get("date_from")
-	dateToStr := r.URL.Query().Get("date_to")
-
-	var (
-		eventType *int
-		begin     *time.Time
-		end       *time.Time
-	)
-
-	if eventTypeStr != "" {
-		et, err := strconv.Atoi(eventTypeStr)
-		if err != nil {
-			log.Error("invalid event type", slog.String("op", op), slog.Any("err", err))
-			writeError(w, http.StatusBadRequest, "invalid event type")
-			eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
-			return
-		}
-		eventType = &et
-	}
-
-	if dateFromStr != "" {
-		t, err := time.Parse(time.RFC3339, dateFromStr)
-		if err != nil {
-			log.Error("invalid date_from", slog.String("op", op), slog.Any("err", err))
-			writeError(w, http.StatusBadRequest, "invalid date_from")
-			eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
-			return
-		}
-		begin = &t
-	}
-
-	if dateToStr != "" {
-		t, err := time.Parse(time.RFC3339, dateToStr)
-		if err != nil {
-			log.Error("invalid date_to", slog.String("op", op), slog.Any("err", err))
-			writeError(w, http.StatusBadRequest, "invalid date_to")
-			eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
-			return
-		}
-		end = &t
+	eventType, begin, end, err := parseEventFilters(r)
+	if err != nil {
+		log.ErrorContext(r.Context(), "invalid filters", slog.String("op", op), slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return
 	}
 
 	readEvents, err := eh.eventService.GetEvents(ctx, eventType, begin, end)
 
 	if err != nil {
-		log.Error("failed to get events", slog.String("op", op), slog.Any("err", err))
-		writeError(w, http.StatusInternalServerError, "failed to get events")
-		eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "500").Inc()
-
+		log.ErrorContext(r.Context(), "failed to get events", slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to get events")
 		return
 	}
 
@@ -134,7 +87,44 @@ func (eh *EventsHandler) HandleGetEvents(w http.ResponseWriter, r *http.Request)
 	}
 
 	writeJSON(w, http.StatusOK, eventResponses)
-	eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "200").Inc()
+}
+
+func (eh *EventsHandler) HandleExportEvents(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.events.HandleExportEvents"
+
+	log := eh.log.With(slog.String("op", op))
+	ctx := r.Context()
+
+	eventType, begin, end, err := parseEventFilters(r)
+	if err != nil {
+		log.ErrorContext(r.Context(), "invalid filters", slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cols, err := export.SelectColumns(eventColumns, r.URL.Query().Get("columns"))
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	stream, dates, err := newExportStream(w, r, "events", export.Header(cols))
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err = eh.eventService.ExportEvents(ctx, eventType, begin, end, func(e model.Event) error {
+		return stream.row(export.Row(cols, e, dates))
+	})
+	if err == nil {
+		err = stream.finish()
+	}
+	if err != nil {
+		log.ErrorContext(r.Context(), "failed to export events", slog.Any("err", err))
+		stream.fail(err, "failed to export events")
+		return
+	}
 }
 
 func (eh *EventsHandler) HandleGetUpcomingEvents(w http.ResponseWriter, r *http.Request) {
@@ -146,10 +136,8 @@ func (eh *EventsHandler) HandleGetUpcomingEvents(w http.ResponseWriter, r *http.
 	readEvents, err := eh.eventService.GetUpcomingEvents(ctx)
 
 	if err != nil {
-		log.Error("failed to get upcoming events", slog.String("op", op), slog.Any("err", err))
-		writeError(w, http.StatusInternalServerError, "failed to get upcoming events")
-		eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "500").Inc()
-
+		log.ErrorContext(r.Context(), "failed to get upcoming events", slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to get upcoming events")
 		return
 	}
 
@@ -173,7 +161,6 @@ func (eh *EventsHandler) HandleGetUpcomingEvents(w http.ResponseWriter, r *http.
 	}
 
 	writeJSON(w, http.StatusOK, eventResponses)
-	eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "200").Inc()
 }
 
 func (eh *EventsHandler) HandleGetAvailableEvents(w http.ResponseWriter, r *http.Request) {
@@ -184,23 +171,15 @@ func (eh *EventsHandler) HandleGetAvailableEvents(w http.ResponseWriter, r *http
 
 	memberID, err := uuid.Parse(r.URL.Query().Get("memberId"))
 	if err != nil {
-		writeError(w, http.StatusBadRequest, "wrong format memberId")
-		eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
+		writeError(w, r, http.StatusBadRequest, "wrong format memberId")
 		return
 	}
 
 	readEvents, err := eh.eventService.GetAvailableEvents(ctx, memberID)
 
 	if err != nil {
-		if errors.Is(err, service.ErrMemberNotFound) {
-			writeError(w, http.StatusNotFound, "member not found")
-			eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "404").Inc()
-			return
-		}
-
-		log.Error("failed to get available events", slog.String("op", op), slog.Any("err", err))
-		writeError(w, http.StatusInternalServerError, "failed to get available events")
-		eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "500").Inc()
+		log.ErrorContext(r.Context(), "failed to get available events", slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to get available events")
 		return
 	}
 
@@ -224,7 +203,6 @@ func (eh *EventsHandler) HandleGetAvailableEvents(w http.ResponseWriter, r *http
 	}
 
 	writeJSON(w, http.StatusOK, eventResponses)
-	eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "200").Inc()
 }
 
 func (eh *EventsHandler) HandleGetRegisteredEvents(w http.ResponseWriter, r *http.Request) {
@@ -235,23 +213,15 @@ func (eh *EventsHandler) HandleGetRegisteredEvents(w http.ResponseWriter, r *htt
 
 	memberID, err := uuid.Parse(r.URL.Query().Get("memberId"))
 	if err != nil {
-		writeError(w, http.StatusBadRequest, "wrong format memberId")
-		eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
+		writeError(w, r, http.StatusBadRequest, "wrong format memberId")
 		return
 	}
 
 	readEvents, err := eh.eventService.GetRegisteredEvents(ctx, memberID)
 
 	if err != nil {
-		if errors.Is(err, service.ErrMemberNotFound) {
-			writeError(w, http.StatusNotFound, "member not found")
-			eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "404").Inc()
-			return
-		}
-
-		log.Error("failed to get registered events", slog.String("op", op), slog.Any("err", err))
-		writeError(w, http.StatusInternalServerError, "failed to get registered events")
-		eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "500").Inc()
+		log.ErrorContext(r.Context(), "failed to get registered events", slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to get registered events")
 		return
 	}
 
@@ -275,7 +245,6 @@ func (eh *EventsHandler) HandleGetRegisteredEvents(w http.ResponseWriter, r *htt
 	}
 
 	writeJSON(w, http.StatusOK, eventResponses)
-	eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "200").Inc()
 }
 
 func (eh *EventsHandler) HandleGetEvent(w http.ResponseWriter, r *http.Request) {
@@ -287,25 +256,21 @@ func (eh *EventsHandler) HandleGetEvent(w http.ResponseWriter, r *http.Request)
 	eventIDStr := chi.URLParam(r, "eventId")
 	eventID, err := strconv.Atoi(eventIDStr)
 	if err != nil {
-		log.Error("invalid event id", slog.String("op", op), slog.Any("err", err))
-		writeError(w, http.StatusBadRequest, "invalid event id")
-		eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
+		log.ErrorContext(r.Context(), "invalid event id", slog.String("op", op), slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, "invalid event id")
 		return
 	}
 
 	e, err := eh.eventService.GetEvent(ctx, eventID)
 	if err != nil {
-		if errors.Is(err, service.ErrEventNotFound) {
-			log.Error("event not found", slog.String("op", op), slog.Any("err", err))
-			writeError(w, http.StatusNotFound, "event not found")
-			eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "404").Inc()
-			return
-		}
-		log.Error("failed to get event", slog.String("error", err.Error()))
-		writeError(w, http.StatusInternalServerError, "failed to get event")
-		eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "500").Inc()
+		log.ErrorContext(r.Context(), "failed to get event", slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to get event")
+		return
+	}
+	if notModified(w, r, versionETag(e.Version)) {
 		return
 	}
+
 	id := int32(e.ID)
 	eventTypeId := int32(e.EventType.ID)
 	locId := int32(e.Location.ID)
@@ -321,9 +286,14 @@ func (eh *EventsHandler) HandleGetEvent(w http.ResponseWriter, r *http.Request)
 		CreatedAt:   &e.CreatedAt,
 		UpdatedAt:   &e.UpdatedAt,
 	}
+	if e.Price > 0 {
+		eventResponse.SetPrice(e.Price)
+	}
+	if e.Access != (model.EventAccess{}) {
+		eventResponse.SetAccess(eventAccessResponse(e.Access))
+	}
 
 	writeJSON(w, http.StatusOK, eventResponse)
-	eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "200").Inc()
 }
 
 func (eh *EventsHandler) HandleUpdateEvent(w http.ResponseWriter, r *http.Request) {
@@ -334,36 +304,213 @@ func (eh *EventsHandler) HandleUpdateEvent(w http.ResponseWriter, r *http.Reques
 	eventIDStr := chi.URLParam(r, "eventId")
 	eventID, err := strconv.Atoi(eventIDStr)
 	if err != nil {
-		log.Error("invalid event id", slog.String("op", op), slog.Any("err", err))
-		writeError(w, http.StatusBadRequest, "invalid event id")
-		eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
+		log.ErrorContext(r.Context(), "invalid event id", slog.String("op", op), slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, "invalid event id")
 		return
 	}
 
 	var req openapi.UpdateEventRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		log.Error("invalid request body", slog.String("op", op), slog.Any("err", err))
-		writeError(w, http.StatusBadRequest, "invalid request body")
-		eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
+		log.ErrorContext(r.Context(), "invalid request body", slog.String("op", op), slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	version, ok := ifMatchVersion(r)
+	if !ok {
+		writeError(w, r, http.StatusPreconditionFailed, "event was modified")
 		return
 	}
 
-	err = eh.eventService.UpdateEvent(ctx, eventID, req.GetTitle(), req.GetDescription(), int(req.GetEventType()), req.GetEventDate(), int(req.GetLocation()), int(req.GetCapacity()))
+	newVersion, err := eh.eventService.UpdateEvent(ctx, eventID, req.GetTitle(), req.GetDescription(), int(req.GetEventType()), req.GetEventDate(), int(req.GetLocation()), int(req.GetCapacity()), version)
 	if err != nil {
-		if errors.Is(err, service.ErrEventNotFound) {
-			log.Error("event not found", slog.String("op", op), slog.Any("err", err))
-			writeError(w, http.StatusNotFound, "event not found")
-			eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "404").Inc()
+		log.ErrorContext(r.Context(), "failed to update event", slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to update event")
+		return
+	}
+
+	w.Header().Set("ETag", versionETag(newVersion))
+	writeJSON(w, http.StatusOK, eventID)
+}
+
+func (eh *EventsHandler) HandlePatchEvent(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.events.HandlePatchEvent"
+	log := eh.log.With(slog.String("op", op))
+	ctx := r.Context()
+
+	eventID, err := strconv.Atoi(chi.URLParam(r, "eventId"))
+	if err != nil {
+		log.ErrorContext(r.Context(), "invalid event id", slog.String("op", op), slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, "invalid event id")
+		return
+	}
+
+	version, ok := ifMatchVersion(r)
+	if !ok {
+		writeError(w, r, http.StatusPreconditionFailed, "event was modified")
+		return
+	}
+
+	fields, err := decodeMergePatch(r)
+	if err != nil {
+		if errors.Is(err, errUnsupportedPatchType) {
+			writeError(w, r, http.StatusUnsupportedMediaType, err.Error())
 			return
 		}
-		log.Error("failed to update event", slog.String("error", err.Error()))
-		writeError(w, http.StatusInternalServerError, "failed to update event")
-		eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "500").Inc()
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	patch, err := eventPatchFromJSON(fields)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	newVersion, err := eh.eventService.PatchEvent(ctx, eventID, patch, version)
+	if err != nil {
+		log.ErrorContext(r.Context(), "failed to update event", slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to update event")
+		return
+	}
+
+	w.Header().Set("ETag", versionETag(newVersion))
+	writeJSON(w, http.StatusOK, eventID)
+}
+
+// HandleSetEventAccess replaces the membership limits on registration. Omitted fields are
+// cleared, so an empty object opens the event to every approved member.
+func (eh *EventsHandler) HandleSetEventAccess(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.events.HandleSetEventAccess"
+	log := eh.log.With(slog.String("op", op))
+
+	eventID, err := strconv.Atoi(chi.URLParam(r, "eventId"))
+	if err != nil {
+		log.ErrorContext(r.Context(), "invalid event id", slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, "invalid event id")
+		return
+	}
+
+	var req openapi.EventAccess
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.ErrorContext(r.Context(), "invalid request body", slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	version, ok := ifMatchVersion(r)
+	if !ok {
+		writeError(w, r, http.StatusPreconditionFailed, "event was modified")
 		return
 	}
 
+	access := model.EventAccess{
+		MinTier:       model.Tier(req.GetMinTier()),
+		PriorityTier:  model.Tier(req.GetPriorityTier()),
+		PriorityUntil: req.PriorityUntil,
+	}
+
+	newVersion, err := eh.eventService.SetEventAccess(r.Context(), eventID, access, version)
+	if err != nil {
+		log.ErrorContext(r.Context(), "failed to set event access", slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to set event access")
+		return
+	}
+
+	w.Header().Set("ETag", versionETag(newVersion))
 	writeJSON(w, http.StatusOK, eventID)
-	eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "200").Inc()
+}
+
+// HandleSetEventPrice sets the fee for registering, in kopecks. A zero price makes the event
+// free again.
+func (eh *EventsHandler) HandleSetEventPrice(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.events.HandleSetEventPrice"
+	log := eh.log.With(slog.String("op", op))
+
+	eventID, err := strconv.Atoi(chi.URLParam(r, "eventId"))
+	if err != nil {
+		log.ErrorContext(r.Context(), "invalid event id", slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, "invalid event id")
+		return
+	}
+
+	var req openapi.EventPrice
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.ErrorContext(r.Context(), "invalid request body", slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	version, ok := ifMatchVersion(r)
+	if !ok {
+		writeError(w, r, http.StatusPreconditionFailed, "event was modified")
+		return
+	}
+
+	newVersion, err := eh.eventService.SetEventPrice(r.Context(), eventID, req.GetPrice(), version)
+	if err != nil {
+		log.ErrorContext(r.Context(), "failed to set event price", slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to set event price")
+		return
+	}
+
+	w.Header().Set("ETag", versionETag(newVersion))
+	writeJSON(w, http.StatusOK, eventID)
+}
+
+// HandleSetEventCapacity sets the number of seats. Lowering it below the active registrations
+// takes force; then the latest registrations are cancelled and listed in the answer.
+func (eh *EventsHandler) HandleSetEventCapacity(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.events.HandleSetEventCapacity"
+	log := eh.log.With(slog.String("op", op))
+
+	eventID, err := strconv.Atoi(chi.URLParam(r, "eventId"))
+	if err != nil {
+		log.ErrorContext(r.Context(), "invalid event id", slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, "invalid event id")
+		return
+	}
+
+	var req openapi.EventCapacity
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.ErrorContext(r.Context(), "invalid request body", slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	version, ok := ifMatchVersion(r)
+	if !ok {
+		writeError(w, r, http.StatusPreconditionFailed, "event was modified")
+		return
+	}
+
+	change, err := eh.eventService.SetEventCapacity(r.Context(), eventID, int(req.GetCapacity()), version, req.GetForce())
+	if err != nil {
+		log.ErrorContext(r.Context(), "failed to set event capacity", slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to set event capacity")
+		return
+	}
+
+	bumped := make([]string, 0, len(change.Bumped))
+	for _, id := range change.Bumped {
+		bumped = append(bumped, id.String())
+	}
+
+	w.Header().Set("ETag", versionETag(change.Version))
+	writeJSON(w, http.StatusOK, openapi.NewEventCapacityResponse(int32(change.Capacity), int32(change.FreedSeats()), bumped))
+}
+
+func eventAccessResponse(a model.EventAccess) openapi.EventAccess {
+	var resp openapi.EventAccess
+	if a.MinTier != "" {
+		resp.SetMinTier(string(a.MinTier))
+	}
+	if a.PriorityTier != "" {
+		resp.SetPriorityTier(string(a.PriorityTier))
+	}
+	resp.PriorityUntil = a.PriorityUntil
+
+	return resp
 }
 
 func (eh *EventsHandler) HandleDeleteEvent(w http.ResponseWriter, r *http.Request) {
@@ -374,26 +521,62 @@ func (eh *EventsHandler) HandleDeleteEvent(w http.ResponseWriter, r *http.Reques
 	eventIDStr := chi.URLParam(r, "eventId")
 	eventID, err := strconv.Atoi(eventIDStr)
 	if err != nil {
-		log.Error("invalid event id", slog.String("op", op), slog.Any("err", err))
-		writeError(w, http.StatusBadRequest, "invalid event id")
-		eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
+		log.ErrorContext(r.Context(), "invalid event id", slog.String("op", op), slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, "invalid event id")
+		return
+	}
+
+	version, ok := ifMatchVersion(r)
+	if !ok {
+		writeError(w, r, http.StatusPreconditionFailed, "event was modified")
 		return
 	}
 
-	err = eh.eventService.DeleteEvent(ctx, eventID)
+	err = eh.eventService.DeleteEvent(ctx, eventID, version)
 	if err != nil {
-		if errors.Is(err, service.ErrEventNotFound) {
-			log.Error("event not found", slog.String("op", op), slog.Any("err", err))
-			writeError(w, http.StatusNotFound, "event not found")
-			eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "404").Inc()
-			return
-		}
-		log.Error("failed to delete event", slog.String("error", err.Error()))
-		writeError(w, http.StatusInternalServerError, "failed to delete event")
-		eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "500").Inc()
+		log.ErrorContext(r.Context(), "failed to delete event", slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to delete event")
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
-	eh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "200").Inc()
+}
+
+// parseEventFilters reads the optional type, date_from and date_to filters shared by the events list and its export.
+func parseEventFilters(r *http.Request) (eventType *int, begin, end *time.Time, err error) {
+	if eventTypeStr := r.URL.Query().Get("type"); eventTypeStr != "" {
+		et, err := strconv.Atoi(eventTypeStr)
+		if err != nil {
+			return nil, nil, nil, errors.New("invalid event type")
+		}
+		eventType = &et
+	}
+
+	begin, end, err = parseDateRange(r)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return eventType, begin, end, nil
+}
+
+// parseDateRange reads the optional date_from and date_to parameters.
+func parseDateRange(r *http.Request) (begin, end *time.Time, err error) {
+	if dateFromStr := r.URL.Query().Get("date_from"); dateFromStr != "" {
+		t, err := time.Parse(time.RFC3339, dateFromStr)
+		if err != nil {
+			return nil, nil, errors.New("invalid date_from")
+		}
+		begin = &t
+	}
+
+	if dateToStr := r.URL.Query().Get("date_to"); dateToStr != "" {
+		t, err := time.Parse(time.RFC3339, dateToStr)
+		if err != nil {
+			return nil, nil, errors.New("invalid date_to")
+		}
+		end = &t
+	}
+
+	return begin, end, nil
 }
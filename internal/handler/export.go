@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/export"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/i18n"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+var errInvalidTimeZone = errors.New("invalid tz")
+
+// Free-text columns go through export.Text. Phones are stored normalized to "+" and digits,
+// so they cannot hold a formula and are left as they are.
+var memberColumns = []export.Column[model.Member]{
+	{Name: "id", Value: func(m model.Member, _ export.DateFormatter) string { return m.ID.String() }},
+	{Name: "full_name", Value: func(m model.Member, _ export.DateFormatter) string { return export.Text(m.FullName) }},
+	{Name: "email", Value: func(m model.Member, _ export.DateFormatter) string { return export.Text(m.Email) }},
+	{Name: "phone", Value: func(m model.Member, _ export.DateFormatter) string { return m.Phone }},
+	{Name: "status", Value: func(m model.Member, _ export.DateFormatter) string { return string(m.Status) }},
+	{Name: "created_at", Value: func(m model.Member, d export.DateFormatter) string { return d.Format(m.CreatedAt) }},
+	{Name: "updated_at", Value: func(m model.Member, d export.DateFormatter) string { return d.Format(m.UpdatedAt) }},
+}
+
+var eventColumns = []export.Column[model.Event]{
+	{Name: "id", Value: func(e model.Event, _ export.DateFormatter) string { return strconv.Itoa(e.ID) }},
+	{Name: "title", Value: func(e model.Event, _ export.DateFormatter) string { return export.Text(e.Title) }},
+	{Name: "description", Value: func(e model.Event, _ export.DateFormatter) string { return export.Text(e.Description) }},
+	{Name: "event_type", Value: func(e model.Event, _ export.DateFormatter) string { return e.EventType.Name }},
+	{Name: "event_date", Value: func(e model.Event, d export.DateFormatter) string { return d.Format(e.EventDate) }},
+	{Name: "location", Value: func(e model.Event, _ export.DateFormatter) string { return e.Location.Name }},
+	{Name: "capacity", Value: func(e model.Event, _ export.DateFormatter) string { return strconv.Itoa(e.Capacity) }},
+	{Name: "created_at", Value: func(e model.Event, d export.DateFormatter) string { return d.Format(e.CreatedAt) }},
+	{Name: "updated_at", Value: func(e model.Event, d export.DateFormatter) string { return d.Format(e.UpdatedAt) }},
+}
+
+var rosterColumns = []export.Column[model.RosterEntry]{
+	{Name: "member_id", Value: func(e model.RosterEntry, _ export.DateFormatter) string { return e.Member.ID.String() }},
+	{Name: "full_name", Value: func(e model.RosterEntry, _ export.DateFormatter) string { return export.Text(e.Member.FullName) }},
+	{Name: "email", Value: func(e model.RosterEntry, _ export.DateFormatter) string { return export.Text(e.Member.Email) }},
+	{Name: "phone", Value: func(e model.RosterEntry, _ export.DateFormatter) string { return e.Member.Phone }},
+	{Name: "registered_at", Value: func(e model.RosterEntry, d export.DateFormatter) string { return d.Format(e.RegisteredAt) }},
+}
+
+// exportStream sets the response headers and writes the header row lazily, so an error
+// returned before any byte reached the client can still be reported as a regular problem response.
+type exportStream struct {
+	w       *trackingWriter
+	r       *http.Request
+	writer  export.Writer
+	header  []string
+	name    string
+	started bool
+}
+
+type trackingWriter struct {
+	http.ResponseWriter
+	written bool
+}
+
+func (t *trackingWriter) Write(b []byte) (int, error) {
+	t.written = true
+	return t.ResponseWriter.Write(b)
+}
+
+func newExportStream(w http.ResponseWriter, r *http.Request, name string, header []string) (*exportStream, export.DateFormatter, error) {
+	tw := &trackingWriter{ResponseWriter: w}
+
+	writer, err := export.New(r.URL.Query().Get("format"), tw)
+	if err != nil {
+		return nil, export.DateFormatter{}, err
+	}
+
+	loc := time.UTC
+	if tz := r.URL.Query().Get("tz"); tz != "" {
+		loc, err = time.LoadLocation(tz)
+		if err != nil {
+			return nil, export.DateFormatter{}, fmt.Errorf("%w: %s", errInvalidTimeZone, tz)
+		}
+	}
+
+	l10n := i18n.FromContext(r.Context())
+
+	locale := r.URL.Query().Get("locale")
+	if locale == "" {
+		locale = string(l10n.Lang)
+	}
+
+	titles := make([]string, len(header))
+	for i, name := range header {
+		titles[i] = columnTitle(l10n, name)
+	}
+
+	stream := &exportStream{w: tw, r: r, writer: writer, header: titles, name: name}
+
+	return stream, export.NewDateFormatter(locale, loc), nil
+}
+
+func (s *exportStream) start() error {
+	s.started = true
+	s.w.Header().Set("Content-Type", s.writer.ContentType())
+	s.w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, s.name, s.writer.Extension()))
+
+	return s.writer.WriteRow(s.header)
+}
+
+func (s *exportStream) row(values []string) error {
+	if !s.started {
+		if err := s.start(); err != nil {
+			return err
+		}
+	}
+
+	return s.writer.WriteRow(values)
+}
+
+func (s *exportStream) finish() error {
+	if !s.started {
+		if err := s.start(); err != nil {
+			return err
+		}
+	}
+
+	return s.writer.Close()
+}
+
+// fail reports err to the client unless part of the file was already sent,
+// in which case the response can only be cut short.
+func (s *exportStream) fail(err error, fallback string) {
+	if s.w.written {
+		return
+	}
+
+	s.w.Header().Del("Content-Disposition")
+	writeServiceError(s.w, s.r, err, fallback)
+}
@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"github.com/Ilya-Repin/orchestra_api/internal/service/health"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// The probes and the status report are served outside the versioned api, next to /metrics,
+// so their responses are not part of the OpenAPI description.
+
+type checkResponse struct {
+	Name      string  `json:"name"`
+	OK        bool    `json:"ok"`
+	LatencyMs float64 `json:"latency_ms"`
+	Detail    string  `json:"detail,omitempty"`
+}
+
+type readinessResponse struct {
+	Ready  bool            `json:"ready"`
+	Checks []checkResponse `json:"checks"`
+}
+
+type buildResponse struct {
+	Version   string `json:"version"`
+	GoVersion string `json:"go_version,omitempty"`
+	Revision  string `json:"revision,omitempty"`
+	BuildTime string `json:"build_time,omitempty"`
+}
+
+type poolResponse struct {
+	MaxOpen        int     `json:"max_open"`
+	Open           int     `json:"open"`
+	InUse          int     `json:"in_use"`
+	Idle           int     `json:"idle"`
+	WaitCount      int64   `json:"wait_count"`
+	WaitDurationMs float64 `json:"wait_duration_ms"`
+}
+
+type statusResponse struct {
+	Build         buildResponse     `json:"build"`
+	StartedAt     time.Time         `json:"started_at"`
+	UptimeSeconds int64             `json:"uptime_seconds"`
+	Draining      bool              `json:"draining"`
+	Readiness     readinessResponse `json:"readiness"`
+	Pool          poolResponse      `json:"pool"`
+	Config        map[string]any    `json:"config"`
+}
+
+type HealthHandler struct {
+	log           *slog.Logger
+	healthService *health.Service
+}
+
+func NewHealthHandler(log *slog.Logger, hs *health.Service) *HealthHandler {
+	return &HealthHandler{log: log, healthService: hs}
+}
+
+// HandleLive answers as long as the process serves requests.
+func (hh *HealthHandler) HandleLive(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// HandleReady answers 503 when the replica should not receive traffic.
+func (hh *HealthHandler) HandleReady(w http.ResponseWriter, r *http.Request) {
+	readiness := hh.healthService.Ready(r.Context())
+
+	status := http.StatusOK
+	if !readiness.Ready {
+		status = http.StatusServiceUnavailable
+	}
+
+	writeJSON(w, status, toReadinessResponse(readiness))
+}
+
+func (hh *HealthHandler) HandleGetStatus(w http.ResponseWriter, r *http.Request) {
+	st := hh.healthService.Status(r.Context())
+
+	writeJSON(w, http.StatusOK, statusResponse{
+		Build: buildResponse{
+			Version:   st.Build.Version,
+			GoVersion: st.Build.GoVersion,
+			Revision:  st.Build.Revision,
+			BuildTime: st.Build.BuildTime,
+		},
+		StartedAt:     st.StartedAt,
+		UptimeSeconds: *seconds(&st.Uptime),
+		Draining:      st.Draining,
+		Readiness:     toReadinessResponse(st.Readiness),
+		Pool: poolResponse{
+			MaxOpen:        st.Pool.MaxOpen,
+			Open:           st.Pool.Open,
+			InUse:          st.Pool.InUse,
+			Idle:           st.Pool.Idle,
+			WaitCount:      st.Pool.WaitCount,
+			WaitDurationMs: milliseconds(st.Pool.WaitDuration),
+		},
+		Config: st.Config,
+	})
+}
+
+func toReadinessResponse(readiness model.Readiness) readinessResponse {
+	resp := readinessResponse{Ready: readiness.Ready, Checks: make([]checkResponse, 0, len(readiness.Checks))}
+	for _, c := range readiness.Checks {
+		resp.Checks = append(resp.Checks, checkResponse{
+			Name:      c.Name,
+			OK:        c.OK,
+			LatencyMs: milliseconds(c.Latency),
+			Detail:    c.Detail,
+		})
+	}
+
+	return resp
+}
+
+// milliseconds converts a duration for a response, keeping a tenth of a millisecond.
+func milliseconds(d time.Duration) float64 {
+	return float64(d.Round(100*time.Microsecond)) / float64(time.Millisecond)
+}
@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"context"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/i18n"
+	"github.com/google/uuid"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LanguageGetter looks up the language preference of the member a request is about.
+type LanguageGetter interface {
+	GetLanguage(ctx context.Context, id uuid.UUID) (string, error)
+}
+
+// Language picks the language of every response and stores its localizer in the request
+// context, where writeProblem and the export handlers find it. Member preferences are
+// remembered for cacheTTL, so a change of language takes up to that long to show.
+type Language struct {
+	log      *slog.Logger
+	catalog  *i18n.Catalog
+	members  LanguageGetter
+	cacheTTL time.Duration
+
+	mu     sync.Mutex
+	cached map[uuid.UUID]cachedLanguage
+	calls  int
+}
+
+type cachedLanguage struct {
+	language string
+	expires  time.Time
+}
+
+func NewLanguage(log *slog.Logger, catalog *i18n.Catalog, members LanguageGetter, cacheTTL time.Duration) *Language {
+	return &Language{
+		log:      log.With("component", "i18n"),
+		catalog:  catalog,
+		members:  members,
+		cacheTTL: cacheTTL,
+		cached:   make(map[uuid.UUID]cachedLanguage),
+	}
+}
+
+// Middleware negotiates the language: the preference of the member the request is about
+// wins, since the bot talks to the API on the member's behalf; then Accept-Language; then
+// the default language.
+func (l *Language) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lang := l.negotiate(r)
+
+		w.Header().Set("Content-Language", string(lang))
+		w.Header().Add("Vary", "Accept-Language")
+
+		next.ServeHTTP(w, r.WithContext(i18n.WithLocalizer(r.Context(), l.catalog.Localizer(lang))))
+	})
+}
+
+func (l *Language) negotiate(r *http.Request) i18n.Lang {
+	const op = "handlers.i18n.negotiate"
+
+	if id, err := uuid.Parse(languageMemberID(r)); err == nil {
+		language, err := l.memberLanguage(r.Context(), id)
+		if err != nil {
+			l.log.DebugContext(r.Context(), "member language unavailable", slog.String("op", op), slog.Any("err", err))
+		} else if lang, ok := i18n.Parse(language); ok {
+			return lang
+		}
+	}
+
+	if lang, ok := i18n.Negotiate(r.Header.Get("Accept-Language")); ok {
+		return lang
+	}
+
+	return i18n.Default
+}
+
+// memberLanguage returns the language preference of a member, asking the members service
+// only when the remembered one is missing or expired. Failures are not remembered.
+func (l *Language) memberLanguage(ctx context.Context, id uuid.UUID) (string, error) {
+	now := time.Now()
+
+	l.mu.Lock()
+	l.calls++
+	if l.calls%10000 == 0 {
+		l.prune(now)
+	}
+	entry, ok := l.cached[id]
+	l.mu.Unlock()
+
+	if ok && now.Before(entry.expires) {
+		return entry.language, nil
+	}
+
+	language, err := l.members.GetLanguage(ctx, id)
+	if err != nil {
+		return "", err
+	}
+
+	l.mu.Lock()
+	l.cached[id] = cachedLanguage{language: language, expires: now.Add(l.cacheTTL)}
+	l.mu.Unlock()
+
+	return language, nil
+}
+
+// prune drops expired preferences, so members who stopped calling do not pile up.
+func (l *Language) prune(now time.Time) {
+	for id, entry := range l.cached {
+		if !now.Before(entry.expires) {
+			delete(l.cached, id)
+		}
+	}
+}
+
+// languageMemberID finds the member a request is about: the X-Member-Id header of /me, the
+// memberId query parameter or the path segment after /members/. Route parameters are not
+// parsed yet when the middleware runs.
+func languageMemberID(r *http.Request) string {
+	if id := r.Header.Get(memberIDHeader); id != "" {
+		return id
+	}
+	if id := r.URL.Query().Get("memberId"); id != "" {
+		return id
+	}
+
+	_, rest, found := strings.Cut(r.URL.Path, "/members/")
+	if !found {
+		return ""
+	}
+	id, _, _ := strings.Cut(rest, "/")
+
+	return id
+}
+
+// columnTitle is the header of an exported column in the language of the request.
+func columnTitle(loc i18n.Localizer, name string) string {
+	key := "column." + name
+	if title := loc.T(key); title != key {
+		return title
+	}
+
+	return name
+}
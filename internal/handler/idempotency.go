@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/Ilya-Repin/orchestra_api/internal/config"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+const (
+	idempotencyHeader    = "Idempotency-Key"
+	maxIdempotencyKeyLen = 255
+	maxIdempotentBody    = 10 << 20
+)
+
+type IdempotencyStorage interface {
+	ClaimIdempotencyKey(ctx context.Context, key, fingerprint string, ttl, lease time.Duration) (model.IdempotentResponse, bool, error)
+	SaveIdempotentResponse(ctx context.Context, resp model.IdempotentResponse) error
+	ReleaseIdempotencyKey(ctx context.Context, claim model.IdempotentResponse) error
+}
+
+type Idempotency struct {
+	log     *slog.Logger
+	storage IdempotencyStorage
+	ttl     time.Duration
+	lease   time.Duration
+}
+
+func NewIdempotency(log *slog.Logger, storage IdempotencyStorage, cfg config.IdempotencyConfig) *Idempotency {
+	return &Idempotency{
+		log:     log,
+		storage: storage,
+		ttl:     cfg.TTL,
+		lease:   cfg.Lease,
+	}
+}
+
+// Middleware makes requests carrying an Idempotency-Key header safe to retry. The first
+// response for a key is stored and replayed for later requests with the same method,
+// url and body; reusing the key for a different request is answered with 422.
+// Server errors are not stored, so the retry of a failed request runs again, and neither is
+// the outcome of a request whose key was taken over after its lease ran out.
+func (i *Idempotency) Middleware(next http.Handler) http.Handler {
+	const op = "handlers.idempotency.Middleware"
+
+	log := i.log.With(slog.String("op", op))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(idempotencyHeader)
+		if key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if len(key) > maxIdempotencyKeyLen {
+			writeError(w, r, http.StatusBadRequest, "idempotency key is too long")
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIdempotentBody))
+		if err != nil {
+			writeError(w, r, http.StatusBadRequest, "invalid request body")
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		fingerprint := requestFingerprint(r, body)
+
+		stored, claimed, err := i.storage.ClaimIdempotencyKey(r.Context(), key, fingerprint, i.ttl, i.lease)
+		if err != nil {
+			log.ErrorContext(r.Context(), "failed to claim idempotency key", slog.Any("err", err))
+			writeError(w, r, http.StatusInternalServerError, "failed to process idempotency key")
+			return
+		}
+
+		if !claimed {
+			switch {
+			case stored.Fingerprint != fingerprint:
+				writeProblem(w, r, http.StatusUnprocessableEntity, "idempotency_key_reused", "idempotency key was already used for a different request", nil)
+			case !stored.Completed:
+				writeProblem(w, r, http.StatusConflict, "idempotency_in_progress", "request with this idempotency key is still in progress", nil)
+			default:
+				for header, value := range map[string]string{
+					"Content-Type": stored.ContentType,
+					"Location":     stored.Location,
+					"ETag":         stored.ETag,
+				} {
+					if value != "" {
+						w.Header().Set(header, value)
+					}
+				}
+				w.Header().Set("Idempotent-Replayed", "true")
+				w.WriteHeader(stored.StatusCode)
+				w.Write(stored.Body)
+			}
+			return
+		}
+
+		rec := &recordingWriter{ResponseWriter: w, status: http.StatusOK}
+		finished := false
+		defer func() {
+			// the request context may already be cancelled, the outcome must be saved anyway
+			ctx := context.WithoutCancel(r.Context())
+
+			if !finished || rec.status >= http.StatusInternalServerError || rec.overflow {
+				if err := i.storage.ReleaseIdempotencyKey(ctx, stored); err != nil {
+					log.ErrorContext(r.Context(), "failed to release idempotency key", slog.Any("err", err))
+				}
+				return
+			}
+
+			stored.StatusCode = rec.status
+			stored.ContentType = rec.Header().Get("Content-Type")
+			stored.Location = rec.Header().Get("Location")
+			stored.ETag = rec.Header().Get("ETag")
+			stored.Body = rec.body.Bytes()
+			if err := i.storage.SaveIdempotentResponse(ctx, stored); err != nil {
+				log.ErrorContext(r.Context(), "failed to save idempotent response", slog.Any("err", err))
+			}
+		}()
+
+		next.ServeHTTP(rec, r)
+		finished = true
+	})
+}
+
+// recordingWriter passes the response through while keeping a copy of it.
+type recordingWriter struct {
+	http.ResponseWriter
+	status   int
+	body     bytes.Buffer
+	overflow bool
+	wrote    bool
+}
+
+func (rw *recordingWriter) WriteHeader(code int) {
+	if !rw.wrote {
+		rw.status = code
+		rw.wrote = true
+	}
+	rw.ResponseWriter.WriteHeader(code)
+}
+
+func (rw *recordingWriter) Write(b []byte) (int, error) {
+	rw.wrote = true
+	if rw.body.Len()+len(b) > maxIdempotentBody {
+		rw.overflow = true
+	} else {
+		rw.body.Write(b)
+	}
+	return rw.ResponseWriter.Write(b)
+}
+
+func requestFingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	io.WriteString(h, r.Method+" "+r.URL.RequestURI()+"\n")
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
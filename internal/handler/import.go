@@ -0,0 +1,220 @@
+package handler
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/i18n"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"github.com/Ilya-Repin/orchestra_api/internal/openapi"
+	"github.com/google/uuid"
+	"io"
+	"strings"
+)
+
+const maxImportSize = 5 << 20
+
+var (
+	errImportHeader        = errors.New("import file must have full_name, email and phone columns")
+	errPaymentImportHeader = errors.New("import file must have member_id or email, tier, amount and paid_at columns")
+)
+
+// readImportRows parses a csv file whose first line names the columns. full_name, email
+// and phone are required, status is optional; unknown columns are ignored. Columns may also
+// be named by their exported headers in any language, so an export can be imported back.
+func readImportRows(r io.Reader, loc i18n.Localizer) ([]model.ImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errImportHeader
+	}
+
+	columns := importColumns(header, []string{"full_name", "email", "phone", "status"}, loc)
+	for _, name := range []string{"full_name", "email", "phone"} {
+		if _, ok := columns[name]; !ok {
+			return nil, errImportHeader
+		}
+	}
+	field := columns.field
+
+	var rows []model.ImportRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid csv: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		rows = append(rows, model.ImportRow{
+			Line:     line,
+			FullName: field(record, "full_name"),
+			Email:    field(record, "email"),
+			Phone:    field(record, "phone"),
+			Status:   model.MemberStatus(strings.ToLower(strings.TrimSpace(field(record, "status")))),
+		})
+	}
+
+	return rows, nil
+}
+
+// readPaymentImportRows parses a csv file of dues. The member is named by member_id or email;
+// tier, amount and paid_at are required, reference and note are optional. Like member imports,
+// columns may be named by their exported headers in any language.
+func readPaymentImportRows(r io.Reader, loc i18n.Localizer) ([]model.PaymentImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errPaymentImportHeader
+	}
+
+	columns := importColumns(header, []string{"member_id", "email", "tier", "amount", "paid_at", "reference", "note"}, loc)
+	_, byID := columns["member_id"]
+	_, byEmail := columns["email"]
+	if !byID && !byEmail {
+		return nil, errPaymentImportHeader
+	}
+	for _, name := range []string{"tier", "amount", "paid_at"} {
+		if _, ok := columns[name]; !ok {
+			return nil, errPaymentImportHeader
+		}
+	}
+	field := columns.field
+
+	var rows []model.PaymentImportRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid csv: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		rows = append(rows, model.PaymentImportRow{
+			Line:      line,
+			MemberID:  field(record, "member_id"),
+			Email:     field(record, "email"),
+			Tier:      field(record, "tier"),
+			Amount:    field(record, "amount"),
+			PaidAt:    field(record, "paid_at"),
+			Reference: field(record, "reference"),
+			Note:      field(record, "note"),
+		})
+	}
+
+	return rows, nil
+}
+
+// csvColumns maps column names to their positions in an import file.
+type csvColumns map[string]int
+
+// importColumns locates the known columns in a header row. A column is found by its name or by
+// its exported title in any language; unknown columns are ignored.
+func importColumns(header []string, names []string, loc i18n.Localizer) csvColumns {
+	aliases := make(map[string]string)
+	for _, name := range names {
+		for _, title := range loc.Variants("column." + name) {
+			aliases[strings.ToLower(title)] = name
+		}
+	}
+
+	columns := make(csvColumns, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if alias, ok := aliases[name]; ok {
+			name = alias
+		}
+		columns[name] = i
+	}
+
+	return columns
+}
+
+// field returns the value of the named column in record, or "" when the column is missing.
+func (c csvColumns) field(record []string, name string) string {
+	i, ok := c[name]
+	if !ok || i >= len(record) {
+		return ""
+	}
+	return record[i]
+}
+
+func importReportResponse(report model.ImportReport) openapi.MemberImportReport {
+	resp := openapi.MemberImportReport{
+		DryRun:    report.DryRun,
+		Committed: report.Committed,
+		Total:     int32(len(report.Results)),
+		Failed:    int32(report.Failed()),
+		Results:   make([]openapi.MemberImportResult, 0, len(report.Results)),
+	}
+
+	for _, res := range report.Results {
+		item := openapi.MemberImportResult{
+			Line:     int32(res.Line),
+			Outcome:  string(res.Outcome),
+			FullName: res.Member.FullName,
+			Email:    res.Member.Email,
+			Phone:    res.Member.Phone,
+			Status:   string(res.Member.Status),
+		}
+		if report.Committed && res.Outcome == model.ImportOK {
+			item.SetId(res.Member.ID.String())
+		}
+		resp.Results = append(resp.Results, item)
+	}
+
+	return resp
+}
+
+func paymentImportReportResponse(report model.PaymentImportReport) openapi.PaymentImportReport {
+	resp := openapi.PaymentImportReport{
+		DryRun:    report.DryRun,
+		Committed: report.Committed,
+		Total:     int32(len(report.Results)),
+		Failed:    int32(report.Failed()),
+		Results:   make([]openapi.PaymentImportResult, 0, len(report.Results)),
+	}
+
+	for _, res := range report.Results {
+		item := openapi.PaymentImportResult{Line: int32(res.Line), Outcome: string(res.Outcome)}
+		p := res.Payment
+		if p.MemberID != uuid.Nil {
+			item.SetMemberId(p.MemberID.String())
+		}
+		if res.Email != "" {
+			item.SetEmail(res.Email)
+		}
+		if p.Tier != "" {
+			item.SetTier(string(p.Tier))
+		}
+		if p.Amount > 0 {
+			item.SetAmount(p.Amount)
+		}
+		if !p.PaidAt.IsZero() {
+			item.SetPaidAt(p.PaidAt)
+		}
+		if !p.PeriodStart.IsZero() {
+			item.SetPeriodStart(p.PeriodStart)
+			item.SetPeriodEnd(p.PeriodEnd)
+		}
+		if p.Reference != "" {
+			item.SetReference(p.Reference)
+		}
+		if report.Committed && res.Outcome == model.ImportOK {
+			item.SetId(int32(p.ID))
+		}
+		resp.Results = append(resp.Results, item)
+	}
+
+	return resp
+}
@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"encoding/json"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"github.com/Ilya-Repin/orchestra_api/internal/openapi"
+	"github.com/Ilya-Repin/orchestra_api/internal/service/jobs"
+	"github.com/go-chi/chi/v5"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+type JobsHandler struct {
+	log         *slog.Logger
+	jobsService *jobs.Service
+}
+
+func NewJobsHandler(log *slog.Logger, js *jobs.Service) *JobsHandler {
+	return &JobsHandler{log: log, jobsService: js}
+}
+
+func (jh *JobsHandler) HandleGetJobs(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.jobs.HandleGetJobs"
+
+	query := r.URL.Query()
+	filter := model.JobFilter{
+		Status: model.JobStatus(query.Get("status")),
+		Kind:   query.Get("kind"),
+	}
+	if raw := query.Get("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit <= 0 {
+			writeError(w, r, http.StatusBadRequest, "invalid limit")
+			return
+		}
+		filter.Limit = limit
+	}
+
+	list, err := jh.jobsService.GetJobs(r.Context(), filter)
+	if err != nil {
+		jh.log.ErrorContext(r.Context(), "failed to get jobs", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to get jobs")
+		return
+	}
+
+	resp := make([]openapi.JobResponse, 0, len(list))
+	for _, job := range list {
+		resp = append(resp, jobResponse(job))
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (jh *JobsHandler) HandleRetryJob(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.jobs.HandleRetryJob"
+
+	jobID, err := strconv.ParseInt(chi.URLParam(r, "jobId"), 10, 64)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, "wrong format jobId")
+		return
+	}
+
+	job, err := jh.jobsService.RetryJob(r.Context(), jobID)
+	if err != nil {
+		jh.log.ErrorContext(r.Context(), "failed to retry job", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to retry job")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, jobResponse(job))
+}
+
+func jobResponse(job model.Job) openapi.JobResponse {
+	payload := map[string]interface{}{}
+	_ = json.Unmarshal(job.Payload, &payload)
+
+	resp := openapi.JobResponse{
+		Id:          job.ID,
+		Kind:        job.Kind,
+		Payload:     payload,
+		Status:      string(job.Status),
+		Attempts:    int32(job.Attempts),
+		MaxAttempts: int32(job.MaxAttempts),
+		RunAt:       job.RunAt,
+		CreatedAt:   job.CreatedAt,
+		UpdatedAt:   job.UpdatedAt,
+	}
+	if job.LastError != "" {
+		resp.SetLastError(job.LastError)
+	}
+	if job.LockedBy != "" {
+		resp.SetLockedBy(job.LockedBy)
+	}
+	if job.LockedAt != nil {
+		resp.SetLockedAt(*job.LockedAt)
+	}
+	if job.FinishedAt != nil {
+		resp.SetFinishedAt(*job.FinishedAt)
+	}
+
+	return resp
+}
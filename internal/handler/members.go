@@ -3,6 +3,8 @@ package handler
 import (
 	"encoding/json"
 	"errors"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/export"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/i18n"
 	"github.com/Ilya-Repin/orchestra_api/internal/infra/metrics"
 	"github.com/Ilya-Repin/orchestra_api/internal/model"
 	"github.com/Ilya-Repin/orchestra_api/internal/openapi"
@@ -12,6 +14,7 @@ import (
 	"github.com/google/uuid"
 	"log/slog"
 	"net/http"
+	"strconv"
 )
 
 type MembersHandler struct {
@@ -35,18 +38,26 @@ func (mh *MembersHandler) HandleGetMembers(w http.ResponseWriter, r *http.Reques
 	ctx := r.Context()
 
 	status := r.URL.Query().Get("status")
+	filter := model.MemberFilter{Status: model.MemberStatus(status)}
 
-	readMembers, err := mh.memberService.GetMembers(ctx, model.MemberStatus(status))
-	if err != nil {
-		if errors.Is(err, service.ErrUnknownStatus) {
-			log.Error("unknown status", slog.String("op", op), slog.String("status", status), slog.Any("err", err))
-			writeError(w, http.StatusBadRequest, "unknown status")
-			mh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
-		} else {
-			log.Error("failed to get members", slog.String("op", op), slog.String("status", status), slog.Any("err", err))
-			writeError(w, http.StatusInternalServerError, "failed to get readMembers")
-			mh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "500").Inc()
+	for param, dst := range map[string]**bool{"email_verified": &filter.EmailVerified, "phone_verified": &filter.PhoneVerified} {
+		raw := r.URL.Query().Get(param)
+		if raw == "" {
+			continue
+		}
+
+		verified, err := strconv.ParseBool(raw)
+		if err != nil {
+			writeError(w, r, http.StatusBadRequest, "invalid "+param)
+			return
 		}
+		*dst = &verified
+	}
+
+	readMembers, err := mh.memberService.GetMembers(ctx, filter)
+	if err != nil {
+		log.ErrorContext(r.Context(), "failed to get members", slog.String("status", status), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to get members")
 		return
 	}
 
@@ -55,19 +66,59 @@ func (mh *MembersHandler) HandleGetMembers(w http.ResponseWriter, r *http.Reques
 		id := m.ID.String()
 		statusStr := string(m.Status)
 
-		memberResponses = append(memberResponses, openapi.MemberResponse{
-			Id:        &id,
-			FullName:  m.FullName,
-			Email:     m.Email,
-			Phone:     m.Phone,
-			Status:    &statusStr,
-			CreatedAt: &m.CreatedAt,
-			UpdatedAt: &m.UpdatedAt,
-		})
+		response := openapi.MemberResponse{
+			Id:              &id,
+			FullName:        m.FullName,
+			Email:           m.Email,
+			Phone:           m.Phone,
+			Status:          &statusStr,
+			EmailVerifiedAt: m.EmailVerifiedAt,
+			PhoneVerifiedAt: m.PhoneVerifiedAt,
+			CreatedAt:       &m.CreatedAt,
+			UpdatedAt:       &m.UpdatedAt,
+		}
+		if m.Language != "" {
+			response.SetLanguage(m.Language)
+		}
+
+		memberResponses = append(memberResponses, response)
 	}
 
 	writeJSON(w, http.StatusOK, memberResponses)
-	mh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "200").Inc()
+}
+
+func (mh *MembersHandler) HandleExportMembers(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.members.HandleExportMembers"
+
+	log := mh.log.With(slog.String("op", op))
+	ctx := r.Context()
+
+	status := r.URL.Query().Get("status")
+
+	cols, err := export.SelectColumns(memberColumns, r.URL.Query().Get("columns"))
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	stream, dates, err := newExportStream(w, r, "members", export.Header(cols))
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err = mh.memberService.ExportMembers(ctx, model.MemberStatus(status), func(m model.Member) error {
+		return stream.row(export.Row(cols, m, dates))
+	})
+	if err == nil {
+		err = stream.finish()
+	}
+	if err != nil {
+		log.ErrorContext(r.Context(), "failed to export members", slog.String("status", status), slog.Any("err", err))
+
+		stream.fail(err, "failed to export members")
+		return
+	}
 }
 
 func (mh *MembersHandler) HandleCreateMember(w http.ResponseWriter, r *http.Request) {
@@ -76,43 +127,60 @@ func (mh *MembersHandler) HandleCreateMember(w http.ResponseWriter, r *http.Requ
 
 	var req openapi.NewMemberRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		mh.log.Warn("failed to decode request", slog.String("op", op), slog.Any("err", err))
-		writeError(w, http.StatusBadRequest, "invalid request body")
-		mh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
+		mh.log.WarnContext(r.Context(), "failed to decode request", slog.String("op", op), slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
 	if req.GetFullName() == "" || req.GetEmail() == "" || req.GetPhone() == "" {
-		writeError(w, http.StatusBadRequest, "missing required fields")
-		mh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
+		writeError(w, r, http.StatusBadRequest, "missing required fields")
 		return
 	}
 
 	id, err := mh.memberService.AddMember(ctx, req.GetFullName(), req.GetEmail(), req.GetPhone())
 	if err != nil {
-		mh.log.Error("failed to create member", slog.String("op", op), slog.Any("err", err))
-
-		code := "400"
-
-		switch {
-		case errors.Is(err, service.ErrEmailDuplicate):
-			writeError(w, http.StatusBadRequest, "email already exists")
-		case errors.Is(err, service.ErrPhoneDuplicate):
-			writeError(w, http.StatusBadRequest, "phone number already exists")
-		case errors.Is(err, service.ErrInvalidEmail):
-			writeError(w, http.StatusBadRequest, "invalid email format")
-		case errors.Is(err, service.ErrInvalidPhone):
-			writeError(w, http.StatusBadRequest, "invalid phone number format")
-		default:
-			code = "500"
-			writeError(w, http.StatusInternalServerError, "failed to create member")
+		mh.log.ErrorContext(r.Context(), "failed to create member", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to create member")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, id.String())
+}
+
+func (mh *MembersHandler) HandleImportMembers(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.members.HandleImportMembers"
+
+	log := mh.log.With(slog.String("op", op))
+	ctx := r.Context()
+
+	dryRun := r.URL.Query().Get("dry_run") != "false"
+	skipInvalid := r.URL.Query().Get("skip_invalid") == "true"
+
+	rows, err := readImportRows(http.MaxBytesReader(w, r.Body, maxImportSize), i18n.FromContext(r.Context()))
+	if err != nil {
+		log.WarnContext(r.Context(), "failed to read import file", slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	if len(rows) == 0 {
+		writeError(w, r, http.StatusBadRequest, "import file has no rows")
+		return
+	}
+
+	report, err := mh.memberService.ImportMembers(ctx, rows, dryRun, skipInvalid)
+	if err != nil {
+		log.ErrorContext(r.Context(), "failed to import members", slog.Any("err", err))
+
+		if errors.Is(err, service.ErrImportRejected) {
+			writeJSON(w, http.StatusUnprocessableEntity, importReportResponse(report))
+			return
 		}
 
-		mh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, code).Inc()
+		writeServiceError(w, r, err, "failed to import members")
 		return
 	}
 
-	writeJSON(w, http.StatusCreated, id.String())
+	writeJSON(w, http.StatusOK, importReportResponse(report))
 }
 
 func (mh *MembersHandler) HandleGetMember(w http.ResponseWriter, r *http.Request) {
@@ -121,26 +189,22 @@ func (mh *MembersHandler) HandleGetMember(w http.ResponseWriter, r *http.Request
 
 	memberID, err := uuid.Parse(chi.URLParam(r, "memberId"))
 	if err != nil {
-		writeError(w, http.StatusBadRequest, "wrong format memberId")
-		mh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
+		writeError(w, r, http.StatusBadRequest, "wrong format memberId")
 		return
 	}
 
 	member, err := mh.memberService.GetMember(ctx, memberID)
 	if err != nil {
-		if errors.Is(err, service.ErrMemberNotFound) {
-			writeError(w, http.StatusNotFound, "member not found")
-			mh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "404").Inc()
-			return
-		}
-		mh.log.Error("failed to get member", slog.String("op", op), slog.Any("err", err))
-		writeError(w, http.StatusInternalServerError, "failed to get member")
-		mh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "500").Inc()
+		mh.log.ErrorContext(r.Context(), "failed to get member", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to get member")
+		return
+	}
+
+	if notModified(w, r, versionETag(member.Version)) {
 		return
 	}
 
 	writeJSON(w, http.StatusOK, member)
-	mh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "200").Inc()
 }
 
 func (mh *MembersHandler) HandleUpdateMemberProfile(w http.ResponseWriter, r *http.Request) {
@@ -148,38 +212,77 @@ func (mh *MembersHandler) HandleUpdateMemberProfile(w http.ResponseWriter, r *ht
 
 	memberID, err := uuid.Parse(chi.URLParam(r, "memberId"))
 	if err != nil {
-		writeError(w, http.StatusBadRequest, "wrong format memberId")
-		mh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
+		writeError(w, r, http.StatusBadRequest, "wrong format memberId")
 		return
 	}
 	var req openapi.UpdateMemberProfileRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeError(w, http.StatusBadRequest, "invalid request body")
-		mh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
 	if req.GetFullName() == "" || req.GetEmail() == "" || req.GetPhone() == "" {
-		writeError(w, http.StatusBadRequest, "missing required fields")
-		mh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
+		writeError(w, r, http.StatusBadRequest, "missing required fields")
+		return
+	}
+
+	version, ok := ifMatchVersion(r)
+	if !ok {
+		writeError(w, r, http.StatusPreconditionFailed, "member was modified")
+		return
+	}
+
+	newVersion, err := mh.memberService.UpdateMember(r.Context(), memberID, req.GetFullName(), req.GetEmail(), req.GetPhone(), version)
+	if err != nil {
+		mh.log.ErrorContext(r.Context(), "failed to update member", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to update member")
 		return
 	}
 
-	err = mh.memberService.UpdateMember(r.Context(), memberID, req.GetFullName(), req.GetEmail(), req.GetPhone())
+	w.Header().Set("ETag", versionETag(newVersion))
+	writeJSON(w, http.StatusOK, memberID)
+}
+
+func (mh *MembersHandler) HandlePatchMemberProfile(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.members.HandlePatchMemberProfile"
+
+	memberID, err := uuid.Parse(chi.URLParam(r, "memberId"))
 	if err != nil {
-		if errors.Is(err, service.ErrMemberNotFound) {
-			writeError(w, http.StatusNotFound, "member not found")
-			mh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "404").Inc()
+		writeError(w, r, http.StatusBadRequest, "wrong format memberId")
+		return
+	}
+
+	version, ok := ifMatchVersion(r)
+	if !ok {
+		writeError(w, r, http.StatusPreconditionFailed, "member was modified")
+		return
+	}
+
+	fields, err := decodeMergePatch(r)
+	if err != nil {
+		if errors.Is(err, errUnsupportedPatchType) {
+			writeError(w, r, http.StatusUnsupportedMediaType, err.Error())
 			return
 		}
-		mh.log.Error("failed to update member", slog.String("op", op), slog.Any("err", err))
-		writeError(w, http.StatusInternalServerError, "failed to update member")
-		mh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "500").Inc()
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	patch, err := memberProfilePatchFromJSON(fields)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, err.Error())
 		return
 	}
 
+	newVersion, err := mh.memberService.PatchMember(r.Context(), memberID, patch, version)
+	if err != nil {
+		mh.log.ErrorContext(r.Context(), "failed to update member", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to update member")
+		return
+	}
+
+	w.Header().Set("ETag", versionETag(newVersion))
 	writeJSON(w, http.StatusOK, memberID)
-	mh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "200").Inc()
 }
 
 func (mh *MembersHandler) HandleUpdateMemberStatus(w http.ResponseWriter, r *http.Request) {
@@ -187,33 +290,31 @@ func (mh *MembersHandler) HandleUpdateMemberStatus(w http.ResponseWriter, r *htt
 
 	memberID, err := uuid.Parse(chi.URLParam(r, "memberId"))
 	if err != nil {
-		writeError(w, http.StatusBadRequest, "wrong format memberId")
-		mh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
+		writeError(w, r, http.StatusBadRequest, "wrong format memberId")
 		return
 	}
 
 	var req openapi.UpdateMemberStatusRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeError(w, http.StatusBadRequest, "invalid request body")
-		mh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	version, ok := ifMatchVersion(r)
+	if !ok {
+		writeError(w, r, http.StatusPreconditionFailed, "member was modified")
 		return
 	}
 
-	err = mh.memberService.UpdateMemberStatus(r.Context(), memberID, model.MemberStatus(req.GetStatus()))
+	newVersion, err := mh.memberService.UpdateMemberStatus(r.Context(), memberID, model.MemberStatus(req.GetStatus()), version)
 	if err != nil {
-		if errors.Is(err, service.ErrMemberNotFound) {
-			writeError(w, http.StatusNotFound, "member not found")
-			mh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "404").Inc()
-			return
-		}
-		mh.log.Error("failed to update member status", slog.String("op", op), slog.Any("err", err))
-		writeError(w, http.StatusInternalServerError, "failed to update member status")
-		mh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "500").Inc()
+		mh.log.ErrorContext(r.Context(), "failed to update member status", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to update member status")
 		return
 	}
 
+	w.Header().Set("ETag", versionETag(newVersion))
 	writeJSON(w, http.StatusOK, memberID)
-	mh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "200").Inc()
 	mh.metrics.UserStatusDecisionsTotal.WithLabelValues(req.GetStatus()).Inc()
 }
 
@@ -222,24 +323,22 @@ func (mh *MembersHandler) HandleDeleteMember(w http.ResponseWriter, r *http.Requ
 
 	memberID, err := uuid.Parse(chi.URLParam(r, "memberId"))
 	if err != nil {
-		writeError(w, http.StatusBadRequest, "wrong format memberId")
-		mh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
+		writeError(w, r, http.StatusBadRequest, "wrong format memberId")
 		return
 	}
 
-	err = mh.memberService.DeleteMember(r.Context(), memberID)
+	version, ok := ifMatchVersion(r)
+	if !ok {
+		writeError(w, r, http.StatusPreconditionFailed, "member was modified")
+		return
+	}
+
+	err = mh.memberService.DeleteMember(r.Context(), memberID, version)
 	if err != nil {
-		if errors.Is(err, service.ErrMemberNotFound) {
-			writeError(w, http.StatusNotFound, "member not found")
-			mh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "404").Inc()
-			return
-		}
-		mh.log.Error("failed to delete member", slog.String("op", op), slog.Any("err", err))
-		writeError(w, http.StatusInternalServerError, "failed to delete member")
-		mh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "500").Inc()
+		mh.log.ErrorContext(r.Context(), "failed to delete member", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to delete member")
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
-	mh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "204").Inc()
 }
@@ -0,0 +1,268 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/export"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/i18n"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"github.com/Ilya-Repin/orchestra_api/internal/openapi"
+	"github.com/Ilya-Repin/orchestra_api/internal/service"
+	"github.com/Ilya-Repin/orchestra_api/internal/service/membership"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+var renewalColumns = []export.Column[model.RenewalEntry]{
+	{Name: "member_id", Value: func(e model.RenewalEntry, _ export.DateFormatter) string { return e.Member.ID.String() }},
+	{Name: "full_name", Value: func(e model.RenewalEntry, _ export.DateFormatter) string { return e.Member.FullName }},
+	{Name: "email", Value: func(e model.RenewalEntry, _ export.DateFormatter) string { return e.Member.Email }},
+	{Name: "phone", Value: func(e model.RenewalEntry, _ export.DateFormatter) string { return e.Member.Phone }},
+	{Name: "tier", Value: func(e model.RenewalEntry, _ export.DateFormatter) string { return string(e.Membership.Tier) }},
+	{Name: "status", Value: func(e model.RenewalEntry, _ export.DateFormatter) string {
+		return string(e.Membership.Status(time.Now()))
+	}},
+	{Name: "expires_at", Value: func(e model.RenewalEntry, d export.DateFormatter) string { return d.Format(e.Membership.ExpiresAt) }},
+	{Name: "last_paid_at", Value: func(e model.RenewalEntry, d export.DateFormatter) string {
+		if e.LastPaidAt == nil {
+			return ""
+		}
+		return d.Format(*e.LastPaidAt)
+	}},
+}
+
+type MembershipHandler struct {
+	log               *slog.Logger
+	membershipService *membership.Service
+}
+
+func NewMembershipHandler(log *slog.Logger, ms *membership.Service) *MembershipHandler {
+	return &MembershipHandler{log: log, membershipService: ms}
+}
+
+func (mh *MembershipHandler) HandleGetMembership(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.membership.HandleGetMembership"
+
+	memberID, err := uuid.Parse(chi.URLParam(r, "memberId"))
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, "wrong format memberId")
+		return
+	}
+
+	ms, payments, err := mh.membershipService.GetMembership(r.Context(), memberID)
+	if err != nil {
+		mh.log.ErrorContext(r.Context(), "failed to get membership", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to get membership")
+		return
+	}
+
+	resp := openapi.MembershipResponse{
+		MemberId: memberID.String(),
+		Status:   string(ms.Status(time.Now())),
+		Payments: make([]openapi.MembershipPaymentResponse, 0, len(payments)),
+	}
+	if ms.Tier != "" {
+		resp.SetTier(string(ms.Tier))
+		resp.SetStartsAt(ms.StartsAt)
+		resp.SetExpiresAt(ms.ExpiresAt)
+	}
+	if ms.ExpiredAt != nil {
+		resp.SetExpiredAt(*ms.ExpiredAt)
+	}
+	for _, p := range payments {
+		resp.Payments = append(resp.Payments, paymentResponse(p))
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (mh *MembershipHandler) HandleRecordPayment(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.membership.HandleRecordPayment"
+
+	log := mh.log.With(slog.String("op", op))
+
+	memberID, err := uuid.Parse(chi.URLParam(r, "memberId"))
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, "wrong format memberId")
+		return
+	}
+
+	var req openapi.NewMembershipPaymentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.ErrorContext(r.Context(), "invalid request body", slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	payment, err := mh.membershipService.RecordPayment(r.Context(), model.MembershipPayment{
+		MemberID:  memberID,
+		Tier:      model.Tier(req.GetTier()),
+		Amount:    req.GetAmount(),
+		PaidAt:    req.GetPaidAt(),
+		Reference: req.GetReference(),
+		Note:      req.GetNote(),
+	})
+	if err != nil {
+		log.ErrorContext(r.Context(), "failed to record payment", slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to record payment")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, paymentResponse(payment))
+}
+
+func (mh *MembershipHandler) HandleImportPayments(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.membership.HandleImportPayments"
+
+	log := mh.log.With(slog.String("op", op))
+	ctx := r.Context()
+
+	dryRun := r.URL.Query().Get("dry_run") != "false"
+	skipInvalid := r.URL.Query().Get("skip_invalid") == "true"
+
+	rows, err := readPaymentImportRows(http.MaxBytesReader(w, r.Body, maxImportSize), i18n.FromContext(ctx))
+	if err != nil {
+		log.WarnContext(r.Context(), "failed to read import file", slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	if len(rows) == 0 {
+		writeError(w, r, http.StatusBadRequest, "import file has no rows")
+		return
+	}
+
+	report, err := mh.membershipService.ImportPayments(ctx, rows, dryRun, skipInvalid)
+	if err != nil {
+		log.ErrorContext(r.Context(), "failed to import payments", slog.Any("err", err))
+
+		if errors.Is(err, service.ErrImportRejected) {
+			writeJSON(w, http.StatusUnprocessableEntity, paymentImportReportResponse(report))
+			return
+		}
+
+		writeServiceError(w, r, err, "failed to import payments")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, paymentImportReportResponse(report))
+}
+
+func (mh *MembershipHandler) HandleGetRenewals(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.membership.HandleGetRenewals"
+
+	within, err := parseWithinDays(r)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	entries, err := mh.membershipService.GetRenewals(r.Context(), within)
+	if err != nil {
+		mh.log.ErrorContext(r.Context(), "failed to get renewals", slog.String("op", op), slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to get renewals")
+		return
+	}
+
+	now := time.Now()
+	resp := make([]openapi.RenewalResponse, 0, len(entries))
+	for _, e := range entries {
+		item := openapi.RenewalResponse{
+			MemberId:  e.Member.ID.String(),
+			FullName:  e.Member.FullName,
+			Email:     e.Member.Email,
+			Phone:     e.Member.Phone,
+			Tier:      string(e.Membership.Tier),
+			Status:    string(e.Membership.Status(now)),
+			ExpiresAt: e.Membership.ExpiresAt,
+		}
+		if e.Membership.ExpiredAt != nil {
+			item.SetExpiredAt(*e.Membership.ExpiredAt)
+		}
+		if e.LastPaidAt != nil {
+			item.SetLastPaidAt(*e.LastPaidAt)
+		}
+		resp = append(resp, item)
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (mh *MembershipHandler) HandleExportRenewals(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.membership.HandleExportRenewals"
+
+	log := mh.log.With(slog.String("op", op))
+
+	within, err := parseWithinDays(r)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cols, err := export.SelectColumns(renewalColumns, r.URL.Query().Get("columns"))
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	stream, dates, err := newExportStream(w, r, "renewals", export.Header(cols))
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	entries, err := mh.membershipService.GetRenewals(r.Context(), within)
+	for i := 0; err == nil && i < len(entries); i++ {
+		err = stream.row(export.Row(cols, entries[i], dates))
+	}
+	if err == nil {
+		err = stream.finish()
+	}
+	if err != nil {
+		log.ErrorContext(r.Context(), "failed to export renewals", slog.Any("err", err))
+		stream.fail(err, "failed to export renewals")
+		return
+	}
+}
+
+// parseWithinDays reads the optional within_days parameter of the renewal report. Zero is
+// returned when it is absent, so the configured window applies.
+func parseWithinDays(r *http.Request) (time.Duration, error) {
+	raw := r.URL.Query().Get("within_days")
+	if raw == "" {
+		return 0, nil
+	}
+
+	days, err := strconv.Atoi(raw)
+	if err != nil || days < 0 {
+		return 0, errors.New("invalid within_days")
+	}
+
+	return time.Duration(days) * 24 * time.Hour, nil
+}
+
+func paymentResponse(p model.MembershipPayment) openapi.MembershipPaymentResponse {
+	resp := openapi.MembershipPaymentResponse{
+		Id:          int32(p.ID),
+		Tier:        string(p.Tier),
+		Amount:      p.Amount,
+		PaidAt:      p.PaidAt,
+		PeriodStart: p.PeriodStart,
+		PeriodEnd:   p.PeriodEnd,
+		Source:      string(p.Source),
+	}
+	if p.Reference != "" {
+		resp.SetReference(p.Reference)
+	}
+	if p.Note != "" {
+		resp.SetNote(p.Note)
+	}
+	if !p.CreatedAt.IsZero() {
+		resp.SetCreatedAt(p.CreatedAt)
+	}
+
+	return resp
+}
@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/metrics"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// unmatchedRoute labels the requests no route matched, so unknown paths do not each get
+// a series of their own.
+const unmatchedRoute = "unmatched"
+
+type RequestMetrics struct {
+	metrics *metrics.Metrics
+}
+
+func NewRequestMetrics(metrics *metrics.Metrics) *RequestMetrics {
+	return &RequestMetrics{metrics: metrics}
+}
+
+// Middleware counts every request and records its duration, labelled by the route pattern,
+// method and response status. It must wrap the whole router so responses written by other
+// middleware and recovered panics are recorded too.
+func (rm *RequestMetrics) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+		defer func() {
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+			labels := []string{routePattern(r), r.Method, strconv.Itoa(status)}
+
+			rm.metrics.ApiRequestsTotal.WithLabelValues(labels...).Inc()
+			rm.metrics.HTTPRequestDurationSeconds.WithLabelValues(labels...).Observe(time.Since(start).Seconds())
+		}()
+
+		next.ServeHTTP(ww, r)
+	})
+}
+
+// routePattern returns the pattern of the route that served r, or unmatchedRoute.
+func routePattern(r *http.Request) string {
+	if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+		return rctx.RoutePattern()
+	}
+
+	return unmatchedRoute
+}
@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"mime"
+	"net/http"
+	"time"
+)
+
+// mergePatchContentType is the media type of RFC 7396 JSON Merge Patch documents.
+const mergePatchContentType = "application/merge-patch+json"
+
+var errUnsupportedPatchType = errors.New("content type must be " + mergePatchContentType)
+
+// decodeMergePatch reads a merge patch document. Plain application/json is accepted as well,
+// since clients often cannot set a custom media type.
+func decodeMergePatch(r *http.Request) (map[string]json.RawMessage, error) {
+	if ct := r.Header.Get("Content-Type"); ct != "" {
+		mediaType, _, err := mime.ParseMediaType(ct)
+		if err != nil || (mediaType != mergePatchContentType && mediaType != "application/json") {
+			return nil, errUnsupportedPatchType
+		}
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&fields); err != nil {
+		return nil, errors.New("request body must be a JSON object")
+	}
+	if fields == nil {
+		return nil, errors.New("request body must be a JSON object")
+	}
+
+	return fields, nil
+}
+
+// patchField takes a member out of a merge patch. A missing member yields nil; an explicit
+// null yields the zero value, which is how a merge patch removes a value.
+func patchField[T any](fields map[string]json.RawMessage, name string) (*T, error) {
+	raw, ok := fields[name]
+	if !ok {
+		return nil, nil
+	}
+	delete(fields, name)
+
+	var v T
+	if string(raw) == "null" {
+		return &v, nil
+	}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, fmt.Errorf("invalid %s", name)
+	}
+
+	return &v, nil
+}
+
+// unknownPatchField reports a member left over after all known fields were taken.
+func unknownPatchField(fields map[string]json.RawMessage) error {
+	for name := range fields {
+		return fmt.Errorf("unknown field %s", name)
+	}
+	return nil
+}
+
+func eventPatchFromJSON(fields map[string]json.RawMessage) (patch model.EventPatch, err error) {
+	if patch.Title, err = patchField[string](fields, "title"); err != nil {
+		return patch, err
+	}
+	if patch.Description, err = patchField[string](fields, "description"); err != nil {
+		return patch, err
+	}
+	if patch.EventType, err = patchField[int](fields, "event_type"); err != nil {
+		return patch, err
+	}
+	if patch.EventDate, err = patchField[time.Time](fields, "event_date"); err != nil {
+		return patch, err
+	}
+	if patch.Location, err = patchField[int](fields, "location"); err != nil {
+		return patch, err
+	}
+	if patch.Capacity, err = patchField[int](fields, "capacity"); err != nil {
+		return patch, err
+	}
+
+	return patch, unknownPatchField(fields)
+}
+
+func memberProfilePatchFromJSON(fields map[string]json.RawMessage) (patch model.MemberProfilePatch, err error) {
+	if patch.FullName, err = patchField[string](fields, "full_name"); err != nil {
+		return patch, err
+	}
+	if patch.Email, err = patchField[string](fields, "email"); err != nil {
+		return patch, err
+	}
+	if patch.Phone, err = patchField[string](fields, "phone"); err != nil {
+		return patch, err
+	}
+	if patch.Language, err = patchField[string](fields, "language"); err != nil {
+		return patch, err
+	}
+
+	return patch, unknownPatchField(fields)
+}
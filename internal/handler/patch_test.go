@@ -0,0 +1,210 @@
+package handler
+
+import (
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func patchRequest(contentType, body string) *http.Request {
+	r := httptest.NewRequest(http.MethodPatch, "/", strings.NewReader(body))
+	if contentType != "" {
+		r.Header.Set("Content-Type", contentType)
+	}
+	return r
+}
+
+func TestMemberMergePatch(t *testing.T) {
+	base := model.Member{FullName: "Anna Petrova", Email: "anna@example.com", Phone: "+79123456789", Language: "ru"}
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		want        model.Member
+		wantErr     string
+	}{
+		{
+			name:        "empty patch keeps everything",
+			contentType: mergePatchContentType,
+			body:        `{}`,
+			want:        base,
+		},
+		{
+			name:        "replaces the given members",
+			contentType: mergePatchContentType,
+			body:        `{"full_name": "Anna Ivanova", "email": "ivanova@example.com"}`,
+			want:        model.Member{FullName: "Anna Ivanova", Email: "ivanova@example.com", Phone: "+79123456789", Language: "ru"},
+		},
+		{
+			name:        "null removes a value",
+			contentType: mergePatchContentType,
+			body:        `{"language": null}`,
+			want:        model.Member{FullName: "Anna Petrova", Email: "anna@example.com", Phone: "+79123456789"},
+		},
+		{
+			name:        "plain json is accepted",
+			contentType: "application/json; charset=utf-8",
+			body:        `{"phone": "+79990001122"}`,
+			want:        model.Member{FullName: "Anna Petrova", Email: "anna@example.com", Phone: "+79990001122", Language: "ru"},
+		},
+		{
+			name: "missing content type is accepted",
+			body: `{"language": "en"}`,
+			want: model.Member{FullName: "Anna Petrova", Email: "anna@example.com", Phone: "+79123456789", Language: "en"},
+		},
+		{
+			name:        "unsupported content type",
+			contentType: "text/plain",
+			body:        `{}`,
+			wantErr:     errUnsupportedPatchType.Error(),
+		},
+		{
+			name:        "not an object",
+			contentType: mergePatchContentType,
+			body:        `["full_name"]`,
+			wantErr:     "request body must be a JSON object",
+		},
+		{
+			name:        "null document",
+			contentType: mergePatchContentType,
+			body:        `null`,
+			wantErr:     "request body must be a JSON object",
+		},
+		{
+			name:        "wrong type",
+			contentType: mergePatchContentType,
+			body:        `{"email": 42}`,
+			wantErr:     "invalid email",
+		},
+		{
+			name:        "unknown member",
+			contentType: mergePatchContentType,
+			body:        `{"status": "approved"}`,
+			wantErr:     "unknown field status",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields, err := decodeMergePatch(patchRequest(tt.contentType, tt.body))
+			var patch model.MemberProfilePatch
+			if err == nil {
+				patch, err = memberProfilePatchFromJSON(fields)
+			}
+
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := patch.Apply(base); got != tt.want {
+				t.Errorf("Apply = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEventMergePatch(t *testing.T) {
+	date := time.Date(2026, 12, 24, 19, 0, 0, 0, time.UTC)
+	base := model.Event{
+		ID:          7,
+		Title:       "Christmas concert",
+		Description: "Programme to be announced",
+		EventType:   model.EventType{ID: 1, Name: "Concert"},
+		EventDate:   date,
+		Location:    model.Location{ID: 2, Name: "Main hall"},
+		Capacity:    120,
+		Version:     3,
+	}
+
+	tests := []struct {
+		name    string
+		body    string
+		want    model.Event
+		wantErr string
+	}{
+		{
+			name: "empty patch keeps everything",
+			body: `{}`,
+			want: base,
+		},
+		{
+			name: "replaces the given members",
+			body: `{"title": "Winter concert", "capacity": 150, "event_date": "2026-12-25T18:00:00Z"}`,
+			want: func() model.Event {
+				e := base
+				e.Title = "Winter concert"
+				e.Capacity = 150
+				e.EventDate = time.Date(2026, 12, 25, 18, 0, 0, 0, time.UTC)
+				return e
+			}(),
+		},
+		{
+			name: "references are replaced by id",
+			body: `{"event_type": 4, "location": 5}`,
+			want: func() model.Event {
+				e := base
+				e.EventType = model.EventType{ID: 4}
+				e.Location = model.Location{ID: 5}
+				return e
+			}(),
+		},
+		{
+			name: "null clears the description",
+			body: `{"description": null}`,
+			want: func() model.Event {
+				e := base
+				e.Description = ""
+				return e
+			}(),
+		},
+		{
+			name:    "wrong type",
+			body:    `{"capacity": "many"}`,
+			wantErr: "invalid capacity",
+		},
+		{
+			name:    "bad date",
+			body:    `{"event_date": "tomorrow"}`,
+			wantErr: "invalid event_date",
+		},
+		{
+			name:    "unknown member",
+			body:    `{"price": 500}`,
+			wantErr: "unknown field price",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields, err := decodeMergePatch(patchRequest(mergePatchContentType, tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			patch, err := eventPatchFromJSON(fields)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := patch.Apply(base); got != tt.want {
+				t.Errorf("Apply = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
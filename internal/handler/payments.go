@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"github.com/Ilya-Repin/orchestra_api/internal/service/registrations"
+	"io"
+	"log/slog"
+	"net/http"
+)
+
+const maxWebhookSize = 64 << 10
+
+type PaymentsHandler struct {
+	log        *slog.Logger
+	regService *registrations.Service
+}
+
+func NewPaymentsHandler(log *slog.Logger, rs *registrations.Service) *PaymentsHandler {
+	return &PaymentsHandler{log: log, regService: rs}
+}
+
+// HandleWebhook accepts notifications from the payment provider. Any answer other than 2xx
+// makes the provider deliver the notification again later.
+func (ph *PaymentsHandler) HandleWebhook(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.payments.HandleWebhook"
+
+	log := ph.log.With(slog.String("op", op))
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookSize))
+	if err != nil {
+		log.WarnContext(r.Context(), "failed to read notification", slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := ph.regService.HandlePaymentNotification(r.Context(), r.Header, body); err != nil {
+		log.ErrorContext(r.Context(), "failed to process payment notification", slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to process payment notification")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
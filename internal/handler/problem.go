@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/i18n"
+	"github.com/Ilya-Repin/orchestra_api/internal/openapi"
+	"github.com/Ilya-Repin/orchestra_api/internal/service"
+	"github.com/go-chi/chi/v5/middleware"
+	"net/http"
+	"strings"
+)
+
+// problemContentType is the media type of RFC 7807 error responses.
+const problemContentType = "application/problem+json"
+
+// problemType says how an error is reported to clients. Codes are part of the API contract:
+// clients branch on them, so a code must never be renamed once released.
+type problemType struct {
+	status int
+	code   string
+}
+
+// problemTypes is the registry of domain errors. Errors are matched with errors.Is in order and
+// the first match wins; the message of the matched error becomes the detail of the response.
+var problemTypes = []struct {
+	err error
+	problemType
+}{
+	{service.ErrVersionMismatch, problemType{http.StatusPreconditionFailed, "version_mismatch"}},
+
+	{service.ErrMemberNotFound, problemType{http.StatusNotFound, "member_not_found"}},
+	{service.ErrEventNotFound, problemType{http.StatusNotFound, "event_not_found"}},
+	{service.ErrRegNotFound, problemType{http.StatusNotFound, "registration_not_found"}},
+	{service.ErrEventTypeNotFound, problemType{http.StatusNotFound, "event_type_not_found"}},
+	{service.ErrLocationNotFound, problemType{http.StatusNotFound, "location_not_found"}},
+	{service.ErrInfoNotFound, problemType{http.StatusNotFound, "info_not_found"}},
+	{service.ErrMetaNotFound, problemType{http.StatusNotFound, "meta_not_found"}},
+	{service.ErrCodeNotFound, problemType{http.StatusNotFound, "verification_code_not_found"}},
+	{service.ErrPaymentNotFound, problemType{http.StatusNotFound, "payment_not_found"}},
+	{service.ErrJobNotFound, problemType{http.StatusNotFound, "job_not_found"}},
+
+	{service.ErrMemberNotApproved, problemType{http.StatusForbidden, "member_not_approved"}},
+	{service.ErrTierRequired, problemType{http.StatusForbidden, "tier_required"}},
+	{service.ErrPriorityWindow, problemType{http.StatusForbidden, "priority_window"}},
+	{service.ErrInvalidSignature, problemType{http.StatusUnauthorized, "invalid_signature"}},
+
+	{service.ErrRegAlreadyExists, problemType{http.StatusConflict, "registration_exists"}},
+	{service.ErrEventFull, problemType{http.StatusConflict, "event_full"}},
+	{service.ErrEmailDuplicate, problemType{http.StatusConflict, "email_taken"}},
+	{service.ErrPhoneDuplicate, problemType{http.StatusConflict, "phone_taken"}},
+	{service.ErrAlreadyVerified, problemType{http.StatusConflict, "already_verified"}},
+	{service.ErrTierDowngrade, problemType{http.StatusConflict, "tier_downgrade"}},
+	{service.ErrPaymentDuplicate, problemType{http.StatusConflict, "payment_exists"}},
+	{service.ErrJobNotDead, problemType{http.StatusConflict, "job_not_dead"}},
+	{service.ErrEventNotStarted, problemType{http.StatusConflict, "event_not_started"}},
+	{service.ErrEventTypeExists, problemType{http.StatusConflict, "event_type_exists"}},
+	{service.ErrLocationExists, problemType{http.StatusConflict, "location_exists"}},
+	{service.ErrCapacityBelowRegs, problemType{http.StatusConflict, "capacity_below_registrations"}},
+	{service.ErrDuplicate, problemType{http.StatusConflict, "duplicate"}},
+
+	{service.ErrUnknownStatus, problemType{http.StatusBadRequest, "unknown_status"}},
+	{service.ErrUnknownChannel, problemType{http.StatusBadRequest, "unknown_channel"}},
+	{service.ErrUnknownLanguage, problemType{http.StatusBadRequest, "unknown_language"}},
+	{service.ErrUnknownTier, problemType{http.StatusBadRequest, "unknown_tier"}},
+	{service.ErrUnknownInterval, problemType{http.StatusBadRequest, "unknown_interval"}},
+	{service.ErrMalformedNotification, problemType{http.StatusBadRequest, "malformed_notification"}},
+	{service.ErrInvalidCode, problemType{http.StatusBadRequest, "invalid_code"}},
+	{service.ErrCodeExpired, problemType{http.StatusGone, "code_expired"}},
+	{service.ErrCodeRecentlySent, problemType{http.StatusTooManyRequests, "code_recently_sent"}},
+	{service.ErrTooManyAttempts, problemType{http.StatusTooManyRequests, "too_many_attempts"}},
+
+	{service.ErrInvalidFullName, problemType{http.StatusUnprocessableEntity, "invalid_full_name"}},
+	{service.ErrInvalidEmail, problemType{http.StatusUnprocessableEntity, "invalid_email"}},
+	{service.ErrInvalidPhone, problemType{http.StatusUnprocessableEntity, "invalid_phone"}},
+	{service.ErrInvalidTitle, problemType{http.StatusUnprocessableEntity, "invalid_title"}},
+	{service.ErrInvalidEventDate, problemType{http.StatusUnprocessableEntity, "invalid_event_date"}},
+	{service.ErrInvalidCapacity, problemType{http.StatusUnprocessableEntity, "invalid_capacity"}},
+	{service.ErrMissingValue, problemType{http.StatusUnprocessableEntity, "missing_value"}},
+	{service.ErrInvalidAmount, problemType{http.StatusUnprocessableEntity, "invalid_amount"}},
+	{service.ErrInvalidPrice, problemType{http.StatusUnprocessableEntity, "invalid_price"}},
+	{service.ErrImportRejected, problemType{http.StatusUnprocessableEntity, "import_rejected"}},
+	{service.ErrReferenceNotFound, problemType{http.StatusUnprocessableEntity, "reference_not_found"}},
+	{service.ErrCheckViolation, problemType{http.StatusUnprocessableEntity, "constraint_violated"}},
+
+	{service.ErrFailedToSendCode, problemType{http.StatusBadGateway, "code_delivery_failed"}},
+	{service.ErrPaymentUnavailable, problemType{http.StatusBadGateway, "payment_unavailable"}},
+}
+
+// codeValidationFailed is reported together with the list of rejected fields.
+const codeValidationFailed = "validation_failed"
+
+// writeServiceError answers with the problem registered for err. Validation errors list the
+// rejected fields; errors missing from the registry are reported as 500 with fallback as the
+// detail, hiding the internals.
+func writeServiceError(w http.ResponseWriter, r *http.Request, err error, fallback string) {
+	var verr *service.ValidationError
+	if errors.As(err, &verr) {
+		writeProblem(w, r, http.StatusUnprocessableEntity, codeValidationFailed, "validation failed", verr.Fields)
+		return
+	}
+
+	for _, p := range problemTypes {
+		if errors.Is(err, p.err) {
+			writeProblem(w, r, p.status, p.code, p.err.Error(), nil)
+			return
+		}
+	}
+
+	writeError(w, r, http.StatusInternalServerError, fallback)
+}
+
+// writeError answers with a problem that is not caused by a domain error, such as a malformed
+// parameter. Its code is derived from the status, e.g. not_found for 404.
+func writeError(w http.ResponseWriter, r *http.Request, status int, detail string) {
+	writeProblem(w, r, status, statusCode(status), detail, nil)
+}
+
+// writeProblem answers with an RFC 7807 problem. The detail and the field messages are
+// translated to the language of the request.
+func writeProblem(w http.ResponseWriter, r *http.Request, status int, code, detail string, fields []service.FieldError) {
+	loc := i18n.FromContext(r.Context())
+
+	problem := openapi.Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: int32(status),
+		Code:   code,
+	}
+	if detail != "" {
+		problem.SetDetail(loc.T(detail))
+	}
+	problem.SetInstance(r.URL.Path)
+	if id := middleware.GetReqID(r.Context()); id != "" {
+		problem.SetRequestId(id)
+	}
+	for _, f := range fields {
+		problem.Errors = append(problem.Errors, openapi.FieldError{Field: f.Field, Message: loc.T(f.Err.Error())})
+	}
+
+	w.Header().Set("Content-Type", problemContentType)
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(problem)
+}
+
+func statusCode(status int) string {
+	return strings.ReplaceAll(strings.ToLower(http.StatusText(status)), " ", "_")
+}
@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/config"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/metrics"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/ratelimit"
+	"github.com/go-chi/chi/v5"
+	"log/slog"
+	"math"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+type RateLimiter struct {
+	log     *slog.Logger
+	store   ratelimit.Store
+	cfg     config.RateLimitConfig
+	proxies []netip.Prefix
+	metrics *metrics.Metrics
+}
+
+func NewRateLimiter(log *slog.Logger, store ratelimit.Store, cfg config.RateLimitConfig, metrics *metrics.Metrics) (*RateLimiter, error) {
+	const op = "handlers.ratelimit.NewRateLimiter"
+
+	proxies := make([]netip.Prefix, 0, len(cfg.TrustedProxies))
+	for _, cidr := range cfg.TrustedProxies {
+		prefix, err := netip.ParsePrefix(strings.TrimSpace(cidr))
+		if err != nil {
+			return nil, fmt.Errorf("%s: trusted proxy %q: %w", op, cidr, err)
+		}
+		proxies = append(proxies, prefix.Masked())
+	}
+
+	return &RateLimiter{
+		log:     log,
+		store:   store,
+		cfg:     cfg,
+		proxies: proxies,
+		metrics: metrics,
+	}, nil
+}
+
+// Limit returns a middleware that applies the buckets of the configured route group:
+// one per client ip and, when the request names a member, one per member. It must be
+// mounted on the route itself (chi's With) so the memberId url param is resolved.
+// Requests are let through when the limiter store fails.
+func (rl *RateLimiter) Limit(group string) func(http.Handler) http.Handler {
+	const op = "handlers.ratelimit.Limit"
+
+	log := rl.log.With(slog.String("op", op), slog.String("group", group))
+
+	groupCfg, ok := rl.cfg.Groups[group]
+	ipBucket, ipOK := ratelimit.BucketFromConfig(groupCfg.PerIP)
+	memberBucket, memberOK := ratelimit.BucketFromConfig(groupCfg.PerMember)
+
+	if !rl.cfg.Enabled || !ok || (!ipOK && !memberOK) {
+		return func(next http.Handler) http.Handler { return next }
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			type check struct {
+				scope  string
+				key    string
+				bucket ratelimit.Bucket
+			}
+
+			var checks []check
+			if ipOK {
+				checks = append(checks, check{"ip", group + ":ip:" + rl.clientIP(r), ipBucket})
+			}
+			if memberID := requestMemberID(r); memberOK && memberID != "" {
+				checks = append(checks, check{"member", group + ":member:" + memberID, memberBucket})
+			}
+
+			for _, c := range checks {
+				decision, err := rl.store.Take(r.Context(), c.key, c.bucket)
+				if err != nil {
+					log.ErrorContext(r.Context(), "rate limiter unavailable", slog.Any("err", err))
+					continue
+				}
+				if decision.Allowed {
+					continue
+				}
+
+				log.WarnContext(r.Context(), "request rate limited", slog.String("scope", c.scope), slog.String("key", c.key))
+
+				w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(decision.RetryAfter.Seconds()))))
+				writeProblem(w, r, http.StatusTooManyRequests, "rate_limited", "too many requests", nil)
+				rl.metrics.RateLimitRejectionsTotal.WithLabelValues(group, c.scope).Inc()
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// clientIP takes the address set by nginx when the request comes from a trusted proxy,
+// since otherwise every request would come from the balancer. Anyone else could send any
+// X-Real-IP, so for them the peer address is used.
+func (rl *RateLimiter) clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	if rl.trustedProxy(host) {
+		if ip := strings.TrimSpace(r.Header.Get("X-Real-IP")); ip != "" {
+			return ip
+		}
+	}
+
+	return host
+}
+
+func (rl *RateLimiter) trustedProxy(host string) bool {
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+
+	for _, prefix := range rl.proxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func requestMemberID(r *http.Request) string {
+	if id := chi.URLParam(r, "memberId"); id != "" {
+		return id
+	}
+
+	return r.URL.Query().Get("memberId")
+}
@@ -1,9 +1,11 @@
 package handler
 
 import (
-	"errors"
+	"encoding/json"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/export"
 	"github.com/Ilya-Repin/orchestra_api/internal/infra/metrics"
-	"github.com/Ilya-Repin/orchestra_api/internal/service"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"github.com/Ilya-Repin/orchestra_api/internal/openapi"
 	"github.com/Ilya-Repin/orchestra_api/internal/service/registrations"
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
@@ -31,44 +33,43 @@ func (rh *RegistrationsHandler) HandleRegister(w http.ResponseWriter, r *http.Re
 	eventIDStr := chi.URLParam(r, "eventId")
 	eventID, err := strconv.Atoi(eventIDStr)
 	if err != nil {
-		log.Error("invalid event id", slog.String("op", op), slog.Any("err", err))
-		writeError(w, http.StatusBadRequest, "invalid event id")
-		rh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
+		log.ErrorContext(r.Context(), "invalid event id", slog.String("op", op), slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, "invalid event id")
 		return
 	}
 
 	memberID, err := uuid.Parse(r.URL.Query().Get("memberId"))
 	if err != nil {
-		writeError(w, http.StatusBadRequest, "wrong format memberId")
-		rh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
-		return
-	}
-
-	status, err := rh.regService.RegisterForEvent(ctx, memberID, eventID)
-	if err != nil {
-		if errors.Is(err, service.ErrMemberNotFound) {
-			log.Error("member not found", slog.String("op", op), slog.Any("err", err))
-			writeError(w, http.StatusNotFound, "member not found")
-			rh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "404").Inc()
-		} else if errors.Is(err, service.ErrMemberNotApproved) {
-			log.Error("member not approved", slog.String("op", op), slog.Any("err", err))
-			writeError(w, http.StatusBadRequest, "member not approved")
-			rh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
-		} else if errors.Is(err, service.ErrRegAlreadyExists) {
-			log.Info("registration already exists", slog.String("op", op), slog.Any("err", err))
-			writeError(w, http.StatusCreated, "registration already exists")
-			rh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "201").Inc()
-		} else {
-			log.Error("failed to register", slog.String("op", op), slog.Any("err", err))
-			writeError(w, http.StatusInternalServerError, "failed to register")
-			rh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "500").Inc()
-		}
+		writeError(w, r, http.StatusBadRequest, "wrong format memberId")
+		return
+	}
+
+	status, payment, err := rh.regService.RegisterForEvent(ctx, memberID, eventID)
+	if err != nil {
+		log.ErrorContext(r.Context(), "failed to register", slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to register")
 		return
 	}
 
-	writeJSON(w, http.StatusCreated, status)
-	rh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "201").Inc()
-	rh.metrics.EventRegistrationsTotal.WithLabelValues("registered").Inc()
+	if payment != nil {
+		writeJSON(w, http.StatusCreated, openapi.RegistrationCreatedResponse{
+			Status:  status,
+			Payment: eventPaymentResponse(*payment),
+		})
+	} else {
+		writeJSON(w, http.StatusCreated, status)
+	}
+	rh.metrics.EventRegistrationsTotal.WithLabelValues(status).Inc()
+}
+
+func eventPaymentResponse(p model.EventPayment) *openapi.EventPaymentResponse {
+	return &openapi.EventPaymentResponse{
+		Id:              int32(p.ID),
+		Amount:          p.Amount,
+		Status:          string(p.Status),
+		ConfirmationUrl: p.ConfirmationURL,
+		ExpiresAt:       p.ExpiresAt,
+	}
 }
 
 func (rh *RegistrationsHandler) HandleCancel(w http.ResponseWriter, r *http.Request) {
@@ -80,35 +81,25 @@ func (rh *RegistrationsHandler) HandleCancel(w http.ResponseWriter, r *http.Requ
 	eventIDStr := chi.URLParam(r, "eventId")
 	eventID, err := strconv.Atoi(eventIDStr)
 	if err != nil {
-		log.Error("invalid event id", slog.String("op", op), slog.Any("err", err))
-		writeError(w, http.StatusBadRequest, "invalid event id")
-		rh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
+		log.ErrorContext(r.Context(), "invalid event id", slog.String("op", op), slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, "invalid event id")
 		return
 	}
 
 	memberID, err := uuid.Parse(r.URL.Query().Get("memberId"))
 	if err != nil {
-		writeError(w, http.StatusBadRequest, "wrong format memberId")
-		rh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
+		writeError(w, r, http.StatusBadRequest, "wrong format memberId")
 		return
 	}
 
 	status, err := rh.regService.CancelRegistration(ctx, memberID, eventID)
 	if err != nil {
-		if errors.Is(err, service.ErrRegNotFound) {
-			log.Error("registration not found", slog.String("op", op), slog.Any("err", err))
-			writeError(w, http.StatusNotFound, "registration not found")
-			rh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "404").Inc()
-		} else {
-			log.Error("failed to cancel", slog.String("op", op), slog.Any("err", err))
-			writeError(w, http.StatusInternalServerError, "failed to cancel")
-			rh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "500").Inc()
-		}
+		log.ErrorContext(r.Context(), "failed to cancel", slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to cancel")
 		return
 	}
 
 	writeJSON(w, http.StatusNoContent, status)
-	rh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "204").Inc()
 	rh.metrics.EventRegistrationsTotal.WithLabelValues("cancelled").Inc()
 }
 
@@ -121,33 +112,151 @@ func (rh *RegistrationsHandler) HandleCheckRegistration(w http.ResponseWriter, r
 	eventIDStr := chi.URLParam(r, "eventId")
 	eventID, err := strconv.Atoi(eventIDStr)
 	if err != nil {
-		log.Error("invalid event id", slog.String("op", op), slog.Any("err", err))
-		writeError(w, http.StatusBadRequest, "invalid event id")
-		rh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
+		log.ErrorContext(r.Context(), "invalid event id", slog.String("op", op), slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, "invalid event id")
 		return
 	}
 
 	memberID, err := uuid.Parse(r.URL.Query().Get("memberId"))
 	if err != nil {
-		writeError(w, http.StatusBadRequest, "wrong format memberId")
-		rh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "400").Inc()
+		writeError(w, r, http.StatusBadRequest, "wrong format memberId")
 		return
 	}
 
 	status, err := rh.regService.GetRegistrationStatus(ctx, memberID, eventID)
 	if err != nil {
-		if errors.Is(err, service.ErrRegNotFound) {
-			log.Error("registration not found", slog.String("op", op), slog.Any("err", err))
-			writeError(w, http.StatusNotFound, "registration not found")
-			rh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "404").Inc()
-		} else {
-			log.Error("failed to check registration", slog.String("op", op), slog.Any("err", err))
-			writeError(w, http.StatusInternalServerError, "failed to check registration")
-			rh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "500").Inc()
-		}
+		log.ErrorContext(r.Context(), "failed to check registration", slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to check registration")
 		return
 	}
 
 	writeJSON(w, http.StatusOK, status)
-	rh.metrics.ApiRequestsTotal.WithLabelValues(r.Method, "200").Inc()
+}
+
+func (rh *RegistrationsHandler) HandleGetRoster(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.registrations.HandleGetRoster"
+
+	log := rh.log.With(slog.String("op", op))
+	ctx := r.Context()
+
+	eventIDStr := chi.URLParam(r, "eventId")
+	eventID, err := strconv.Atoi(eventIDStr)
+	if err != nil {
+		log.ErrorContext(r.Context(), "invalid event id", slog.String("op", op), slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, "invalid event id")
+		return
+	}
+
+	roster, err := rh.regService.GetEventRoster(ctx, eventID)
+	if err != nil {
+		log.ErrorContext(r.Context(), "failed to get roster", slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to get roster")
+		return
+	}
+
+	var memberResponses []openapi.MemberResponse
+	for _, m := range roster {
+		id := m.ID.String()
+		statusStr := string(m.Status)
+
+		memberResponses = append(memberResponses, openapi.MemberResponse{
+			Id:        &id,
+			FullName:  m.FullName,
+			Email:     m.Email,
+			Phone:     m.Phone,
+			Status:    &statusStr,
+			CreatedAt: &m.CreatedAt,
+			UpdatedAt: &m.UpdatedAt,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, memberResponses)
+}
+
+func (rh *RegistrationsHandler) HandleExportRoster(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.registrations.HandleExportRoster"
+
+	log := rh.log.With(slog.String("op", op))
+	ctx := r.Context()
+
+	eventIDStr := chi.URLParam(r, "eventId")
+	eventID, err := strconv.Atoi(eventIDStr)
+	if err != nil {
+		log.ErrorContext(r.Context(), "invalid event id", slog.String("op", op), slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, "invalid event id")
+		return
+	}
+
+	cols, err := export.SelectColumns(rosterColumns, r.URL.Query().Get("columns"))
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	stream, dates, err := newExportStream(w, r, "roster-"+eventIDStr, export.Header(cols))
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err = rh.regService.ExportEventRoster(ctx, eventID, func(e model.RosterEntry) error {
+		return stream.row(export.Row(cols, e, dates))
+	})
+	if err == nil {
+		err = stream.finish()
+	}
+	if err != nil {
+		log.ErrorContext(r.Context(), "failed to export roster", slog.Any("err", err))
+		stream.fail(err, "failed to export roster")
+		return
+	}
+}
+
+func (rh *RegistrationsHandler) HandleRecordAttendance(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.registrations.HandleRecordAttendance"
+
+	log := rh.log.With(slog.String("op", op))
+	ctx := r.Context()
+
+	eventID, err := strconv.Atoi(chi.URLParam(r, "eventId"))
+	if err != nil {
+		log.ErrorContext(r.Context(), "invalid event id", slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, "invalid event id")
+		return
+	}
+
+	var req openapi.AttendanceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.ErrorContext(r.Context(), "invalid request body", slog.Any("err", err))
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	attended := make([]uuid.UUID, 0, len(req.MemberIds))
+	for _, raw := range req.MemberIds {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			writeError(w, r, http.StatusBadRequest, "not a valid UUID")
+			return
+		}
+		attended = append(attended, id)
+	}
+
+	report, err := rh.regService.RecordAttendance(ctx, eventID, attended)
+	if err != nil {
+		log.ErrorContext(r.Context(), "failed to record attendance", slog.Any("err", err))
+		writeServiceError(w, r, err, "failed to record attendance")
+		return
+	}
+
+	notRegistered := make([]string, 0, len(report.NotRegistered))
+	for _, id := range report.NotRegistered {
+		notRegistered = append(notRegistered, id.String())
+	}
+
+	writeJSON(w, http.StatusOK, openapi.AttendanceReport{
+		Attended:      int32(report.Attended),
+		NoShow:        int32(report.NoShow),
+		NotRegistered: notRegistered,
+	})
 }
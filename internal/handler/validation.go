@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/service"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	"github.com/google/uuid"
+	"log/slog"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+func init() {
+	openapi3filter.RegisterBodyDecoder(mergePatchContentType, openapi3filter.JSONBodyDecoder)
+	openapi3.DefineStringFormatCallback("uuid", func(s string) error {
+		if _, err := uuid.Parse(s); err != nil {
+			return errors.New("not a valid UUID")
+		}
+		return nil
+	})
+}
+
+// RequestValidator checks requests against the OpenAPI document before they reach a handler.
+type RequestValidator struct {
+	log      *slog.Logger
+	router   routers.Router
+	basePath string
+}
+
+// NewRequestValidator builds a validator from the OpenAPI document. Paths of incoming requests
+// are matched below the path of the first server in the document.
+func NewRequestValidator(log *slog.Logger, spec []byte) (*RequestValidator, error) {
+	const op = "handlers.validation.NewRequestValidator"
+
+	loader := openapi3.NewLoader()
+	doc, err := loader.LoadFromData(spec)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := doc.Validate(context.Background()); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var basePath string
+	if len(doc.Servers) > 0 {
+		u, err := url.Parse(doc.Servers[0].URL)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		basePath = strings.TrimSuffix(u.Path, "/")
+	}
+	doc.Servers = nil
+
+	router, err := legacy.NewRouter(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &RequestValidator{
+		log:      log.With("component", "validation"),
+		router:   router,
+		basePath: basePath,
+	}, nil
+}
+
+// Middleware rejects requests whose parameters or JSON body do not match the document: bad
+// parameters and unreadable bodies with 400, bodies that break the schema with 422. Requests to
+// paths the document does not describe are passed through untouched.
+func (v *RequestValidator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.validation.Middleware"
+
+		if !strings.HasPrefix(r.URL.Path, v.basePath) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		routed := r.Clone(r.Context())
+		routed.URL.Path = strings.TrimPrefix(r.URL.Path, v.basePath)
+		routed.URL.RawPath = ""
+
+		route, pathParams, err := v.router.FindRoute(routed)
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		input := &openapi3filter.RequestValidationInput{
+			Request:    routed,
+			PathParams: pathParams,
+			Route:      route,
+			Options: &openapi3filter.Options{
+				MultiError:         true,
+				AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+				ExcludeRequestBody: !isJSONRequest(r),
+			},
+		}
+
+		err = openapi3filter.ValidateRequest(r.Context(), input)
+		r.Body = routed.Body
+		if err == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		code, fields := requestFieldErrors(err)
+		problemCode := statusCode(code)
+		if code == http.StatusUnprocessableEntity {
+			problemCode = codeValidationFailed
+		}
+
+		v.log.WarnContext(r.Context(), "request rejected", slog.String("op", op), slog.String("path", r.URL.Path), slog.Any("err", err))
+		writeProblem(w, r, code, problemCode, "request does not match the API schema", fields)
+	})
+}
+
+// isJSONRequest reports whether the body is a JSON document. Other bodies, such as CSV imports,
+// are parsed and checked by their handlers.
+func isJSONRequest(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// requestFieldErrors flattens validation errors into per-field messages and picks the status
+// of the response: 422 when only the body schema was violated, 400 otherwise.
+func requestFieldErrors(err error) (int, []service.FieldError) {
+	code := http.StatusUnprocessableEntity
+	var fields []service.FieldError
+
+	var collect func(err error, param string)
+	collect = func(err error, param string) {
+		switch e := err.(type) {
+		case openapi3.MultiError:
+			for _, inner := range e {
+				collect(inner, param)
+			}
+		case *openapi3filter.RequestError:
+			if e.Parameter != nil {
+				code = http.StatusBadRequest
+				param = e.Parameter.Name
+			}
+			switch e.Err.(type) {
+			case openapi3.MultiError, *openapi3.SchemaError:
+				collect(e.Err, param)
+			default:
+				code = http.StatusBadRequest
+				if e.RequestBody != nil {
+					fields = append(fields, service.FieldError{Err: errors.New("invalid request body")})
+				} else {
+					fields = append(fields, service.FieldError{Field: param, Err: errors.New("invalid value")})
+				}
+			}
+		case *openapi3.SchemaError:
+			field := strings.Join(e.JSONPointer(), ".")
+			if param != "" {
+				field = param
+			}
+			fields = append(fields, service.FieldError{Field: field, Err: errors.New(e.Reason)})
+		default:
+			code = http.StatusBadRequest
+			fields = append(fields, service.FieldError{Field: param, Err: errors.New("invalid value")})
+		}
+	}
+	collect(err, "")
+
+	return code, fields
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"encoding/json"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"github.com/Ilya-Repin/orchestra_api/internal/openapi"
+	"github.com/Ilya-Repin/orchestra_api/internal/service/verification"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"log/slog"
+	"net/http"
+)
+
+type VerificationHandler struct {
+	log                 *slog.Logger
+	verificationService *verification.Service
+}
+
+func NewVerificationHandler(log *slog.Logger, verificationService *verification.Service) *VerificationHandler {
+	return &VerificationHandler{
+		log:                 log,
+		verificationService: verificationService,
+	}
+}
+
+func (vh *VerificationHandler) HandleSendCode(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.verification.HandleSendCode"
+
+	log := vh.log.With(slog.String("op", op))
+
+	memberID, err := uuid.Parse(chi.URLParam(r, "memberId"))
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, "wrong format memberId")
+		return
+	}
+
+	channel := model.VerificationChannel(chi.URLParam(r, "channel"))
+
+	expiresAt, err := vh.verificationService.SendCode(r.Context(), memberID, channel)
+	if err != nil {
+		log.ErrorContext(r.Context(), "failed to send verification code", slog.Any("err", err))
+
+		writeServiceError(w, r, err, "failed to send verification code")
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, openapi.VerificationSentResponse{ExpiresAt: expiresAt})
+}
+
+func (vh *VerificationHandler) HandleConfirmCode(w http.ResponseWriter, r *http.Request) {
+	const op = "handlers.verification.HandleConfirmCode"
+
+	log := vh.log.With(slog.String("op", op))
+
+	memberID, err := uuid.Parse(chi.URLParam(r, "memberId"))
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, "wrong format memberId")
+		return
+	}
+
+	var req openapi.VerificationConfirmRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.GetCode() == "" {
+		writeError(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	channel := model.VerificationChannel(chi.URLParam(r, "channel"))
+
+	err = vh.verificationService.ConfirmCode(r.Context(), memberID, channel, req.GetCode())
+	if err != nil {
+		log.WarnContext(r.Context(), "failed to confirm verification code", slog.Any("err", err))
+
+		writeServiceError(w, r, err, "failed to verify contact")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,68 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrUnknownColumn = errors.New("unknown column")
+
+// Column describes one exported field of T.
+type Column[T any] struct {
+	Name  string
+	Value func(v T, dates DateFormatter) string
+}
+
+// SelectColumns picks the columns listed in spec (comma separated) in the given order.
+// An empty spec selects every column.
+func SelectColumns[T any](all []Column[T], spec string) ([]Column[T], error) {
+	if strings.TrimSpace(spec) == "" {
+		return all, nil
+	}
+
+	byName := make(map[string]Column[T], len(all))
+	for _, c := range all {
+		byName[c.Name] = c
+	}
+
+	var selected []Column[T]
+	for _, name := range strings.Split(spec, ",") {
+		name = strings.TrimSpace(name)
+		c, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("%q: %w", name, ErrUnknownColumn)
+		}
+		selected = append(selected, c)
+	}
+
+	return selected, nil
+}
+
+// Text makes a free-text value safe to open in a spreadsheet: a value that starts like a
+// formula gets a leading apostrophe, so Excel and LibreOffice show it instead of evaluating it.
+func Text(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+
+	return value
+}
+
+func Header[T any](cols []Column[T]) []string {
+	header := make([]string, len(cols))
+	for i, c := range cols {
+		header[i] = c.Name
+	}
+
+	return header
+}
+
+func Row[T any](cols []Column[T], v T, dates DateFormatter) []string {
+	row := make([]string, len(cols))
+	for i, c := range cols {
+		row[i] = c.Value(v, dates)
+	}
+
+	return row
+}
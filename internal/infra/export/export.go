@@ -0,0 +1,170 @@
+package export
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"github.com/xuri/excelize/v2"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+)
+
+var ErrUnknownFormat = errors.New("unknown export format")
+
+// Writer receives a table row by row and writes it to the underlying stream.
+type Writer interface {
+	WriteRow(values []string) error
+	// Close flushes buffered data. It must be called once after the last row.
+	Close() error
+	ContentType() string
+	Extension() string
+}
+
+func New(format string, w io.Writer) (Writer, error) {
+	switch format {
+	case "", FormatCSV:
+		return newCSVWriter(w), nil
+	case FormatXLSX:
+		return newXLSXWriter(w)
+	default:
+		return nil, fmt.Errorf("%q: %w", format, ErrUnknownFormat)
+	}
+}
+
+type csvWriter struct {
+	w    *csv.Writer
+	rows int
+}
+
+func newCSVWriter(w io.Writer) *csvWriter {
+	return &csvWriter{w: csv.NewWriter(w)}
+}
+
+func (c *csvWriter) WriteRow(values []string) error {
+	if err := c.w.Write(values); err != nil {
+		return err
+	}
+
+	// flush periodically so the client starts receiving data before the query ends
+	c.rows++
+	if c.rows%100 == 0 {
+		c.w.Flush()
+		return c.w.Error()
+	}
+
+	return nil
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *csvWriter) ContentType() string {
+	return "text/csv; charset=utf-8"
+}
+
+func (c *csvWriter) Extension() string {
+	return FormatCSV
+}
+
+type xlsxWriter struct {
+	out  io.Writer
+	file *excelize.File
+	sw   *excelize.StreamWriter
+	row  int
+}
+
+func newXLSXWriter(w io.Writer) (*xlsxWriter, error) {
+	file := excelize.NewFile()
+
+	sw, err := file.NewStreamWriter("Sheet1")
+	if err != nil {
+		return nil, err
+	}
+
+	return &xlsxWriter{out: w, file: file, sw: sw}, nil
+}
+
+func (x *xlsxWriter) WriteRow(values []string) error {
+	x.row++
+
+	cell, err := excelize.CoordinatesToCellName(1, x.row)
+	if err != nil {
+		return err
+	}
+
+	row := make([]interface{}, len(values))
+	for i, v := range values {
+		row[i] = v
+	}
+
+	return x.sw.SetRow(cell, row)
+}
+
+func (x *xlsxWriter) Close() error {
+	defer x.file.Close()
+
+	if err := x.sw.Flush(); err != nil {
+		return err
+	}
+
+	_, err := x.file.WriteTo(x.out)
+	return err
+}
+
+func (x *xlsxWriter) ContentType() string {
+	return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+}
+
+func (x *xlsxWriter) Extension() string {
+	return FormatXLSX
+}
+
+var dateLayouts = map[string]string{
+	"ru": "02.01.2006 15:04",
+	"en": "01/02/2006 3:04 PM",
+}
+
+// DateFormatter renders timestamps for a locale and time zone.
+// Unknown locales fall back to RFC 3339.
+type DateFormatter struct {
+	layout string
+	loc    *time.Location
+}
+
+func NewDateFormatter(locale string, loc *time.Location) DateFormatter {
+	layout, ok := dateLayouts[baseLanguage(locale)]
+	if !ok {
+		layout = time.RFC3339
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	return DateFormatter{layout: layout, loc: loc}
+}
+
+func (d DateFormatter) Format(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.In(d.loc).Format(d.layout)
+}
+
+// baseLanguage reduces a tag like "ru-RU" or an Accept-Language value like "en-US,en;q=0.9" to "ru" or "en".
+func baseLanguage(locale string) string {
+	locale, _, _ = strings.Cut(locale, ",")
+	locale, _, _ = strings.Cut(locale, ";")
+	locale, _, _ = strings.Cut(locale, "-")
+	locale, _, _ = strings.Cut(locale, "_")
+
+	return strings.ToLower(strings.TrimSpace(locale))
+}
@@ -0,0 +1,258 @@
+// Package i18n translates user-facing texts to the languages the club works in.
+//
+// Messages are looked up by their English text, the way gettext does it, so a text without
+// a translation is shown in English rather than as an opaque key. The built-in catalog can be
+// extended and overridden at runtime with translations stored in the database.
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type Lang string
+
+const (
+	RU Lang = "ru"
+	EN Lang = "en"
+)
+
+// Default is used when neither the client nor the member asked for a language.
+const Default = RU
+
+// Supported lists the languages in the order they are preferred on a tie.
+var Supported = []Lang{RU, EN}
+
+// Parse reduces a language tag such as "ru-RU" or "en_US" to a supported language.
+func Parse(tag string) (Lang, bool) {
+	base, _, _ := strings.Cut(strings.TrimSpace(tag), "-")
+	base, _, _ = strings.Cut(base, "_")
+
+	lang := Lang(strings.ToLower(base))
+	for _, l := range Supported {
+		if l == lang {
+			return l, true
+		}
+	}
+
+	return "", false
+}
+
+// Negotiate picks the supported language the client prefers most according to an
+// Accept-Language header. ok is false if the header names none of them.
+func Negotiate(acceptLanguage string) (lang Lang, ok bool) {
+	type candidate struct {
+		lang Lang
+		q    float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+
+		q := 1.0
+		if v, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			parsed, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q <= 0 {
+			continue
+		}
+
+		if l, supported := Parse(tag); supported {
+			candidates = append(candidates, candidate{l, q})
+		}
+	}
+	if len(candidates) == 0 {
+		return "", false
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].q > candidates[j].q })
+
+	return candidates[0].lang, true
+}
+
+// PluralForm returns the index of the plural form used for n. Russian has three forms
+// (1 минута, 2 минуты, 5 минут), English two (1 minute, 2 minutes).
+func PluralForm(lang Lang, n int) int {
+	if n < 0 {
+		n = -n
+	}
+
+	if lang == RU {
+		switch {
+		case n%10 == 1 && n%100 != 11:
+			return 0
+		case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+			return 1
+		default:
+			return 2
+		}
+	}
+
+	if n == 1 {
+		return 0
+	}
+	return 1
+}
+
+// Translation is one catalog entry. Forms holds a single text, or the plural forms of
+// the language in the order PluralForm numbers them.
+type Translation struct {
+	Key   string
+	Lang  Lang
+	Forms []string
+}
+
+// Source provides translations kept outside the binary.
+type Source interface {
+	GetTranslations(ctx context.Context) ([]Translation, error)
+}
+
+// Catalog holds the texts of every supported language. It is safe for concurrent use.
+type Catalog struct {
+	mu       sync.RWMutex
+	messages map[Lang]map[string][]string
+}
+
+// NewCatalog returns a catalog filled with the built-in translations.
+func NewCatalog() *Catalog {
+	return &Catalog{messages: withBuiltin()}
+}
+
+// Load replaces the translations with the built-in ones overridden by those from src, so
+// translations removed from src fall back to the built-in text. It is safe to call while
+// the catalog is in use.
+func (c *Catalog) Load(ctx context.Context, src Source) error {
+	const op = "infra.i18n.Catalog.Load"
+
+	translations, err := src.GetTranslations(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	messages := withBuiltin()
+	for _, t := range translations {
+		if len(t.Forms) > 0 {
+			set(messages, t.Lang, t.Key, t.Forms)
+		}
+	}
+
+	c.mu.Lock()
+	c.messages = messages
+	c.mu.Unlock()
+
+	return nil
+}
+
+func withBuiltin() map[Lang]map[string][]string {
+	messages := make(map[Lang]map[string][]string)
+	for lang, builtinMessages := range builtin {
+		for key, forms := range builtinMessages {
+			set(messages, lang, key, forms)
+		}
+	}
+
+	return messages
+}
+
+func set(messages map[Lang]map[string][]string, lang Lang, key string, forms []string) {
+	if messages[lang] == nil {
+		messages[lang] = make(map[string][]string)
+	}
+	messages[lang][key] = forms
+}
+
+func (c *Catalog) lookup(lang Lang, key string) []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.messages[lang][key]
+}
+
+// Variants returns the text of key in every supported language, including key itself.
+func (c *Catalog) Variants(key string) []string {
+	variants := []string{key}
+	for _, lang := range Supported {
+		if forms := c.lookup(lang, key); len(forms) > 0 {
+			variants = append(variants, forms[0])
+		}
+	}
+
+	return variants
+}
+
+// Localizer translates texts to one language.
+type Localizer struct {
+	catalog *Catalog
+	Lang    Lang
+}
+
+func (c *Catalog) Localizer(lang Lang) Localizer {
+	return Localizer{catalog: c, Lang: lang}
+}
+
+// T translates key and formats the result with args like fmt.Sprintf.
+func (l Localizer) T(key string, args ...any) string {
+	text := key
+	if l.catalog != nil {
+		if forms := l.catalog.lookup(l.Lang, key); len(forms) > 0 {
+			text = forms[0]
+		}
+	}
+
+	if len(args) == 0 {
+		return text
+	}
+	return fmt.Sprintf(text, args...)
+}
+
+// N translates key in the plural form for n and formats it with n followed by args.
+// A language without enough forms falls back to its last one.
+func (l Localizer) N(key string, n int, args ...any) string {
+	forms := []string{key}
+	if l.catalog != nil {
+		if f := l.catalog.lookup(l.Lang, key); len(f) > 0 {
+			forms = f
+		}
+	}
+
+	i := PluralForm(l.Lang, n)
+	if i >= len(forms) {
+		i = len(forms) - 1
+	}
+
+	return fmt.Sprintf(forms[i], append([]any{n}, args...)...)
+}
+
+// Variants returns the text of key in every supported language, including key itself.
+func (l Localizer) Variants(key string) []string {
+	if l.catalog == nil {
+		return []string{key}
+	}
+
+	return l.catalog.Variants(key)
+}
+
+type ctxKey struct{}
+
+// WithLocalizer stores l in ctx for the rest of the request.
+func WithLocalizer(ctx context.Context, l Localizer) context.Context {
+	return context.WithValue(ctx, ctxKey{}, l)
+}
+
+// FromContext returns the localizer of the request. Without one texts are left untranslated.
+func FromContext(ctx context.Context) Localizer {
+	if l, ok := ctx.Value(ctxKey{}).(Localizer); ok {
+		return l
+	}
+
+	return Localizer{Lang: EN}
+}
@@ -0,0 +1,249 @@
+package i18n
+
+// builtin is the catalog shipped with the service. English texts are the keys, so only
+// entries that differ from the key are listed for English.
+var builtin = map[Lang]map[string][]string{
+	RU: {
+		// Errors of the domain.
+		"resource was modified concurrently":  {"ресурс был изменен другим запросом"},
+		"member not found":                    {"участник не найден"},
+		"event not found":                     {"событие не найдено"},
+		"registration not found":              {"регистрация не найдена"},
+		"event type not found":                {"тип события не найден"},
+		"location not found":                  {"площадка не найдена"},
+		"orchestra info not found":            {"информация об оркестре не найдена"},
+		"meta object not found":               {"справочная запись не найдена"},
+		"verification code not found":         {"код подтверждения не отправлялся"},
+		"member is not approved":              {"участник еще не одобрен"},
+		"registration already exists":         {"участник уже зарегистрирован на событие"},
+		"event full":                          {"на событие не осталось мест"},
+		"email already exists":                {"этот email уже используется"},
+		"phone number already exists":         {"этот номер телефона уже используется"},
+		"contact already verified":            {"контакт уже подтвержден"},
+		"unknown status":                      {"неизвестный статус"},
+		"unknown verification channel":        {"неизвестный канал подтверждения"},
+		"unknown language":                    {"неподдерживаемый язык"},
+		"invalid verification code":           {"неверный код подтверждения"},
+		"verification code expired":           {"срок действия кода истек"},
+		"verification code was sent recently": {"код уже был отправлен недавно"},
+		"too many verification attempts":      {"превышено число попыток, запросите новый код"},
+		"full name is required":               {"укажите ФИО"},
+		"invalid email format":                {"некорректный email"},
+		"invalid phone number format":         {"некорректный номер телефона"},
+		"title is required":                   {"укажите название"},
+		"event date must be in the future":    {"дата события должна быть в будущем"},
+		"capacity must be positive":           {"число мест должно быть положительным"},
+		"import contains invalid rows":        {"в файле импорта есть ошибочные строки"},
+		"failed to send verification code":    {"не удалось отправить код подтверждения"},
+		"validation failed":                   {"данные не прошли проверку"},
+		"value is required":                   {"заполните поле"},
+
+		// Errors of memberships.
+		"unknown membership tier":                                   {"неизвестный уровень членства"},
+		"amount must be positive":                                   {"сумма должна быть положительной"},
+		"a lower tier can not be paid while a higher one is active": {"нельзя оплатить более низкий уровень, пока действует более высокий"},
+		"payment with this reference is already recorded":           {"платеж с этим номером уже учтен"},
+		"event is open to a higher membership tier":                 {"событие доступно только участникам более высокого уровня"},
+		"registration is open only to priority tiers yet":           {"регистрация пока открыта только для приоритетных уровней"},
+
+		// Errors of event payments.
+		"price must be positive":                 {"цена должна быть положительной"},
+		"payment provider is unavailable":        {"платежный сервис недоступен, место не забронировано"},
+		"invalid webhook signature":              {"неверная подпись уведомления"},
+		"malformed payment notification":         {"некорректное уведомление о платеже"},
+		"event payment not found":                {"платеж не найден"},
+		"failed to set event price":              {"не удалось изменить цену события"},
+		"failed to process payment notification": {"не удалось обработать уведомление о платеже"},
+
+		// Errors of background jobs.
+		"job not found":                 {"задача не найдена"},
+		"only dead jobs can be retried": {"перезапустить можно только задачу в статусе dead"},
+		"failed to get jobs":            {"не удалось получить список задач"},
+		"failed to retry job":           {"не удалось перезапустить задачу"},
+		"wrong format jobId":            {"некорректный формат jobId"},
+		"invalid limit":                 {"некорректное значение limit"},
+
+		// Errors of analytics and attendance.
+		"attendance can only be recorded once the event has started": {"посещаемость можно отметить только после начала события"},
+		"failed to record attendance":                                {"не удалось отметить посещаемость"},
+		"date_from must be before date_to":                           {"date_from должна быть раньше date_to"},
+		"unknown interval":                                           {"неизвестный интервал"},
+		"date range has too many periods for the interval":           {"слишком много интервалов в периоде"},
+		"failed to get analytics":                                    {"не удалось посчитать показатели"},
+
+		// Errors of reference data.
+		"event type with this name already exists": {"тип события с таким названием уже есть"},
+		"location with this name already exists":   {"площадка с таким названием уже есть"},
+
+		// Errors of event capacity.
+		"capacity is below the number of active registrations": {"мест меньше, чем действующих регистраций"},
+		"failed to set event capacity":                         {"не удалось изменить количество мест"},
+
+		// Errors of constraints without an error of their own.
+		"value already exists":             {"такое значение уже есть"},
+		"referenced resource not found":    {"связанный объект не найден"},
+		"value violates a data constraint": {"значение нарушает ограничение данных"},
+
+		// Errors of the request itself.
+		"request does not match the API schema":                    {"запрос не соответствует схеме API"},
+		"invalid request body":                                     {"некорректное тело запроса"},
+		"request body must be a JSON object":                       {"тело запроса должно быть JSON-объектом"},
+		"missing required fields":                                  {"не заполнены обязательные поля"},
+		"invalid value":                                            {"некорректное значение"},
+		"not a valid UUID":                                         {"некорректный UUID"},
+		"invalid event id":                                         {"некорректный ID события"},
+		"invalid location id":                                      {"некорректный ID площадки"},
+		"wrong format memberId":                                    {"некорректный формат memberId"},
+		"wrong format X-Member-Id":                                 {"некорректный формат X-Member-Id"},
+		"X-Member-Id header is required":                           {"не указан заголовок X-Member-Id"},
+		"invalid event type":                                       {"некорректный тип события"},
+		"invalid date_from":                                        {"некорректная дата date_from"},
+		"invalid date_to":                                          {"некорректная дата date_to"},
+		"invalid within_days":                                      {"некорректное значение within_days"},
+		"event was modified":                                       {"событие было изменено"},
+		"member was modified":                                      {"данные участника были изменены"},
+		"If-Match header is required":                              {"не указан заголовок If-Match"},
+		"content type must be application/merge-patch+json":        {"Content-Type должен быть application/merge-patch+json"},
+		"import file has no rows":                                  {"в файле импорта нет строк"},
+		"import file must have full_name, email and phone columns": {"в файле импорта должны быть колонки full_name, email и phone"},
+		"idempotency key is too long":                              {"слишком длинный Idempotency-Key"},
+		"idempotency key was already used for a different request": {"Idempotency-Key уже использован для другого запроса"},
+		"request with this idempotency key is still in progress":   {"запрос с этим Idempotency-Key еще обрабатывается"},
+		"too many requests":                                        {"слишком много запросов, повторите позже"},
+
+		"import file must have member_id or email, tier, amount and paid_at columns": {"в файле импорта должны быть колонки member_id или email, tier, amount и paid_at"},
+
+		// Failures reported as 500.
+		"failed to process idempotency key":       {"не удалось обработать Idempotency-Key"},
+		"failed to add event":                     {"не удалось создать событие"},
+		"failed to add event type":                {"не удалось создать тип события"},
+		"failed to add location":                  {"не удалось создать площадку"},
+		"failed to save translation":              {"не удалось сохранить перевод"},
+		"failed to cancel":                        {"не удалось отменить регистрацию"},
+		"failed to check registration":            {"не удалось проверить регистрацию"},
+		"failed to create member":                 {"не удалось создать участника"},
+		"failed to delete event":                  {"не удалось удалить событие"},
+		"failed to delete member":                 {"не удалось удалить участника"},
+		"failed to erase member data":             {"не удалось удалить персональные данные"},
+		"failed to export member data":            {"не удалось выгрузить данные участника"},
+		"failed to export events":                 {"не удалось выгрузить события"},
+		"failed to export members":                {"не удалось выгрузить участников"},
+		"failed to export roster":                 {"не удалось выгрузить список участников события"},
+		"failed to get available events":          {"не удалось получить доступные события"},
+		"failed to get event":                     {"не удалось получить событие"},
+		"failed to get event types":               {"не удалось получить типы событий"},
+		"failed to get events":                    {"не удалось получить события"},
+		"failed to get info":                      {"не удалось получить информацию"},
+		"failed to get locations":                 {"не удалось получить площадки"},
+		"failed to get member":                    {"не удалось получить участника"},
+		"failed to get members":                   {"не удалось получить участников"},
+		"failed to get membership":                {"не удалось получить данные о членстве"},
+		"failed to get renewals":                  {"не удалось получить список продлений"},
+		"failed to export renewals":               {"не удалось выгрузить список продлений"},
+		"failed to import payments":               {"не удалось импортировать взносы"},
+		"failed to record payment":                {"не удалось сохранить взнос"},
+		"failed to set event access":              {"не удалось изменить доступ к событию"},
+		"failed to get registered events":         {"не удалось получить события участника"},
+		"failed to get registrations":             {"не удалось получить регистрации"},
+		"failed to get roster":                    {"не удалось получить список участников события"},
+		"failed to get upcoming events":           {"не удалось получить ближайшие события"},
+		"failed to import members":                {"не удалось импортировать участников"},
+		"failed to register":                      {"не удалось зарегистрироваться"},
+		"failed to request erasure":               {"не удалось запросить удаление данных"},
+		"failed to request data export":           {"не удалось запросить выгрузку данных"},
+		"failed to save info":                     {"не удалось сохранить информацию"},
+		"failed to save notification preferences": {"не удалось сохранить настройки уведомлений"},
+		"failed to update event":                  {"не удалось обновить событие"},
+		"failed to update member":                 {"не удалось обновить участника"},
+		"failed to update member status":          {"не удалось обновить статус участника"},
+		"failed to verify contact":                {"не удалось подтвердить контакт"},
+
+		// Notifications.
+		"%d minutes":                 {"%d минуту", "%d минуты", "%d минут"},
+		"Email address confirmation": {"Подтверждение адреса почты"},
+		"Hello, %s!\n\nYour confirmation code: %s\nThe code is valid for %s.\n\nIf you did not apply to the orchestra friends club, just ignore this email.\n": {
+			"Здравствуйте, %s!\n\nКод подтверждения адреса: %s\nКод действует %s.\n\nЕсли вы не оставляли заявку в клубе друзей оркестра, просто проигнорируйте это письмо.\n",
+		},
+		"Confirmation code: %s. Valid for %s.": {"Код подтверждения: %s. Действует %s."},
+		"Personal data erasure":                {"Удаление персональных данных"},
+		"Personal data export":                 {"Выгрузка персональных данных"},
+		"Hello, %s!\n\nYou asked for a copy of your personal data stored by the orchestra friends club. To get it, use the code: %s\nThe code is valid for %s.\n\nIf you did not ask for this, just ignore this email.\n": {
+			"Здравствуйте, %s!\n\nВы запросили копию своих персональных данных, хранящихся в клубе друзей оркестра. Чтобы получить ее, используйте код: %s\nКод действует %s.\n\nЕсли вы не запрашивали выгрузку, просто проигнорируйте это письмо.\n",
+		},
+		"Hello, %s!\n\nYou asked to erase your personal data from the orchestra friends club. To confirm, use the code: %s\nThe code is valid for %s.\n\nYour name, email and phone will be erased irreversibly. If you did not ask for this, just ignore this email.\n": {
+			"Здравствуйте, %s!\n\nВы запросили удаление своих персональных данных из клуба друзей оркестра. Для подтверждения используйте код: %s\nКод действует %s.\n\nИмя, email и телефон будут удалены без возможности восстановления. Если вы не запрашивали удаление, просто проигнорируйте это письмо.\n",
+		},
+
+		// Event reminders.
+		"2 Jan 2006, 15:04":       {"02.01.2006 15:04"},
+		"Reminder: %s":            {"Напоминание: %s"},
+		"Reminder: «%s», %s, %s.": {"Напоминание: «%s», %s, %s."},
+		"Hello, %s!\n\nWe remind you that you are registered for «%s». It takes place on %s at %s.\n\nIf your plans have changed, please cancel the registration so that someone else can take the seat.\n": {
+			"Здравствуйте, %s!\n\nНапоминаем, что вы зарегистрированы на «%s». Событие пройдет %s, место проведения: %s.\n\nЕсли ваши планы изменились, пожалуйста, отмените регистрацию, чтобы место смог занять кто-то другой.\n",
+		},
+
+		// Seat notices.
+		"Registration cancelled: %s":                              {"Регистрация отменена: %s"},
+		"Registration for «%s», %s, is cancelled: seats reduced.": {"Регистрация на «%s», %s, отменена: мест стало меньше."},
+		"Hello, %s!\n\nUnfortunately, the number of seats for «%s» on %s at %s has been reduced, and your registration had to be cancelled. If you paid for the seat, the payment will be refunded in full.\n\nWe apologize for the inconvenience.\n": {
+			"Здравствуйте, %s!\n\nК сожалению, количество мест на «%s» (%s, место проведения: %s) сократилось, и вашу регистрацию пришлось отменить. Если место было оплачено, деньги вернутся в полном объеме.\n\nПриносим извинения за неудобства.\n",
+		},
+		"Seats available: %s":                      {"Появились места: %s"},
+		"Seats for «%s», %s, are available again.": {"На «%s», %s, снова есть места."},
+		"Hello, %s!\n\nSeats for «%s» on %s at %s are available again. If you still want to attend, register once more while seats last.\n": {
+			"Здравствуйте, %s!\n\nНа «%s» (%s, место проведения: %s) снова есть места. Если вы по-прежнему хотите прийти, зарегистрируйтесь еще раз, пока места не закончились.\n",
+		},
+
+		// Export column headers.
+		"column.id":            {"ID"},
+		"column.full_name":     {"ФИО"},
+		"column.email":         {"Email"},
+		"column.phone":         {"Телефон"},
+		"column.status":        {"Статус"},
+		"column.created_at":    {"Создан"},
+		"column.updated_at":    {"Изменен"},
+		"column.title":         {"Название"},
+		"column.description":   {"Описание"},
+		"column.event_type":    {"Тип события"},
+		"column.event_date":    {"Дата"},
+		"column.location":      {"Площадка"},
+		"column.capacity":      {"Мест"},
+		"column.member_id":     {"ID участника"},
+		"column.registered_at": {"Дата регистрации"},
+		"column.event_id":      {"ID события"},
+		"column.tier":          {"Уровень"},
+		"column.amount":        {"Сумма"},
+		"column.paid_at":       {"Дата оплаты"},
+		"column.reference":     {"Номер платежа"},
+		"column.note":          {"Комментарий"},
+		"column.expires_at":    {"Действует до"},
+		"column.last_paid_at":  {"Последний взнос"},
+	},
+	EN: {
+		"%d minutes": {"%d minute", "%d minutes"},
+
+		"column.id":            {"ID"},
+		"column.full_name":     {"Full name"},
+		"column.email":         {"Email"},
+		"column.phone":         {"Phone"},
+		"column.status":        {"Status"},
+		"column.created_at":    {"Created at"},
+		"column.updated_at":    {"Updated at"},
+		"column.title":         {"Title"},
+		"column.description":   {"Description"},
+		"column.event_type":    {"Event type"},
+		"column.event_date":    {"Date"},
+		"column.location":      {"Location"},
+		"column.capacity":      {"Capacity"},
+		"column.member_id":     {"Member ID"},
+		"column.registered_at": {"Registered at"},
+		"column.event_id":      {"Event ID"},
+		"column.tier":          {"Tier"},
+		"column.amount":        {"Amount"},
+		"column.paid_at":       {"Paid at"},
+		"column.reference":     {"Reference"},
+		"column.note":          {"Note"},
+		"column.expires_at":    {"Expires at"},
+		"column.last_paid_at":  {"Last paid at"},
+	},
+}
@@ -1,13 +1,23 @@
 package metrics
 
 import (
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
 type Metrics struct {
-	ApiRequestsTotal         *prometheus.CounterVec
-	EventRegistrationsTotal  *prometheus.CounterVec
-	UserStatusDecisionsTotal *prometheus.CounterVec
+	ApiRequestsTotal           *prometheus.CounterVec
+	HTTPRequestDurationSeconds *prometheus.HistogramVec
+	DBQueryDurationSeconds     *prometheus.HistogramVec
+	EventRegistrationsTotal    *prometheus.CounterVec
+	UserStatusDecisionsTotal   *prometheus.CounterVec
+	RateLimitRejectionsTotal   *prometheus.CounterVec
+	JobsProcessedTotal         *prometheus.CounterVec
+	JobDurationSeconds         *prometheus.HistogramVec
+	JobsInQueue                *prometheus.GaugeVec
+	MembersPending             prometheus.Gauge
+	UpcomingEvents             prometheus.Gauge
+	UpcomingSeatsLeft          prometheus.Gauge
 }
 
 func New() *Metrics {
@@ -17,14 +27,30 @@ func New() *Metrics {
 				Name: "api_requests_total",
 				Help: "Total number of API requests",
 			},
-			[]string{"method", "status"},
+			[]string{"route", "method", "status"}, // route: chi pattern, "unmatched" for unknown paths
+		),
+		HTTPRequestDurationSeconds: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "http_request_duration_seconds",
+				Help:    "Duration of API requests",
+				Buckets: prometheus.DefBuckets,
+			},
+			[]string{"route", "method", "status"},
+		),
+		DBQueryDurationSeconds: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "db_query_duration_seconds",
+				Help:    "Duration of storage operations",
+				Buckets: []float64{0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5},
+			},
+			[]string{"op"},
 		),
 		EventRegistrationsTotal: prometheus.NewCounterVec(
 			prometheus.CounterOpts{
 				Name: "event_registrations_total",
 				Help: "Total number of event registrations",
 			},
-			[]string{"action"}, // "registered", "cancelled"
+			[]string{"action"}, // "registered", "pending_payment", "cancelled"
 		),
 		UserStatusDecisionsTotal: prometheus.NewCounterVec(
 			prometheus.CounterOpts{
@@ -33,13 +59,74 @@ func New() *Metrics {
 			},
 			[]string{"decision"}, // "approved", "declined"
 		),
+		RateLimitRejectionsTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "rate_limit_rejections_total",
+				Help: "Total number of requests rejected by the rate limiter",
+			},
+			[]string{"group", "scope"}, // scope: "ip", "member"
+		),
+		JobsProcessedTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "jobs_processed_total",
+				Help: "Total number of background job attempts by outcome",
+			},
+			[]string{"kind", "outcome"}, // outcome: "succeeded", "retried", "dead", "released"
+		),
+		JobDurationSeconds: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "job_duration_seconds",
+				Help:    "Duration of background job attempts",
+				Buckets: []float64{0.01, 0.05, 0.1, 0.5, 1, 5, 15, 60, 300},
+			},
+			[]string{"kind"},
+		),
+		JobsInQueue: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "jobs_in_queue",
+				Help: "Number of background jobs in the queue by status",
+			},
+			[]string{"status"},
+		),
+		MembersPending: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name: "members_pending",
+				Help: "Number of membership applications awaiting a decision",
+			},
+		),
+		UpcomingEvents: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name: "upcoming_events",
+				Help: "Number of events that have not started yet",
+			},
+		),
+		UpcomingSeatsLeft: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name: "upcoming_event_seats_left",
+				Help: "Number of free seats over the events that have not started yet",
+			},
+		),
 	}
 
 	prometheus.MustRegister(
 		m.ApiRequestsTotal,
+		m.HTTPRequestDurationSeconds,
+		m.DBQueryDurationSeconds,
 		m.EventRegistrationsTotal,
 		m.UserStatusDecisionsTotal,
+		m.RateLimitRejectionsTotal,
+		m.JobsProcessedTotal,
+		m.JobDurationSeconds,
+		m.JobsInQueue,
+		m.MembersPending,
+		m.UpcomingEvents,
+		m.UpcomingSeatsLeft,
 	)
 
 	return m
 }
+
+// RegisterDB exports the connection pool statistics of db, labelled with name.
+func (m *Metrics) RegisterDB(db *pgxpool.Pool, name string) {
+	prometheus.MustRegister(newPoolCollector(db, name))
+}
@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// poolCollector reads the statistics of a pgx pool on every scrape.
+type poolCollector struct {
+	pool *pgxpool.Pool
+
+	maxConns              *prometheus.Desc
+	totalConns            *prometheus.Desc
+	acquiredConns         *prometheus.Desc
+	idleConns             *prometheus.Desc
+	acquiresTotal         *prometheus.Desc
+	emptyAcquiresTotal    *prometheus.Desc
+	canceledAcquiresTotal *prometheus.Desc
+	acquireSecondsTotal   *prometheus.Desc
+	lifetimeClosedTotal   *prometheus.Desc
+	idleClosedTotal       *prometheus.Desc
+}
+
+func newPoolCollector(pool *pgxpool.Pool, name string) *poolCollector {
+	desc := func(metric, help string) *prometheus.Desc {
+		return prometheus.NewDesc("db_pool_"+metric, help, nil, prometheus.Labels{"db_name": name})
+	}
+
+	return &poolCollector{
+		pool:                  pool,
+		maxConns:              desc("max_connections", "Maximum number of connections of the pool"),
+		totalConns:            desc("connections", "Number of open connections, in use or idle"),
+		acquiredConns:         desc("in_use_connections", "Number of connections in use"),
+		idleConns:             desc("idle_connections", "Number of idle connections"),
+		acquiresTotal:         desc("acquires_total", "Total number of connections acquired from the pool"),
+		emptyAcquiresTotal:    desc("empty_acquires_total", "Total number of acquires that waited for a connection"),
+		canceledAcquiresTotal: desc("canceled_acquires_total", "Total number of acquires cancelled by their context"),
+		acquireSecondsTotal:   desc("acquire_seconds_total", "Total time spent acquiring connections"),
+		lifetimeClosedTotal:   desc("max_lifetime_closed_total", "Total number of connections closed for reaching their maximum lifetime"),
+		idleClosedTotal:       desc("max_idle_closed_total", "Total number of connections closed for staying idle too long"),
+	}
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+
+	ch <- prometheus.MustNewConstMetric(c.maxConns, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(stat.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquiredConns, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(stat.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquiresTotal, prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquiresTotal, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.canceledAcquiresTotal, prometheus.CounterValue, float64(stat.CanceledAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.acquireSecondsTotal, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+	ch <- prometheus.MustNewConstMetric(c.lifetimeClosedTotal, prometheus.CounterValue, float64(stat.MaxLifetimeDestroyCount()))
+	ch <- prometheus.MustNewConstMetric(c.idleClosedTotal, prometheus.CounterValue, float64(stat.MaxIdleDestroyCount()))
+}
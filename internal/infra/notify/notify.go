@@ -0,0 +1,62 @@
+// Package notify delivers messages to members by email and sms.
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/config"
+	"log/slog"
+)
+
+var ErrUnknownProvider = errors.New("unknown sms provider")
+
+type Mailer interface {
+	SendEmail(ctx context.Context, to, subject, body string) error
+}
+
+type SMSProvider interface {
+	SendSMS(ctx context.Context, phone, text string) error
+}
+
+// NewMailer returns an SMTP mailer, or a Log mailer when no SMTP host is configured.
+func NewMailer(cfg config.SMTPConfig, log *slog.Logger) Mailer {
+	if cfg.Host == "" {
+		return NewLog(log)
+	}
+
+	return NewSMTP(cfg)
+}
+
+func NewSMSProvider(cfg config.SMSConfig, log *slog.Logger) (SMSProvider, error) {
+	const op = "infra.notify.NewSMSProvider"
+
+	switch cfg.Provider {
+	case "", "fake":
+		return NewLog(log), nil
+	case "smsru":
+		return NewSMSRu(cfg), nil
+	default:
+		return nil, fmt.Errorf("%s: %q: %w", op, cfg.Provider, ErrUnknownProvider)
+	}
+}
+
+// Log is the local fake for both channels: messages are written to the log instead
+// of being delivered.
+type Log struct {
+	log *slog.Logger
+}
+
+func NewLog(log *slog.Logger) *Log {
+	return &Log{log: log.With("component", "notify")}
+}
+
+func (l *Log) SendEmail(_ context.Context, to, subject, body string) error {
+	l.log.Info("email not sent, no smtp configured", "to", to, "subject", subject, "body", body)
+	return nil
+}
+
+func (l *Log) SendSMS(_ context.Context, phone, text string) error {
+	l.log.Info("sms not sent, fake provider", "phone", phone, "text", text)
+	return nil
+}
@@ -0,0 +1,78 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/config"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const smsRuURL = "https://sms.ru/sms/send"
+
+var ErrSMSRejected = errors.New("sms rejected by provider")
+
+// SMSRu sends messages through the sms.ru http api.
+type SMSRu struct {
+	cfg    config.SMSConfig
+	client *http.Client
+}
+
+func NewSMSRu(cfg config.SMSConfig) *SMSRu {
+	return &SMSRu{cfg: cfg, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+type smsRuResponse struct {
+	Status     string `json:"status"`
+	StatusCode int    `json:"status_code"`
+	StatusText string `json:"status_text"`
+	SMS        map[string]struct {
+		Status     string `json:"status"`
+		StatusText string `json:"status_text"`
+	} `json:"sms"`
+}
+
+func (s *SMSRu) SendSMS(ctx context.Context, phone, text string) error {
+	const op = "infra.notify.SMSRu.SendSMS"
+
+	to := strings.TrimPrefix(phone, "+")
+
+	form := url.Values{}
+	form.Set("api_id", s.cfg.APIKey)
+	form.Set("to", to)
+	form.Set("msg", text)
+	form.Set("json", "1")
+	if s.cfg.Sender != "" {
+		form.Set("from", s.cfg.Sender)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, smsRuURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer resp.Body.Close()
+
+	var result smsRuResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if result.Status != "OK" {
+		return fmt.Errorf("%s: %s: %w", op, result.StatusText, ErrSMSRejected)
+	}
+	if sms, ok := result.SMS[to]; ok && sms.Status != "OK" {
+		return fmt.Errorf("%s: %s: %w", op, sms.StatusText, ErrSMSRejected)
+	}
+
+	return nil
+}
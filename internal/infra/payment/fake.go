@@ -0,0 +1,73 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/config"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+// Fake is the local provider. Payments are only logged, and they are settled by posting
+// a YooKassa-style webhook for them, e.g. {"event":"payment.succeeded","object":{"id":"fake-1"}}.
+// Without a webhook secret, allowed in the local environment only, unsigned webhooks are
+// accepted.
+type Fake struct {
+	log *slog.Logger
+	cfg config.PaymentsConfig
+}
+
+func NewFake(cfg config.PaymentsConfig, log *slog.Logger) *Fake {
+	return &Fake{log: log.With("component", "payment"), cfg: cfg}
+}
+
+func (f *Fake) Name() string {
+	return "fake"
+}
+
+func (f *Fake) CreatePayment(_ context.Context, p model.EventPayment) (model.EventPayment, error) {
+	p.ExternalID = fmt.Sprintf("fake-%d", p.ID)
+
+	confirm := url.URL{Scheme: "fake", Host: "pay", Path: "/" + p.ExternalID}
+	if f.cfg.ReturnURL != "" {
+		if u, err := url.Parse(f.cfg.ReturnURL); err == nil {
+			confirm = *u
+		}
+		q := confirm.Query()
+		q.Set("payment_id", p.ExternalID)
+		confirm.RawQuery = q.Encode()
+	}
+	p.ConfirmationURL = confirm.String()
+
+	f.log.Info("payment created, fake provider", "payment_id", p.ExternalID, "amount", p.Amount)
+	return p, nil
+}
+
+func (f *Fake) CancelPayment(_ context.Context, p model.EventPayment) error {
+	f.log.Info("payment cancelled, fake provider", "payment_id", p.ExternalID)
+	return nil
+}
+
+func (f *Fake) Refund(_ context.Context, p model.EventPayment) (string, error) {
+	f.log.Info("payment refunded, fake provider", "payment_id", p.ExternalID, "amount", p.Amount)
+	return "fake-refund-" + p.ExternalID, nil
+}
+
+func (f *Fake) ParseNotification(_ context.Context, header http.Header, body []byte) (model.PaymentNotification, error) {
+	const op = "infra.payment.Fake.ParseNotification"
+
+	if f.cfg.WebhookSecret != "" {
+		if err := verifySignature(f.cfg.WebhookSecret, header, body); err != nil {
+			return model.PaymentNotification{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	n, _, err := parseNotification(f.Name(), body)
+	if err != nil {
+		return model.PaymentNotification{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return n, nil
+}
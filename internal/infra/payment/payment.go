@@ -0,0 +1,129 @@
+// Package payment takes payments for paid events through an external provider.
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/config"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+// SignatureHeader carries the hex HMAC-SHA256 of the webhook body, optionally prefixed
+// with "sha256=".
+const SignatureHeader = "X-Webhook-Signature"
+
+var (
+	ErrUnknownProvider       = errors.New("unknown payment provider")
+	ErrNoWebhookSecret       = errors.New("webhook secret is required outside the local environment")
+	ErrInvalidSignature      = errors.New("invalid webhook signature")
+	ErrMalformedNotification = errors.New("malformed payment notification")
+	ErrRejected              = errors.New("rejected by payment provider")
+)
+
+// Provider creates, cancels and refunds payments and checks the webhooks they produce.
+type Provider interface {
+	Name() string
+	// CreatePayment registers p with the provider and returns it with ExternalID and
+	// ConfirmationURL set. Repeating the call for the same p.ID creates no second payment.
+	CreatePayment(ctx context.Context, p model.EventPayment) (model.EventPayment, error)
+	CancelPayment(ctx context.Context, p model.EventPayment) error
+	// Refund returns the whole amount of p and reports the provider's refund id.
+	Refund(ctx context.Context, p model.EventPayment) (string, error)
+	// ParseNotification verifies a webhook and reports which payment it is about. A
+	// notification about something the api does not track has an empty Status.
+	ParseNotification(ctx context.Context, header http.Header, body []byte) (model.PaymentNotification, error)
+}
+
+// NewProvider returns the configured provider. Outside the local environment the provider
+// must be named and webhooks must be signed, so that nobody can confirm a payment by posting
+// a forged notification.
+func NewProvider(cfg config.PaymentsConfig, env string, log *slog.Logger) (Provider, error) {
+	const op = "infra.payment.NewProvider"
+
+	if env != config.EnvLocal {
+		if cfg.Provider == "" {
+			return nil, fmt.Errorf("%s: no provider configured: %w", op, ErrUnknownProvider)
+		}
+		if cfg.WebhookSecret == "" {
+			return nil, fmt.Errorf("%s: %w", op, ErrNoWebhookSecret)
+		}
+	}
+
+	switch cfg.Provider {
+	case "", "fake":
+		return NewFake(cfg, log), nil
+	case "yookassa":
+		return NewYooKassa(cfg), nil
+	default:
+		return nil, fmt.Errorf("%s: %q: %w", op, cfg.Provider, ErrUnknownProvider)
+	}
+}
+
+// verifySignature checks the signature header of a webhook body against secret.
+func verifySignature(secret string, header http.Header, body []byte) error {
+	got, err := hex.DecodeString(strings.TrimPrefix(header.Get(SignatureHeader), "sha256="))
+	if err != nil || len(got) == 0 {
+		return ErrInvalidSignature
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// notification is the webhook body. Both providers use the YooKassa layout, so the fake
+// can be driven with the same payloads.
+type notification struct {
+	Type   string `json:"type"`
+	Event  string `json:"event"`
+	Object struct {
+		ID        string `json:"id"`
+		Status    string `json:"status"`
+		PaymentID string `json:"payment_id"`
+	} `json:"object"`
+}
+
+// parseNotification decodes a webhook body. For refunds ExternalID is the refunded payment,
+// the refund itself is left in the returned body.
+func parseNotification(provider string, body []byte) (model.PaymentNotification, notification, error) {
+	var n notification
+	if err := json.Unmarshal(body, &n); err != nil {
+		return model.PaymentNotification{}, n, fmt.Errorf("%w: %w", ErrMalformedNotification, err)
+	}
+	if n.Event == "" || n.Object.ID == "" {
+		return model.PaymentNotification{}, n, ErrMalformedNotification
+	}
+
+	result := model.PaymentNotification{
+		Provider:   provider,
+		Key:        n.Event + ":" + n.Object.ID,
+		ExternalID: n.Object.ID,
+	}
+
+	switch n.Event {
+	case "payment.succeeded":
+		result.Status = model.PaymentSucceeded
+	case "payment.canceled":
+		result.Status = model.PaymentCanceled
+	case "refund.succeeded":
+		if n.Object.PaymentID == "" {
+			return model.PaymentNotification{}, n, ErrMalformedNotification
+		}
+		result.ExternalID = n.Object.PaymentID
+		result.Status = model.PaymentRefunded
+	}
+
+	return result, n, nil
+}
@@ -0,0 +1,193 @@
+package payment
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/config"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const yooKassaURL = "https://api.yookassa.ru/v3"
+
+// YooKassa takes payments through the YooKassa api. Payments are captured at once, so a
+// payment the member has not completed can not be cancelled and simply expires on the
+// provider side. Webhooks are checked against WebhookSecret when it is set, and their
+// status is always confirmed by reading the payment or refund back from the api.
+type YooKassa struct {
+	cfg    config.PaymentsConfig
+	client *http.Client
+}
+
+func NewYooKassa(cfg config.PaymentsConfig) *YooKassa {
+	return &YooKassa{cfg: cfg, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+type yooKassaAmount struct {
+	Value    string `json:"value"`
+	Currency string `json:"currency"`
+}
+
+type yooKassaPayment struct {
+	ID           string `json:"id"`
+	Status       string `json:"status"`
+	Confirmation struct {
+		ConfirmationURL string `json:"confirmation_url"`
+	} `json:"confirmation"`
+}
+
+type yooKassaRefund struct {
+	ID        string `json:"id"`
+	Status    string `json:"status"`
+	PaymentID string `json:"payment_id"`
+}
+
+type yooKassaError struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
+}
+
+func (y *YooKassa) Name() string {
+	return "yookassa"
+}
+
+func (y *YooKassa) CreatePayment(ctx context.Context, p model.EventPayment) (model.EventPayment, error) {
+	const op = "infra.payment.YooKassa.CreatePayment"
+
+	body := map[string]any{
+		"amount":       kopecksAmount(p.Amount),
+		"capture":      true,
+		"confirmation": map[string]string{"type": "redirect", "return_url": y.cfg.ReturnURL},
+		"description":  p.EventTitle,
+		"metadata": map[string]string{
+			"payment_id": strconv.Itoa(p.ID),
+			"event_id":   strconv.Itoa(p.EventID),
+			"member_id":  p.MemberID.String(),
+		},
+	}
+
+	var created yooKassaPayment
+	if err := y.call(ctx, http.MethodPost, "/payments", "payment-"+strconv.Itoa(p.ID), body, &created); err != nil {
+		return p, fmt.Errorf("%s: %w", op, err)
+	}
+
+	p.ExternalID = created.ID
+	p.ConfirmationURL = created.Confirmation.ConfirmationURL
+	return p, nil
+}
+
+func (y *YooKassa) CancelPayment(_ context.Context, _ model.EventPayment) error {
+	return nil
+}
+
+func (y *YooKassa) Refund(ctx context.Context, p model.EventPayment) (string, error) {
+	const op = "infra.payment.YooKassa.Refund"
+
+	body := map[string]any{
+		"payment_id": p.ExternalID,
+		"amount":     kopecksAmount(p.Amount),
+	}
+
+	var refund yooKassaRefund
+	if err := y.call(ctx, http.MethodPost, "/refunds", "refund-"+strconv.Itoa(p.ID), body, &refund); err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	if refund.Status == "canceled" {
+		return "", fmt.Errorf("%s: refund %s canceled: %w", op, refund.ID, ErrRejected)
+	}
+
+	return refund.ID, nil
+}
+
+func (y *YooKassa) ParseNotification(ctx context.Context, header http.Header, body []byte) (model.PaymentNotification, error) {
+	const op = "infra.payment.YooKassa.ParseNotification"
+
+	if y.cfg.WebhookSecret != "" {
+		if err := verifySignature(y.cfg.WebhookSecret, header, body); err != nil {
+			return model.PaymentNotification{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	n, raw, err := parseNotification(y.Name(), body)
+	if err != nil {
+		return model.PaymentNotification{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var status string
+	switch n.Status {
+	case "":
+		return n, nil
+	case model.PaymentRefunded:
+		var refund yooKassaRefund
+		err = y.call(ctx, http.MethodGet, "/refunds/"+url.PathEscape(raw.Object.ID), "", nil, &refund)
+		status = refund.Status
+		if err == nil && refund.PaymentID != n.ExternalID {
+			err = ErrInvalidSignature
+		}
+	default:
+		var payment yooKassaPayment
+		err = y.call(ctx, http.MethodGet, "/payments/"+url.PathEscape(n.ExternalID), "", nil, &payment)
+		status = payment.Status
+	}
+	if err != nil {
+		return model.PaymentNotification{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	want := string(n.Status)
+	if n.Status == model.PaymentRefunded {
+		want = "succeeded"
+	}
+	if status != want {
+		return model.PaymentNotification{}, fmt.Errorf("%s: status %q is %q at the provider: %w", op, n.Status, status, ErrInvalidSignature)
+	}
+
+	return n, nil
+}
+
+func (y *YooKassa) call(ctx context.Context, method, path, idempotenceKey string, body, out any) error {
+	var payload io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		payload = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, yooKassaURL+path, payload)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(y.cfg.ShopID, y.cfg.SecretKey)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if idempotenceKey != "" {
+		req.Header.Set("Idempotence-Key", idempotenceKey)
+	}
+
+	resp, err := y.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		var apiErr yooKassaError
+		_ = json.NewDecoder(resp.Body).Decode(&apiErr)
+		return fmt.Errorf("%d %s: %s: %w", resp.StatusCode, apiErr.Code, apiErr.Description, ErrRejected)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// kopecksAmount formats an amount in kopecks the way the api expects it.
+func kopecksAmount(kopecks int64) yooKassaAmount {
+	return yooKassaAmount{Value: fmt.Sprintf("%d.%02d", kopecks/100, kopecks%100), Currency: "RUB"}
+}
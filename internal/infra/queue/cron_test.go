@@ -0,0 +1,101 @@
+package queue
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseScheduleInvalid(t *testing.T) {
+	specs := []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+		"1-x * * * *",
+		"@every",
+		"@every x",
+		"@every -1m",
+		"@yearly",
+	}
+
+	for _, spec := range specs {
+		t.Run(spec, func(t *testing.T) {
+			if _, err := ParseSchedule(spec); !errors.Is(err, ErrInvalidSchedule) {
+				t.Fatalf("ParseSchedule(%q) error = %v, want ErrInvalidSchedule", spec, err)
+			}
+		})
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	at := func(s string) time.Time {
+		t.Helper()
+		v, err := time.Parse("2006-01-02 15:04", s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+
+	// 2026-10-18 is a Sunday.
+	tests := []struct {
+		name string
+		spec string
+		from string
+		want string
+	}{
+		{"every minute", "* * * * *", "2026-10-18 10:07", "2026-10-18 10:08"},
+		{"next is strictly after", "7 10 * * *", "2026-10-18 10:07", "2026-10-19 10:07"},
+		{"minute step", "*/15 * * * *", "2026-10-18 10:07", "2026-10-18 10:15"},
+		{"step over a range", "10-30/10 * * * *", "2026-10-18 10:25", "2026-10-18 10:30"},
+		{"step over a range wraps to the next hour", "10-30/10 * * * *", "2026-10-18 10:30", "2026-10-18 11:10"},
+		{"step from a value", "50/5 * * * *", "2026-10-18 10:56", "2026-10-18 11:50"},
+		{"list", "0 8,20 * * *", "2026-10-18 10:00", "2026-10-18 20:00"},
+		{"weekdays skip the weekend", "0 12 * * 1-5", "2026-10-23 13:00", "2026-10-26 12:00"},
+		{"sunday as 0", "0 0 * * 0", "2026-10-19 00:00", "2026-10-25 00:00"},
+		{"sunday as 7", "0 0 * * 7", "2026-10-19 00:00", "2026-10-25 00:00"},
+		{"day of month only", "0 9 1 * *", "2026-10-18 12:00", "2026-11-01 09:00"},
+		{"day of month or weekday, weekday first", "0 9 1 * 1", "2026-10-18 12:00", "2026-10-19 09:00"},
+		{"day of month or weekday, day first", "0 9 1 * 1", "2026-10-27 12:00", "2026-11-01 09:00"},
+		{"month", "0 0 1 1 *", "2026-10-18 12:00", "2027-01-01 00:00"},
+		{"leap day", "0 0 29 2 *", "2026-10-18 12:00", "2028-02-29 00:00"},
+		{"hourly", "@hourly", "2026-10-18 10:07", "2026-10-18 11:00"},
+		{"daily", "@daily", "2026-10-18 10:07", "2026-10-19 00:00"},
+		{"weekly", "@weekly", "2026-10-18 10:07", "2026-10-25 00:00"},
+		{"monthly", "@monthly", "2026-10-18 10:07", "2026-11-01 00:00"},
+		{"every is aligned", "@every 1h", "2026-10-18 10:07", "2026-10-18 11:00"},
+		{"every on the boundary", "@every 15m", "2026-10-18 10:15", "2026-10-18 10:30"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseSchedule(tt.spec)
+			if err != nil {
+				t.Fatalf("ParseSchedule(%q): %v", tt.spec, err)
+			}
+
+			if got := schedule.Next(at(tt.from)); !got.Equal(at(tt.want)) {
+				t.Errorf("Next(%s) = %s, want %s", tt.from, got.Format("2006-01-02 15:04"), tt.want)
+			}
+		})
+	}
+}
+
+func TestScheduleNextNever(t *testing.T) {
+	schedule, err := ParseSchedule("0 0 30 2 *")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := schedule.Next(time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)); !got.IsZero() {
+		t.Errorf("Next = %s, want the zero time", got)
+	}
+}
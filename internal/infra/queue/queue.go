@@ -0,0 +1,368 @@
+// Package queue runs background jobs from a queue kept in Postgres. Any number of replicas
+// can run it: jobs are claimed with FOR UPDATE SKIP LOCKED and every run of a periodic job
+// is enqueued only once.
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/config"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/metrics"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"log/slog"
+	"math/rand/v2"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// maintenanceInterval is how often lost jobs are recovered and the queue gauges refreshed.
+const maintenanceInterval = 30 * time.Second
+
+var (
+	ErrUnknownKind     = errors.New("unknown job kind")
+	ErrInvalidSchedule = errors.New("invalid schedule")
+)
+
+type Store interface {
+	EnqueueJob(ctx context.Context, job model.Job) (int64, bool, error)
+	ScheduleJob(ctx context.Context, job model.Job) (bool, error)
+	ClaimJobs(ctx context.Context, kinds []string, worker string, limit int) ([]model.Job, error)
+	CompleteJob(ctx context.Context, id int64) error
+	FailJob(ctx context.Context, id int64, reason string, retryAt time.Time) (model.JobStatus, error)
+	ReleaseJob(ctx context.Context, id int64) error
+	RecoverStaleJobs(ctx context.Context, lockedBefore time.Time) (int, error)
+	CountJobs(ctx context.Context) (map[model.JobStatus]int, error)
+}
+
+type handlerFunc func(ctx context.Context, payload json.RawMessage) error
+
+type periodic struct {
+	kind     string
+	schedule Schedule
+	next     time.Time
+}
+
+// Runner executes the jobs of the kinds registered with it and enqueues periodic jobs on
+// their schedules.
+type Runner struct {
+	log      *slog.Logger
+	store    Store
+	cfg      config.JobsConfig
+	metrics  *metrics.Metrics
+	worker   string
+	handlers map[string]handlerFunc
+	periodic []*periodic
+}
+
+func NewRunner(log *slog.Logger, store Store, cfg config.JobsConfig, metrics *metrics.Metrics) *Runner {
+	host, _ := os.Hostname()
+
+	return &Runner{
+		log:      log.With("component", "queue"),
+		store:    store,
+		cfg:      cfg,
+		metrics:  metrics,
+		worker:   host + ":" + strconv.Itoa(os.Getpid()),
+		handlers: make(map[string]handlerFunc),
+	}
+}
+
+// Handle registers fn for jobs of kind. The payload of a job is decoded into T.
+func Handle[T any](r *Runner, kind string, fn func(ctx context.Context, payload T) error) {
+	r.handlers[kind] = func(ctx context.Context, raw json.RawMessage) error {
+		var payload T
+		if err := json.Unmarshal(raw, &payload); err != nil {
+			return fmt.Errorf("decode payload: %w", err)
+		}
+		return fn(ctx, payload)
+	}
+}
+
+// HandleFunc registers fn for jobs of kind that carry no payload.
+func HandleFunc(r *Runner, kind string, fn func(ctx context.Context) error) {
+	r.handlers[kind] = func(ctx context.Context, _ json.RawMessage) error {
+		return fn(ctx)
+	}
+}
+
+// Enqueue adds a job of kind with payload, to run at runAt. A non-empty dedupeKey that was
+// used before makes it a no-op, reported by created being false.
+func Enqueue[T any](ctx context.Context, r *Runner, kind string, payload T, runAt time.Time, dedupeKey string) (id int64, created bool, err error) {
+	const op = "infra.queue.Enqueue"
+
+	job, err := r.job(kind, payload, runAt, dedupeKey)
+	if err != nil {
+		return 0, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	id, created, err = r.store.EnqueueJob(ctx, job)
+	if err != nil {
+		return 0, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, created, nil
+}
+
+// Job makes a job of kind with payload to run as soon as a worker takes it, for the storage
+// to enqueue in the transaction of the change it announces. It lets the services announce
+// what happened without knowing who handles it.
+func (r *Runner) Job(kind string, payload any) (model.Job, error) {
+	const op = "infra.queue.Runner.Job"
+
+	job, err := r.job(kind, payload, time.Now(), "")
+	if err != nil {
+		return model.Job{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return job, nil
+}
+
+func (r *Runner) job(kind string, payload any, runAt time.Time, dedupeKey string) (model.Job, error) {
+	if _, ok := r.handlers[kind]; !ok {
+		return model.Job{}, fmt.Errorf("%q: %w", kind, ErrUnknownKind)
+	}
+
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return model.Job{}, err
+	}
+
+	return model.Job{
+		Kind:        kind,
+		Payload:     raw,
+		MaxAttempts: r.cfg.MaxAttempts,
+		RunAt:       runAt,
+		DedupeKey:   dedupeKey,
+	}, nil
+}
+
+// Schedule makes kind a periodic job run on spec, see ParseSchedule. A spec configured in
+// JobsConfig.Schedules for the kind takes precedence.
+func (r *Runner) Schedule(kind, spec string) error {
+	const op = "infra.queue.Runner.Schedule"
+
+	if _, ok := r.handlers[kind]; !ok {
+		return fmt.Errorf("%s: %q: %w", op, kind, ErrUnknownKind)
+	}
+	if configured, ok := r.cfg.Schedules[kind]; ok {
+		spec = configured
+	}
+
+	schedule, err := ParseSchedule(spec)
+	if err != nil {
+		return fmt.Errorf("%s: %s: %w", op, kind, err)
+	}
+
+	r.periodic = append(r.periodic, &periodic{kind: kind, schedule: schedule})
+	return nil
+}
+
+// Run works the queue until ctx is cancelled. It then stops taking jobs and waits for the
+// running ones; jobs still running after ShutdownTimeout are cancelled and put back to the
+// queue.
+func (r *Runner) Run(ctx context.Context) {
+	const op = "infra.queue.Runner.Run"
+
+	log := r.log.With(slog.String("op", op), slog.String("worker", r.worker))
+
+	kinds := make([]string, 0, len(r.handlers))
+	for kind := range r.handlers {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	log.Info("job runner started", "kinds", kinds, "workers", r.cfg.Workers)
+
+	workCtx, cancelWork := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelWork()
+
+	var wg sync.WaitGroup
+	for i := 0; i < r.cfg.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r.work(ctx, workCtx, kinds)
+		}()
+	}
+
+	r.schedule(ctx)
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(r.cfg.ShutdownTimeout):
+		log.Warn("jobs still running at shutdown, cancelling them")
+		cancelWork()
+		<-done
+	}
+
+	log.Info("job runner stopped")
+}
+
+// work claims and runs jobs one at a time until ctx is cancelled.
+func (r *Runner) work(ctx, workCtx context.Context, kinds []string) {
+	for ctx.Err() == nil {
+		jobs, err := r.store.ClaimJobs(ctx, kinds, r.worker, 1)
+		if err != nil && ctx.Err() == nil {
+			r.log.Error("failed to claim jobs", "error", err)
+		}
+		if len(jobs) == 0 {
+			select {
+			case <-ctx.Done():
+			case <-time.After(r.cfg.PollInterval):
+			}
+			continue
+		}
+
+		for _, job := range jobs {
+			r.runJob(workCtx, job)
+		}
+	}
+}
+
+func (r *Runner) runJob(workCtx context.Context, job model.Job) {
+	log := r.log.With(slog.Int64("job_id", job.ID), slog.String("kind", job.Kind), slog.Int("attempt", job.Attempts))
+
+	jobCtx, cancel := context.WithTimeout(workCtx, r.cfg.Timeout)
+	start := time.Now()
+	err := r.call(jobCtx, job)
+	cancel()
+	r.metrics.JobDurationSeconds.WithLabelValues(job.Kind).Observe(time.Since(start).Seconds())
+
+	// The outcome is recorded even when the job was cancelled by shutdown.
+	storeCtx := context.WithoutCancel(workCtx)
+
+	var outcome string
+	switch {
+	case err == nil:
+		outcome = "succeeded"
+		if err := r.store.CompleteJob(storeCtx, job.ID); err != nil {
+			log.Error("failed to complete job", "error", err)
+		}
+	case workCtx.Err() != nil:
+		outcome = "released"
+		log.Warn("job interrupted by shutdown", "error", err)
+		if err := r.store.ReleaseJob(storeCtx, job.ID); err != nil {
+			log.Error("failed to release job", "error", err)
+		}
+	default:
+		retryAt := time.Now().Add(r.backoff(job.Attempts))
+		status, ferr := r.store.FailJob(storeCtx, job.ID, err.Error(), retryAt)
+		if ferr != nil {
+			log.Error("failed to record job failure", "error", ferr)
+		}
+		if status == model.JobDead {
+			outcome = "dead"
+			log.Error("job failed, no attempts left", "error", err)
+		} else {
+			outcome = "retried"
+			log.Warn("job failed, will retry", "retry_at", retryAt, "error", err)
+		}
+	}
+
+	r.metrics.JobsProcessedTotal.WithLabelValues(job.Kind, outcome).Inc()
+}
+
+// call runs the handler of the job, turning a panic into an error.
+func (r *Runner) call(ctx context.Context, job model.Job) (err error) {
+	handler, ok := r.handlers[job.Kind]
+	if !ok {
+		return ErrUnknownKind
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("panic: %v", p)
+		}
+	}()
+
+	return handler(ctx, job.Payload)
+}
+
+// backoff returns the delay before the next attempt: RetryBackoff doubled for every attempt
+// made, capped at MaxBackoff, with up to a tenth added at random so retries spread out.
+func (r *Runner) backoff(attempts int) time.Duration {
+	d := r.cfg.RetryBackoff
+	for i := 1; i < attempts && d < r.cfg.MaxBackoff; i++ {
+		d *= 2
+	}
+	d = min(d, r.cfg.MaxBackoff)
+
+	return d + time.Duration(rand.Int64N(int64(d)/10+1))
+}
+
+// schedule enqueues periodic jobs when they are due and looks after the queue until ctx is
+// cancelled.
+func (r *Runner) schedule(ctx context.Context) {
+	now := time.Now()
+	for _, p := range r.periodic {
+		p.next = p.schedule.Next(now)
+	}
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	var maintainedAt time.Time
+	for {
+		now := time.Now()
+		for _, p := range r.periodic {
+			if p.next.IsZero() || now.Before(p.next) {
+				continue
+			}
+
+			_, err := r.store.ScheduleJob(ctx, model.Job{
+				Kind:        p.kind,
+				Payload:     json.RawMessage("{}"),
+				MaxAttempts: r.cfg.MaxAttempts,
+				RunAt:       p.next,
+				DedupeKey:   p.kind + "@" + p.next.UTC().Format(time.RFC3339),
+			})
+			if err != nil && ctx.Err() == nil {
+				r.log.Error("failed to schedule job", "kind", p.kind, "error", err)
+			}
+			p.next = p.schedule.Next(now)
+		}
+
+		if now.Sub(maintainedAt) >= maintenanceInterval {
+			r.maintain(ctx)
+			maintainedAt = now
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// maintain puts back jobs whose replica has gone and refreshes the queue gauges.
+func (r *Runner) maintain(ctx context.Context) {
+	lost, err := r.store.RecoverStaleJobs(ctx, time.Now().Add(-r.cfg.Timeout-time.Minute))
+	if err != nil && ctx.Err() == nil {
+		r.log.Error("failed to recover lost jobs", "error", err)
+	}
+	if lost > 0 {
+		r.log.Warn("lost jobs recovered", "count", lost)
+	}
+
+	counts, err := r.store.CountJobs(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			r.log.Error("failed to count jobs", "error", err)
+		}
+		return
+	}
+	for _, status := range []model.JobStatus{model.JobPending, model.JobRunning, model.JobSucceeded, model.JobDead} {
+		r.metrics.JobsInQueue.WithLabelValues(string(status)).Set(float64(counts[status]))
+	}
+}
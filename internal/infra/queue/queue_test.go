@@ -0,0 +1,33 @@
+package queue
+
+import (
+	"github.com/Ilya-Repin/orchestra_api/internal/config"
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	r := &Runner{cfg: config.JobsConfig{RetryBackoff: 10 * time.Second, MaxBackoff: time.Hour}}
+
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, 10 * time.Second},
+		{2, 20 * time.Second},
+		{3, 40 * time.Second},
+		{9, 2560 * time.Second},
+		{10, time.Hour},
+		{50, time.Hour},
+	}
+
+	for _, tt := range tests {
+		// The jitter adds up to a tenth of the delay.
+		for range 20 {
+			got := r.backoff(tt.attempts)
+			if got < tt.want || got > tt.want+tt.want/10 {
+				t.Fatalf("backoff(%d) = %s, want between %s and %s", tt.attempts, got, tt.want, tt.want+tt.want/10)
+			}
+		}
+	}
+}
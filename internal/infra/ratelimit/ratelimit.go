@@ -0,0 +1,106 @@
+// Package ratelimit implements token buckets used to throttle clients.
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"github.com/Ilya-Repin/orchestra_api/internal/config"
+	"math"
+	"sync"
+	"time"
+)
+
+var ErrUnknownBackend = errors.New("unknown rate limit backend")
+
+// Bucket refills Rate tokens per second up to Burst tokens; every request takes one.
+type Bucket struct {
+	Rate  float64
+	Burst float64
+}
+
+// BucketFromConfig converts a configured bucket. ok is false for a disabled bucket.
+func BucketFromConfig(cfg config.BucketConfig) (b Bucket, ok bool) {
+	if cfg.Requests <= 0 || cfg.Period <= 0 {
+		return Bucket{}, false
+	}
+
+	burst := cfg.Burst
+	if burst <= 0 {
+		burst = cfg.Requests
+	}
+
+	return Bucket{Rate: float64(cfg.Requests) / cfg.Period.Seconds(), Burst: float64(burst)}, true
+}
+
+// RetryAfter is how long it takes to refill the bucket from tokens to one token.
+func (b Bucket) RetryAfter(tokens float64) time.Duration {
+	if tokens >= 1 {
+		return 0
+	}
+
+	return time.Duration(math.Ceil((1 - tokens) / b.Rate * float64(time.Second)))
+}
+
+type Decision struct {
+	Allowed    bool
+	RetryAfter time.Duration
+}
+
+// Store keeps bucket state. Implementations must take tokens atomically, since the
+// same key is hit concurrently.
+type Store interface {
+	Take(ctx context.Context, key string, bucket Bucket) (Decision, error)
+}
+
+// Memory keeps buckets in the process. It suits a single replica and local runs.
+type Memory struct {
+	mu      sync.Mutex
+	buckets map[string]*memoryBucket
+	calls   int
+}
+
+type memoryBucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+func NewMemory() *Memory {
+	return &Memory{buckets: make(map[string]*memoryBucket)}
+}
+
+func (m *Memory) Take(_ context.Context, key string, bucket Bucket) (Decision, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+
+	m.calls++
+	if m.calls%10000 == 0 {
+		m.prune(now)
+	}
+
+	b, ok := m.buckets[key]
+	if !ok {
+		b = &memoryBucket{tokens: bucket.Burst, updated: now}
+		m.buckets[key] = b
+	}
+
+	b.tokens = math.Min(bucket.Burst, b.tokens+now.Sub(b.updated).Seconds()*bucket.Rate)
+	b.updated = now
+
+	if b.tokens < 1 {
+		return Decision{RetryAfter: bucket.RetryAfter(b.tokens)}, nil
+	}
+
+	b.tokens--
+	return Decision{Allowed: true}, nil
+}
+
+// prune drops buckets idle for a day; they would be refilled by now anyway.
+func (m *Memory) prune(now time.Time) {
+	for key, b := range m.buckets {
+		if now.Sub(b.updated) > 24*time.Hour {
+			delete(m.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package ratelimit
+
+import (
+	"context"
+	"github.com/Ilya-Repin/orchestra_api/internal/config"
+	"testing"
+	"time"
+)
+
+func TestBucketFromConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    config.BucketConfig
+		want   Bucket
+		wantOK bool
+	}{
+		{"disabled without requests", config.BucketConfig{Period: time.Minute}, Bucket{}, false},
+		{"disabled without period", config.BucketConfig{Requests: 10}, Bucket{}, false},
+		{"burst defaults to requests", config.BucketConfig{Requests: 60, Period: time.Minute}, Bucket{Rate: 1, Burst: 60}, true},
+		{"explicit burst", config.BucketConfig{Requests: 10, Period: 10 * time.Second, Burst: 3}, Bucket{Rate: 1, Burst: 3}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := BucketFromConfig(tt.cfg)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("BucketFromConfig(%+v) = %+v, %v, want %+v, %v", tt.cfg, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestBucketRetryAfter(t *testing.T) {
+	tests := []struct {
+		name   string
+		bucket Bucket
+		tokens float64
+		want   time.Duration
+	}{
+		{"a token left", Bucket{Rate: 1, Burst: 5}, 1, 0},
+		{"empty", Bucket{Rate: 1, Burst: 5}, 0, time.Second},
+		{"half a token", Bucket{Rate: 1, Burst: 5}, 0.5, 500 * time.Millisecond},
+		{"slow refill", Bucket{Rate: 1.0 / 60, Burst: 5}, 0, time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.bucket.RetryAfter(tt.tokens); got != tt.want {
+				t.Errorf("RetryAfter(%v) = %s, want %s", tt.tokens, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMemoryTake(t *testing.T) {
+	// One token an hour, so no refill happens during the test.
+	bucket := Bucket{Rate: 1.0 / 3600, Burst: 3}
+
+	tests := []struct {
+		name    string
+		key     string
+		allowed bool
+	}{
+		{"first of the burst", "a", true},
+		{"second of the burst", "a", true},
+		{"last of the burst", "a", true},
+		{"burst used up", "a", false},
+		{"other key has its own bucket", "b", true},
+		{"still used up", "a", false},
+	}
+
+	store := NewMemory()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := store.Take(context.Background(), tt.key, bucket)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d.Allowed != tt.allowed {
+				t.Fatalf("Allowed = %v, want %v", d.Allowed, tt.allowed)
+			}
+			if !d.Allowed && (d.RetryAfter <= 59*time.Minute || d.RetryAfter > time.Hour) {
+				t.Errorf("RetryAfter = %s, want about an hour", d.RetryAfter)
+			}
+		})
+	}
+}
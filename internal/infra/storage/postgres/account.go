@@ -0,0 +1,230 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/storage"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"time"
+)
+
+// GetMemberRegistrations lists every registration of the member, cancelled ones included,
+// latest event first.
+func (s *PostgresStorage) GetMemberRegistrations(ctx context.Context, memberID uuid.UUID) ([]model.MemberRegistration, error) {
+	const op = "infra.storage.postgres.GetMemberRegistrations"
+	defer s.observe(op, time.Now())
+
+	query := `
+		SELECT e.id, e.title, e.event_date, l.name, r.registration_status, r.created_at, r.updated_at
+		FROM registrations r
+		JOIN events e ON r.event_id = e.id
+		JOIN locations l ON e.location = l.id
+		WHERE r.user_id = $1
+		ORDER BY e.event_date DESC;
+	`
+
+	rows, err := s.reader().Query(ctx, query, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var registrations []model.MemberRegistration
+	for rows.Next() {
+		var r model.MemberRegistration
+		err := rows.Scan(&r.EventID, &r.Title, &r.EventDate, &r.Location, &r.Status, &r.RegisteredAt, &r.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		registrations = append(registrations, r)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return registrations, nil
+}
+
+// SetNotificationPreferences stores the channels the member agrees to be notified through.
+func (s *PostgresStorage) SetNotificationPreferences(ctx context.Context, memberID uuid.UUID, prefs model.NotificationPreferences) error {
+	const op = "infra.storage.postgres.SetNotificationPreferences"
+	defer s.observe(op, time.Now())
+
+	query := "UPDATE club_members SET notify_email = $1, notify_sms = $2 WHERE id = $3 AND erased_at IS NULL;"
+
+	res, err := s.db.Exec(ctx, query, prefs.Email, prefs.SMS, memberID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected := res.RowsAffected()
+	if affected == 0 {
+		return storage.ErrMemberNotFound
+	}
+
+	return nil
+}
+
+// SaveAccountCode stores a new code for the purpose, replacing the member's previous one and
+// resetting its attempt counter. A previous code created after replaceBefore is kept and
+// storage.ErrCodeRecentlySent is returned.
+func (s *PostgresStorage) SaveAccountCode(ctx context.Context, code model.AccountCode, replaceBefore time.Time) error {
+	const op = "infra.storage.postgres.SaveAccountCode"
+	defer s.observe(op, time.Now())
+
+	query := `
+		INSERT INTO account_codes (member_id, purpose, code_hash, attempts, expires_at)
+		VALUES ($1, $2, $3, 0, $4)
+		ON CONFLICT (member_id, purpose)
+		DO UPDATE SET code_hash = EXCLUDED.code_hash, attempts = 0, expires_at = EXCLUDED.expires_at, created_at = CURRENT_TIMESTAMP
+		WHERE account_codes.created_at < $5;
+	`
+
+	res, err := s.db.Exec(ctx, query, code.MemberID, code.Purpose, code.CodeHash, code.ExpiresAt, replaceBefore)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, dbError(err))
+	}
+
+	affected := res.RowsAffected()
+	if affected == 0 {
+		return storage.ErrCodeRecentlySent
+	}
+
+	return nil
+}
+
+// UseAccountCode counts an attempt against the member's code for the purpose and returns it
+// with the updated counter.
+func (s *PostgresStorage) UseAccountCode(ctx context.Context, memberID uuid.UUID, purpose model.CodePurpose) (model.AccountCode, error) {
+	const op = "infra.storage.postgres.UseAccountCode"
+	defer s.observe(op, time.Now())
+
+	query := `
+		UPDATE account_codes
+		SET attempts = attempts + 1
+		WHERE member_id = $1 AND purpose = $2
+		RETURNING member_id, purpose, code_hash, attempts, expires_at, created_at;
+	`
+
+	var code model.AccountCode
+	err := s.db.QueryRow(ctx, query, memberID, purpose).Scan(
+		&code.MemberID, &code.Purpose, &code.CodeHash, &code.Attempts, &code.ExpiresAt, &code.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.AccountCode{}, storage.ErrCodeNotFound
+		}
+		return model.AccountCode{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return code, nil
+}
+
+// DeleteAccountCode forgets the member's code for the purpose once it has served.
+func (s *PostgresStorage) DeleteAccountCode(ctx context.Context, memberID uuid.UUID, purpose model.CodePurpose) error {
+	const op = "infra.storage.postgres.DeleteAccountCode"
+	defer s.observe(op, time.Now())
+
+	if _, err := s.db.Exec(ctx, "DELETE FROM account_codes WHERE member_id = $1 AND purpose = $2;", memberID, purpose); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// EraseMember anonymizes the member in place: the name is cleared and the contacts are
+// replaced with placeholders, while past registrations stay for attendance statistics.
+// Registrations for upcoming events are cancelled to free the seats; their pending payments
+// are cancelled and paid ones marked for refund, however close the event is, since the member
+// can no longer attend. The settled payments are returned for the provider. A non-zero
+// version makes the erasure conditional on the row still being at that version.
+func (s *PostgresStorage) EraseMember(ctx context.Context, id uuid.UUID, version int) ([]model.EventPayment, error) {
+	const op = "infra.storage.postgres.EraseMember"
+	defer s.observe(op, time.Now())
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE club_members
+		SET full_name = '',
+		    email = id || '@erased.invalid',
+		    phone = 'erased:' || id,
+		    email_verified_at = NULL,
+		    phone_verified_at = NULL,
+		    language = NULL,
+		    notify_email = FALSE,
+		    notify_sms = FALSE,
+		    erased_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND erased_at IS NULL AND ($2 = 0 OR version = $2);
+	`
+
+	res, err := tx.Exec(ctx, query, id, version)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected := res.RowsAffected()
+	if affected == 0 {
+		return nil, s.missingOrStale(ctx, op, memberExistsQuery, id, storage.ErrMemberNotFound)
+	}
+
+	cancelQuery := `
+		UPDATE registrations
+		SET registration_status = 'cancelled', payment_expires_at = NULL, bumped_at = NULL
+		WHERE user_id = $1
+		  AND registration_status IN ('registered', 'pending_payment')
+		  AND event_id IN (SELECT id FROM events WHERE event_date > CURRENT_TIMESTAMP)
+		RETURNING id;
+	`
+	rows, err := tx.Query(ctx, cancelQuery, id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	var cancelled []int
+	for rows.Next() {
+		var regID int
+		if err := rows.Scan(&regID); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		cancelled = append(cancelled, regID)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var payments []model.EventPayment
+	for _, regID := range cancelled {
+		payment, err := settleCancelledPayment(ctx, tx, regID, time.Time{})
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if payment != nil {
+			payments = append(payments, *payment)
+		}
+	}
+
+	for _, q := range []string{
+		"DELETE FROM verification_codes WHERE member_id = $1;",
+		"DELETE FROM account_codes WHERE member_id = $1;",
+	} {
+		if _, err := tx.Exec(ctx, q, id); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return payments, nil
+}
@@ -0,0 +1,483 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/storage"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"time"
+)
+
+// The registration figures come from the event_stats materialized view, refreshed by
+// RefreshEventStats; milestones and membership figures are read live.
+
+// RefreshEventStats recomputes the registration figures of all events without blocking
+// the readers of the view.
+func (s *PostgresStorage) RefreshEventStats(ctx context.Context) error {
+	const op = "infra.storage.postgres.RefreshEventStats"
+	defer s.observe(op, time.Now())
+
+	if _, err := s.db.Exec(ctx, "REFRESH MATERIALIZED VIEW CONCURRENTLY event_stats;"); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetEventStats returns the figures of the events held in the date range, optionally of
+// one type or at one location, in date order.
+func (s *PostgresStorage) GetEventStats(ctx context.Context, r model.DateRange, eventType, location *int) ([]model.EventStats, error) {
+	const op = "infra.storage.postgres.GetEventStats"
+	defer s.observe(op, time.Now())
+
+	query := `
+		SELECT e.id, e.title, e.event_date, e.event_type, e.location, e.capacity, e.created_at,
+		       m.sold_out_at, m.attendance_recorded_at IS NOT NULL,
+		       COALESCE(st.registered, 0), COALESCE(st.pending, 0), COALESCE(st.cancelled, 0),
+		       COALESCE(st.attended, 0), COALESCE(st.no_show, 0)
+		FROM events e
+		LEFT JOIN event_milestones m ON m.event_id = e.id
+		LEFT JOIN event_stats st ON st.event_id = e.id
+		WHERE e.event_date >= $1 AND e.event_date < $2
+		  AND ($3::int IS NULL OR e.event_type = $3)
+		  AND ($4::int IS NULL OR e.location = $4)
+		ORDER BY e.event_date, e.id;
+	`
+
+	rows, err := s.reader().Query(ctx, query, r.From, r.To, eventType, location)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var stats []model.EventStats
+	for rows.Next() {
+		var (
+			st        model.EventStats
+			eventType sql.NullInt64
+		)
+		err := rows.Scan(&st.EventID, &st.Title, &st.EventDate, &eventType, &st.Location, &st.Capacity, &st.CreatedAt,
+			&st.SoldOutAt, &st.AttendanceRecorded,
+			&st.Counts.Registered, &st.Counts.Pending, &st.Counts.Cancelled, &st.Counts.Attended, &st.Counts.NoShow)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		st.EventType = int(eventType.Int64)
+		stats = append(stats, st)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return stats, nil
+}
+
+// GetEventTypeStats sums up the events held in the date range by event type. Names are in
+// lang where a translation exists.
+func (s *PostgresStorage) GetEventTypeStats(ctx context.Context, r model.DateRange, lang string) ([]model.GroupStats, error) {
+	const op = "infra.storage.postgres.GetEventTypeStats"
+	defer s.observe(op, time.Now())
+
+	query := `
+		SELECT g.id, COALESCE(t.name, g.name), ` + groupStatsColumns + `
+		FROM event_types g
+		LEFT JOIN event_type_translations t ON t.event_type_id = g.id AND t.lang = $3
+		LEFT JOIN events e ON e.event_type = g.id AND e.event_date >= $1 AND e.event_date < $2
+		LEFT JOIN event_milestones m ON m.event_id = e.id
+		LEFT JOIN event_stats st ON st.event_id = e.id
+		GROUP BY g.id, COALESCE(t.name, g.name)
+		ORDER BY g.id;
+	`
+
+	stats, err := s.groupStats(ctx, query, r.From, r.To, lang)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return stats, nil
+}
+
+// GetLocationStats sums up the events held in the date range by location. Names are in
+// lang where a translation exists.
+func (s *PostgresStorage) GetLocationStats(ctx context.Context, r model.DateRange, lang string) ([]model.GroupStats, error) {
+	const op = "infra.storage.postgres.GetLocationStats"
+	defer s.observe(op, time.Now())
+
+	query := `
+		SELECT g.id, COALESCE(t.name, g.name), ` + groupStatsColumns + `
+		FROM locations g
+		LEFT JOIN location_translations t ON t.location_id = g.id AND t.lang = $3
+		LEFT JOIN events e ON e.location = g.id AND e.event_date >= $1 AND e.event_date < $2
+		LEFT JOIN event_milestones m ON m.event_id = e.id
+		LEFT JOIN event_stats st ON st.event_id = e.id
+		GROUP BY g.id, COALESCE(t.name, g.name)
+		ORDER BY g.id;
+	`
+
+	stats, err := s.groupStats(ctx, query, r.From, r.To, lang)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return stats, nil
+}
+
+// groupStatsColumns are the aggregates scanned by groupStats, over events e joined with
+// their milestones m and figures st.
+const groupStatsColumns = `
+		       COUNT(e.id), COALESCE(SUM(e.capacity), 0), COUNT(m.sold_out_at),
+		       AVG(EXTRACT(EPOCH FROM m.sold_out_at - e.created_at)),
+		       COALESCE(SUM(st.registered), 0), COALESCE(SUM(st.pending), 0), COALESCE(SUM(st.cancelled), 0),
+		       COALESCE(SUM(st.attended), 0), COALESCE(SUM(st.no_show), 0)`
+
+func (s *PostgresStorage) groupStats(ctx context.Context, query string, args ...any) ([]model.GroupStats, error) {
+	rows, err := s.reader().Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stats []model.GroupStats
+	for rows.Next() {
+		var (
+			g       model.GroupStats
+			sellout sql.NullFloat64
+		)
+		err := rows.Scan(&g.ID, &g.Name, &g.Events, &g.Capacity, &g.SoldOut, &sellout,
+			&g.Counts.Registered, &g.Counts.Pending, &g.Counts.Cancelled, &g.Counts.Attended, &g.Counts.NoShow)
+		if err != nil {
+			return nil, err
+		}
+		if sellout.Valid {
+			d := time.Duration(sellout.Float64 * float64(time.Second))
+			g.AvgTimeToSellout = &d
+		}
+		stats = append(stats, g)
+	}
+
+	return stats, rows.Err()
+}
+
+// GetMemberGrowth splits the date range into periods of the interval, aligned to its start
+// in UTC, and counts the applications and decisions made in each. Decisions of unknown time
+// are left out of the periods; such approved members count as members from their application.
+func (s *PostgresStorage) GetMemberGrowth(ctx context.Context, r model.DateRange, interval model.Interval) ([]model.GrowthPoint, error) {
+	const op = "infra.storage.postgres.GetMemberGrowth"
+	defer s.observe(op, time.Now())
+
+	query := `
+		WITH periods AS (
+			SELECT p AS start, p + ('1 ' || $3)::interval AS finish
+			FROM generate_series(
+				date_trunc($3, $1::timestamptz, 'UTC'), $2::timestamptz, ('1 ' || $3)::interval
+			) AS p
+			WHERE p < $2
+		)
+		SELECT p.start,
+		       (SELECT COUNT(*) FROM club_members m
+		        WHERE m.created_at >= p.start AND m.created_at < p.finish),
+		       (SELECT COUNT(*) FROM club_members m
+		        WHERE m.status = 'approved' AND m.decided_at >= p.start AND m.decided_at < p.finish),
+		       (SELECT COUNT(*) FROM club_members m
+		        WHERE m.status = 'declined' AND m.decided_at >= p.start AND m.decided_at < p.finish),
+		       (SELECT COUNT(*) FROM club_members m
+		        WHERE m.status = 'approved' AND COALESCE(m.decided_at, m.created_at) < p.finish
+		          AND m.erased_at IS NULL)
+		FROM periods p
+		ORDER BY p.start;
+	`
+
+	rows, err := s.reader().Query(ctx, query, r.From, r.To, string(interval))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var points []model.GrowthPoint
+	for rows.Next() {
+		var p model.GrowthPoint
+		if err := rows.Scan(&p.Start, &p.Applied, &p.Approved, &p.Declined, &p.Members); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		points = append(points, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return points, nil
+}
+
+// GetMemberFunnel follows the members who applied in the date range. Decisions of unknown
+// time are counted separately and left out of the median.
+func (s *PostgresStorage) GetMemberFunnel(ctx context.Context, r model.DateRange) (model.Funnel, error) {
+	const op = "infra.storage.postgres.GetMemberFunnel"
+	defer s.observe(op, time.Now())
+
+	query := `
+		SELECT COUNT(*),
+		       COUNT(*) FILTER (WHERE m.email_verified_at IS NOT NULL OR m.phone_verified_at IS NOT NULL),
+		       COUNT(*) FILTER (WHERE m.status = 'pending'),
+		       COUNT(*) FILTER (WHERE m.status = 'approved'),
+		       COUNT(*) FILTER (WHERE m.status = 'declined'),
+		       COUNT(*) FILTER (WHERE m.status <> 'pending' AND m.decided_at IS NULL),
+		       COUNT(*) FILTER (WHERE a.registered),
+		       COUNT(*) FILTER (WHERE a.attended),
+		       PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM m.decided_at - m.created_at))
+		           FILTER (WHERE m.decided_at IS NOT NULL)
+		FROM club_members m
+		LEFT JOIN LATERAL (
+			SELECT BOOL_OR(r.registration_status <> 'cancelled') AS registered,
+			       BOOL_OR(COALESCE(r.attended, FALSE))           AS attended
+			FROM registrations r
+			WHERE r.user_id = m.id
+		) a ON TRUE
+		WHERE m.created_at >= $1 AND m.created_at < $2;
+	`
+
+	var (
+		f        model.Funnel
+		decision sql.NullFloat64
+	)
+	err := s.reader().QueryRow(ctx, query, r.From, r.To).Scan(
+		&f.Applied, &f.Verified, &f.Pending, &f.Approved, &f.Declined, &f.UndatedDecisions, &f.Registered, &f.Attended, &decision,
+	)
+	if err != nil {
+		return model.Funnel{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if decision.Valid {
+		d := time.Duration(decision.Float64 * float64(time.Second))
+		f.MedianDecision = &d
+	}
+
+	return f, nil
+}
+
+// GetActiveMembers ranks the members by their registrations for events held in the date
+// range, then by the events they attended. Erased members are left out.
+func (s *PostgresStorage) GetActiveMembers(ctx context.Context, r model.DateRange, limit int) ([]model.ActiveMember, error) {
+	const op = "infra.storage.postgres.GetActiveMembers"
+	defer s.observe(op, time.Now())
+
+	query := `
+		SELECT m.id, m.full_name,
+		       COUNT(*) FILTER (WHERE r.registration_status = 'registered') AS registrations,
+		       COUNT(*) FILTER (WHERE r.attended)                           AS attended,
+		       COUNT(*) FILTER (WHERE r.registration_status = 'cancelled')  AS cancelled,
+		       MAX(e.event_date) FILTER (WHERE r.registration_status = 'registered')
+		FROM registrations r
+		JOIN events e ON e.id = r.event_id
+		JOIN club_members m ON m.id = r.user_id
+		WHERE e.event_date >= $1 AND e.event_date < $2 AND m.erased_at IS NULL
+		GROUP BY m.id, m.full_name
+		HAVING COUNT(*) FILTER (WHERE r.registration_status = 'registered') > 0
+		ORDER BY registrations DESC, attended DESC, cancelled, m.full_name
+		LIMIT $3;
+	`
+
+	rows, err := s.reader().Query(ctx, query, r.From, r.To, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var members []model.ActiveMember
+	for rows.Next() {
+		var m model.ActiveMember
+		if err := rows.Scan(&m.MemberID, &m.FullName, &m.Registrations, &m.Attended, &m.Cancelled, &m.LastEventDate); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		members = append(members, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return members, nil
+}
+
+// GetClubSummary counts the members by status and sums up the events held in the date range.
+func (s *PostgresStorage) GetClubSummary(ctx context.Context, r model.DateRange) (model.ClubSummary, error) {
+	const op = "infra.storage.postgres.GetClubSummary"
+	defer s.observe(op, time.Now())
+
+	summary := model.ClubSummary{Members: make(map[model.MemberStatus]int)}
+
+	rows, err := s.reader().Query(ctx, "SELECT status, COUNT(*) FROM club_members WHERE erased_at IS NULL GROUP BY status;")
+	if err != nil {
+		return model.ClubSummary{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			status model.MemberStatus
+			count  int
+		)
+		if err := rows.Scan(&status, &count); err != nil {
+			return model.ClubSummary{}, fmt.Errorf("%s: %w", op, err)
+		}
+		summary.Members[status] = count
+	}
+	if err := rows.Err(); err != nil {
+		return model.ClubSummary{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := `
+		SELECT (SELECT COUNT(*) FROM club_members WHERE created_at >= $1 AND created_at < $2),
+		       COUNT(e.id), COALESCE(SUM(e.capacity), 0), COUNT(m.sold_out_at),
+		       COALESCE(SUM(st.registered), 0), COALESCE(SUM(st.pending), 0), COALESCE(SUM(st.cancelled), 0),
+		       COALESCE(SUM(st.attended), 0), COALESCE(SUM(st.no_show), 0)
+		FROM events e
+		LEFT JOIN event_milestones m ON m.event_id = e.id
+		LEFT JOIN event_stats st ON st.event_id = e.id
+		WHERE e.event_date >= $1 AND e.event_date < $2;
+	`
+
+	err = s.reader().QueryRow(ctx, query, r.From, r.To).Scan(
+		&summary.NewMembers, &summary.Events, &summary.Capacity, &summary.SoldOutEvents,
+		&summary.Counts.Registered, &summary.Counts.Pending, &summary.Counts.Cancelled,
+		&summary.Counts.Attended, &summary.Counts.NoShow,
+	)
+	if err != nil {
+		return model.ClubSummary{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return summary, nil
+}
+
+// RecordAttendance marks the members with an active registration for the event as present
+// if they are among attended and as absent otherwise. Recording it again replaces the marks.
+// The event must have started by now.
+func (s *PostgresStorage) RecordAttendance(ctx context.Context, eventID int, attended []uuid.UUID, now time.Time) (model.AttendanceReport, error) {
+	const op = "infra.storage.postgres.RecordAttendance"
+	defer s.observe(op, time.Now())
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return model.AttendanceReport{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	var eventDate time.Time
+	err = tx.QueryRow(ctx, "SELECT event_date FROM events WHERE id = $1 FOR SHARE;", eventID).Scan(&eventDate)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.AttendanceReport{}, fmt.Errorf("%s: %w", op, storage.ErrEventNotFound)
+		}
+		return model.AttendanceReport{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if eventDate.After(now) {
+		return model.AttendanceReport{}, fmt.Errorf("%s: %w", op, storage.ErrEventNotStarted)
+	}
+
+	ids := make([]string, 0, len(attended))
+	for _, id := range attended {
+		ids = append(ids, id.String())
+	}
+
+	query := `
+		UPDATE registrations
+		SET attended = (user_id = ANY($2::uuid[]))
+		WHERE event_id = $1 AND registration_status = 'registered'
+		RETURNING attended;
+	`
+
+	rows, err := tx.Query(ctx, query, eventID, ids)
+	if err != nil {
+		return model.AttendanceReport{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var report model.AttendanceReport
+	for rows.Next() {
+		var present bool
+		if err := rows.Scan(&present); err != nil {
+			rows.Close()
+			return model.AttendanceReport{}, fmt.Errorf("%s: %w", op, err)
+		}
+		if present {
+			report.Attended++
+		} else {
+			report.NoShow++
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return model.AttendanceReport{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query = `
+		SELECT id FROM unnest($2::uuid[]) AS id
+		EXCEPT
+		SELECT user_id FROM registrations WHERE event_id = $1 AND registration_status = 'registered';
+	`
+
+	rows, err = tx.Query(ctx, query, eventID, ids)
+	if err != nil {
+		return model.AttendanceReport{}, fmt.Errorf("%s: %w", op, err)
+	}
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return model.AttendanceReport{}, fmt.Errorf("%s: %w", op, err)
+		}
+		report.NotRegistered = append(report.NotRegistered, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return model.AttendanceReport{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query = `
+		INSERT INTO event_milestones (event_id, attendance_recorded_at)
+		VALUES ($1, $2)
+		ON CONFLICT (event_id) DO UPDATE SET attendance_recorded_at = EXCLUDED.attendance_recorded_at;
+	`
+
+	if _, err := tx.Exec(ctx, query, eventID, now); err != nil {
+		return model.AttendanceReport{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return model.AttendanceReport{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return report, nil
+}
+
+// GetClubGauges counts the pending applications and the free seats of the events after now.
+// Held seats are taken; erased members are left out.
+func (s *PostgresStorage) GetClubGauges(ctx context.Context, now time.Time) (model.ClubGauges, error) {
+	const op = "infra.storage.postgres.GetClubGauges"
+	defer s.observe(op, time.Now())
+
+	query := `
+		SELECT (SELECT COUNT(*) FROM club_members WHERE status = 'pending' AND erased_at IS NULL),
+		       COUNT(e.id),
+		       COALESCE(SUM(GREATEST(e.capacity - COALESCE(r.taken, 0), 0)), 0)
+		FROM events e
+		LEFT JOIN (
+			SELECT event_id, COUNT(*) AS taken
+			FROM registrations
+			WHERE registration_status IN ('registered', 'pending_payment')
+			GROUP BY event_id
+		) r ON r.event_id = e.id
+		WHERE e.event_date > $1;
+	`
+
+	var gauges model.ClubGauges
+	err := s.reader().QueryRow(ctx, query, now).Scan(&gauges.PendingMembers, &gauges.UpcomingEvents, &gauges.SeatsLeft)
+	if err != nil {
+		return model.ClubGauges{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return gauges, nil
+}
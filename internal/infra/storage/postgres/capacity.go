@@ -0,0 +1,196 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/storage"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"slices"
+	"time"
+)
+
+// SetEventCapacity sets the number of seats of the event. A capacity lowered below the active
+// registrations is rejected with ErrCapacityBelowRegs unless force is set; then the latest
+// registrations are cancelled until the rest fit, their payments cancelled or marked for
+// refund. A non-zero version makes the change conditional on the row being at that version.
+// The jobs announce makes of the change are enqueued in its transaction.
+func (s *PostgresStorage) SetEventCapacity(ctx context.Context, id, capacity, version int, force bool, announce model.SeatsAnnouncer) (model.CapacityChange, error) {
+	const op = "infra.storage.postgres.SetEventCapacity"
+	defer s.observe(op, time.Now())
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return model.CapacityChange{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	change := model.CapacityChange{EventID: id, Capacity: capacity}
+
+	var current int
+	err = tx.QueryRow(ctx, "SELECT capacity, version FROM events WHERE id = $1 FOR UPDATE;", id).Scan(&change.Previous, &current)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.CapacityChange{}, storage.ErrEventNotFound
+		}
+		return model.CapacityChange{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if version != 0 && current != version {
+		return model.CapacityChange{}, fmt.Errorf("%s: %w", op, storage.ErrVersionMismatch)
+	}
+
+	countQuery := `
+		SELECT COUNT(*) FROM registrations
+		WHERE event_id = $1 AND registration_status IN ('registered', 'pending_payment');
+	`
+	if err := tx.QueryRow(ctx, countQuery, id).Scan(&change.Active); err != nil {
+		return model.CapacityChange{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if excess := change.Active - capacity; excess > 0 {
+		if !force && capacity < change.Previous {
+			return model.CapacityChange{}, fmt.Errorf("%s: %w", op, storage.ErrCapacityBelowRegs)
+		}
+		if force {
+			if err := bumpRegistrations(ctx, tx, &change, excess); err != nil {
+				return model.CapacityChange{}, fmt.Errorf("%s: %w", op, err)
+			}
+		}
+	}
+
+	err = tx.QueryRow(ctx, "UPDATE events SET capacity = $2 WHERE id = $1 RETURNING version;", id, capacity).Scan(&change.Version)
+	if err != nil {
+		return model.CapacityChange{}, fmt.Errorf("%s: %w", op, dbError(err))
+	}
+
+	if err := announceSeats(ctx, tx, change, announce); err != nil {
+		return model.CapacityChange{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return model.CapacityChange{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return change, nil
+}
+
+// announceSeats enqueues the jobs announce makes of the change in tx.
+func announceSeats(ctx context.Context, tx pgx.Tx, change model.CapacityChange, announce model.SeatsAnnouncer) error {
+	jobs, err := announce(change)
+	if err != nil {
+		return err
+	}
+
+	return enqueueJobs(ctx, tx, jobs)
+}
+
+// bumpRegistrations cancels the n latest active registrations for the event of the change
+// and settles their payments. The seats are taken away by the organizers, so a paid seat is
+// refunded however close the event is.
+func bumpRegistrations(ctx context.Context, tx pgx.Tx, change *model.CapacityChange, n int) error {
+	query := `
+		UPDATE registrations
+		SET registration_status = 'cancelled', payment_expires_at = NULL, bumped_at = CURRENT_TIMESTAMP
+		WHERE id IN (
+		    SELECT id FROM registrations
+		    WHERE event_id = $1 AND registration_status IN ('registered', 'pending_payment')
+		    ORDER BY registered_at DESC, id DESC
+		    LIMIT $2
+		)
+		RETURNING id, user_id, registered_at;
+	`
+
+	rows, err := tx.Query(ctx, query, change.EventID, n)
+	if err != nil {
+		return err
+	}
+
+	type bumped struct {
+		regID        int
+		memberID     uuid.UUID
+		registeredAt time.Time
+	}
+	var regs []bumped
+	for rows.Next() {
+		var b bumped
+		if err := rows.Scan(&b.regID, &b.memberID, &b.registeredAt); err != nil {
+			rows.Close()
+			return err
+		}
+		regs = append(regs, b)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	// RETURNING does not keep the order of the subquery.
+	slices.SortFunc(regs, func(a, b bumped) int {
+		if c := b.registeredAt.Compare(a.registeredAt); c != 0 {
+			return c
+		}
+		return b.regID - a.regID
+	})
+
+	for _, b := range regs {
+		payment, err := settleCancelledPayment(ctx, tx, b.regID, time.Time{})
+		if err != nil {
+			return err
+		}
+		change.Bumped = append(change.Bumped, b.memberID)
+		if payment != nil {
+			change.Payments = append(change.Payments, *payment)
+		}
+	}
+	change.Active -= len(regs)
+
+	return nil
+}
+
+// GetSeatNotices loads the members bumped from the event by a shrink of its capacity who have
+// not registered again, with the event named in each member's language. A non-nil memberIDs
+// narrows them down to those members. Erased members are left out.
+func (s *PostgresStorage) GetSeatNotices(ctx context.Context, eventID int, memberIDs []uuid.UUID) ([]model.SeatNotice, error) {
+	const op = "infra.storage.postgres.GetSeatNotices"
+	defer s.observe(op, time.Now())
+
+	query := `
+		SELECT m.id, m.full_name, m.email, m.phone, COALESCE(m.language, ''), m.notify_email, m.notify_sms,
+		       e.id, e.title, e.event_date, COALESCE(t.name, l.name)
+		FROM registrations r
+		JOIN club_members m ON m.id = r.user_id
+		JOIN events e ON e.id = r.event_id
+		JOIN locations l ON l.id = e.location
+		LEFT JOIN location_translations t ON t.location_id = l.id AND t.lang = m.language
+		WHERE r.event_id = $1 AND r.registration_status = 'cancelled' AND r.bumped_at IS NOT NULL
+		  AND ($2::uuid[] IS NULL OR r.user_id = ANY($2))
+		  AND m.erased_at IS NULL
+		ORDER BY r.bumped_at, r.id;
+	`
+
+	rows, err := s.db.Query(ctx, query, eventID, memberIDs)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var notices []model.SeatNotice
+	for rows.Next() {
+		var n model.SeatNotice
+		err := rows.Scan(
+			&n.MemberID, &n.FullName, &n.Email, &n.Phone, &n.Language, &n.Notifications.Email, &n.Notifications.SMS,
+			&n.EventID, &n.EventTitle, &n.EventDate, &n.Location,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		notices = append(notices, n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return notices, nil
+}
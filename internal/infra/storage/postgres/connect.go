@@ -0,0 +1,190 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/config"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"log/slog"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// pingTimeout bounds a single ping while connecting or checking the replica.
+const pingTimeout = 5 * time.Second
+
+// InitDB connects to the primary database, retrying with backoff while it cannot be reached.
+func InitDB(log *slog.Logger, cfg *config.StorageConfig) (*pgxpool.Pool, error) {
+	const op = "infra.storage.postgres.InitDB"
+
+	dsn, err := connString(cfg, cfg.URL, cfg.Host, cfg.Port)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	db, err := open(dsn, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	backoff := cfg.ConnectBackoff
+	for attempt := 1; ; attempt++ {
+		err = ping(db)
+		if err == nil {
+			return db, nil
+		}
+		if attempt >= cfg.ConnectAttempts {
+			db.Close()
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		log.Warn("database not reachable, retrying",
+			slog.Int("attempt", attempt), slog.Duration("backoff", backoff), slog.Any("error", err))
+		time.Sleep(backoff)
+		backoff = min(2*backoff, cfg.ConnectMaxBackoff)
+	}
+}
+
+// InitReplica opens the configured read replica, or returns nil without one. The replica is not
+// waited for: reads go to the primary until Replica.Monitor finds it healthy.
+func InitReplica(cfg *config.StorageConfig) (*pgxpool.Pool, error) {
+	const op = "infra.storage.postgres.InitReplica"
+
+	if !cfg.Replica.Enabled() {
+		return nil, nil
+	}
+
+	dsn, err := connString(cfg, cfg.Replica.URL, cfg.Replica.Host, cfg.Replica.Port)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	db, err := open(dsn, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return db, nil
+}
+
+// connString returns the URL of a database: rawURL when given, built from host and port and the
+// credentials of cfg otherwise. Building a URL rather than a key=value string keeps passwords with
+// spaces or quotes intact.
+func connString(cfg *config.StorageConfig, rawURL, host string, port int) (string, error) {
+	password, err := readPassword(cfg)
+	if err != nil {
+		return "", err
+	}
+
+	if rawURL != "" {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return "", err
+		}
+		if _, ok := u.User.Password(); !ok && password != "" {
+			user := cfg.User
+			if u.User != nil {
+				user = u.User.Username()
+			}
+			u.User = url.UserPassword(user, password)
+		}
+		return u.String(), nil
+	}
+
+	u := &url.URL{
+		Scheme: "postgres",
+		User:   url.UserPassword(cfg.User, password),
+		Host:   net.JoinHostPort(host, strconv.Itoa(port)),
+		Path:   "/" + cfg.Dbname,
+	}
+	if cfg.Sslmode != "" {
+		u.RawQuery = url.Values{"sslmode": {cfg.Sslmode}}.Encode()
+	}
+
+	return u.String(), nil
+}
+
+// readPassword returns the contents of PasswordFile without the trailing newline when it is
+// set, and Password otherwise.
+func readPassword(cfg *config.StorageConfig) (string, error) {
+	if cfg.PasswordFile == "" {
+		return cfg.Password, nil
+	}
+
+	b, err := os.ReadFile(cfg.PasswordFile)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(string(b), "\r\n"), nil
+}
+
+// open returns a traced pool on dsn sized as cfg sets. Connections are made lazily, on the
+// first query or ping. Statements run in the default mode of pgx: each connection prepares a
+// query on its first run and keeps the prepared statement in a cache of
+// StatementCacheCapacity entries, so repeated queries skip parsing and planning.
+func open(dsn string, cfg *config.StorageConfig) (*pgxpool.Pool, error) {
+	poolCfg, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	poolCfg.MaxConns = int32(cfg.MaxConns)
+	poolCfg.MinConns = int32(cfg.MinConns)
+	poolCfg.MaxConnLifetime = cfg.ConnMaxLifetime
+	poolCfg.MaxConnIdleTime = cfg.ConnMaxIdleTime
+	poolCfg.ConnConfig.DefaultQueryExecMode = pgx.QueryExecModeCacheStatement
+	poolCfg.ConnConfig.StatementCacheCapacity = cfg.StatementCacheCapacity
+	poolCfg.ConnConfig.Tracer = queryTracer{}
+
+	return pgxpool.NewWithConfig(context.Background(), poolCfg)
+}
+
+func ping(db *pgxpool.Pool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), pingTimeout)
+	defer cancel()
+
+	return db.Ping(ctx)
+}
+
+// Replica is a read replica the storage routes reads to while it is healthy.
+type Replica struct {
+	log      *slog.Logger
+	db       *pgxpool.Pool
+	interval time.Duration
+	healthy  atomic.Bool
+}
+
+func NewReplica(log *slog.Logger, db *pgxpool.Pool, interval time.Duration) *Replica {
+	return &Replica{log: log.With("component", "replica"), db: db, interval: interval}
+}
+
+// Monitor pings the replica every interval until ctx is cancelled and marks it healthy while it
+// answers.
+func (r *Replica) Monitor(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		err := ping(r.db)
+		if healthy := err == nil; healthy != r.healthy.Swap(healthy) {
+			if healthy {
+				r.log.Info("replica is healthy, routing reads to it")
+			} else {
+				r.log.Warn("replica is not reachable, routing reads to the primary", slog.Any("error", err))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
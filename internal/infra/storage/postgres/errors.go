@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"errors"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/storage"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// constraint identifies a constraint of the schema by the SQLSTATE its violation is reported
+// with and its name. Constraints declared inline without a name get the default one postgres
+// derives from the table and columns, such as club_members_email_key.
+type constraint struct {
+	code string
+	name string
+}
+
+// constraintErrors maps the constraints whose violation a caller can act on to the storage
+// errors reported for them.
+var constraintErrors = map[constraint]error{
+	{pgerrcode.UniqueViolation, "club_members_email_key"}:             storage.ErrEmailDuplicate,
+	{pgerrcode.UniqueViolation, "club_members_phone_key"}:             storage.ErrPhoneDuplicate,
+	{pgerrcode.UniqueViolation, "event_types_name_key"}:               storage.ErrEventTypeExists,
+	{pgerrcode.UniqueViolation, "locations_name_key"}:                 storage.ErrLocationExists,
+	{pgerrcode.UniqueViolation, "registrations_user_id_event_id_key"}: storage.ErrRegAlreadyExists,
+	{pgerrcode.UniqueViolation, "membership_payments_reference_key"}:  storage.ErrPaymentDuplicate,
+
+	{pgerrcode.ForeignKeyViolation, "events_event_type_fkey"}:            storage.ErrEventTypeNotFound,
+	{pgerrcode.ForeignKeyViolation, "events_location_fkey"}:              storage.ErrLocationNotFound,
+	{pgerrcode.ForeignKeyViolation, "verification_codes_member_id_fkey"}: storage.ErrMemberNotFound,
+	{pgerrcode.ForeignKeyViolation, "account_codes_member_id_fkey"}:      storage.ErrMemberNotFound,
+
+	{pgerrcode.CheckViolation, "email_syntax"}:         storage.ErrInvalidEmail,
+	{pgerrcode.CheckViolation, "phone_number"}:         storage.ErrInvalidPhone,
+	{pgerrcode.CheckViolation, "event_not_in_future"}:  storage.ErrEventInPast,
+	{pgerrcode.CheckViolation, "event_price"}:          storage.ErrInvalidPrice,
+	{pgerrcode.CheckViolation, "payment_amount"}:       storage.ErrInvalidAmount,
+	{pgerrcode.CheckViolation, "event_payment_amount"}: storage.ErrInvalidAmount,
+}
+
+// dbError translates a violated constraint into a storage error: the one registered in
+// constraintErrors, or the generic error of its kind naming the constraint. Other errors are
+// returned as is.
+func dbError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	if mapped, ok := constraintErrors[constraint{pgErr.Code, pgErr.ConstraintName}]; ok {
+		return mapped
+	}
+
+	switch pgErr.Code {
+	case pgerrcode.UniqueViolation:
+		return fmt.Errorf("%s: %w", pgErr.ConstraintName, storage.ErrDuplicate)
+	case pgerrcode.ForeignKeyViolation:
+		return fmt.Errorf("%s: %w", pgErr.ConstraintName, storage.ErrReferenceNotFound)
+	case pgerrcode.CheckViolation:
+		return fmt.Errorf("%s: %w", pgErr.ConstraintName, storage.ErrCheckViolation)
+	}
+
+	return err
+}
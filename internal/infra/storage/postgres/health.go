@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"time"
+)
+
+// Ping checks that the database can be reached.
+func (s *PostgresStorage) Ping(ctx context.Context) error {
+	const op = "infra.storage.postgres.Ping"
+	defer s.observe(op, time.Now())
+
+	if err := s.db.Ping(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetSchemaVersion returns the version of the last migration applied by goose.
+func (s *PostgresStorage) GetSchemaVersion(ctx context.Context) (int64, error) {
+	const op = "infra.storage.postgres.GetSchemaVersion"
+	defer s.observe(op, time.Now())
+
+	query := "SELECT version_id FROM goose_db_version WHERE is_applied ORDER BY id DESC LIMIT 1;"
+
+	var version int64
+	if err := s.db.QueryRow(ctx, query).Scan(&version); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return version, nil
+}
+
+// PoolStats returns the state of the connection pool. Waits count the acquires that found no
+// idle connection, and the wait duration sums the time of all acquires.
+func (s *PostgresStorage) PoolStats() model.PoolStats {
+	stat := s.db.Stat()
+
+	return model.PoolStats{
+		MaxOpen:      int(stat.MaxConns()),
+		Open:         int(stat.TotalConns()),
+		InUse:        int(stat.AcquiredConns()),
+		Idle:         int(stat.IdleConns()),
+		WaitCount:    stat.EmptyAcquireCount(),
+		WaitDuration: stat.AcquireDuration(),
+	}
+}
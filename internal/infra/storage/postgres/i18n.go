@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/i18n"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/storage"
+	"time"
+)
+
+// GetTranslations returns the message translations stored on top of the built-in catalog.
+func (s *PostgresStorage) GetTranslations(ctx context.Context) ([]i18n.Translation, error) {
+	const op = "infra.storage.postgres.GetTranslations"
+	defer s.observe(op, time.Now())
+
+	rows, err := s.reader().Query(ctx, "SELECT key, lang, forms FROM translations")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var translations []i18n.Translation
+	for rows.Next() {
+		var t i18n.Translation
+		if err := rows.Scan(&t.Key, &t.Lang, &t.Forms); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		translations = append(translations, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return translations, nil
+}
+
+// SetEventTypeTranslation stores the variant of an event type in lang. The version of the
+// event type is bumped, so cached listings are revalidated.
+func (s *PostgresStorage) SetEventTypeTranslation(ctx context.Context, id int, lang, name, description string) error {
+	const op = "infra.storage.postgres.SetEventTypeTranslation"
+	defer s.observe(op, time.Now())
+
+	query := `
+		INSERT INTO event_type_translations (event_type_id, lang, name, description)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (event_type_id, lang)
+		DO UPDATE SET name = EXCLUDED.name, description = EXCLUDED.description;
+	`
+
+	return s.setTranslation(ctx, op, "UPDATE event_types SET version = version WHERE id = $1", id, storage.ErrEventTypeNotFound,
+		query, id, lang, name, description)
+}
+
+// SetLocationTranslation stores the variant of a location in lang and bumps its version.
+func (s *PostgresStorage) SetLocationTranslation(ctx context.Context, id int, lang, name, route, features string) error {
+	const op = "infra.storage.postgres.SetLocationTranslation"
+	defer s.observe(op, time.Now())
+
+	query := `
+		INSERT INTO location_translations (location_id, lang, name, route, features)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (location_id, lang)
+		DO UPDATE SET name = EXCLUDED.name, route = EXCLUDED.route, features = EXCLUDED.features;
+	`
+
+	return s.setTranslation(ctx, op, "UPDATE locations SET version = version WHERE id = $1", id, storage.ErrLocationNotFound,
+		query, id, lang, name, route, features)
+}
+
+// setTranslation bumps the version of the translated record and upserts its variant in one
+// transaction. A missing record is reported as notFound.
+func (s *PostgresStorage) setTranslation(ctx context.Context, op, bumpQuery string, id int, notFound error, upsertQuery string, args ...any) error {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	res, err := tx.Exec(ctx, bumpQuery, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	affected := res.RowsAffected()
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, notFound)
+	}
+
+	if _, err := tx.Exec(ctx, upsertQuery, args...); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"github.com/jackc/pgx/v5"
+	"time"
+)
+
+// ClaimIdempotencyKey reserves key for a new request, holding it for lease. An expired
+// record is taken over, and so is one whose request has not answered within its lease.
+// When the key is held by an earlier request, that record is returned with claimed
+// set to false.
+func (s *PostgresStorage) ClaimIdempotencyKey(ctx context.Context, key, fingerprint string, ttl, lease time.Duration) (model.IdempotentResponse, bool, error) {
+	const op = "infra.storage.postgres.ClaimIdempotencyKey"
+	defer s.observe(op, time.Now())
+
+	query := `
+		INSERT INTO idempotency_keys AS k (key, fingerprint, expires_at, locked_until)
+		VALUES ($1, $2, now() + $3 * INTERVAL '1 second', now() + $4 * INTERVAL '1 second')
+		ON CONFLICT (key) DO UPDATE
+		SET fingerprint = EXCLUDED.fingerprint, status_code = NULL, content_type = '', location = '', etag = '',
+		    body = NULL, created_at = now(), expires_at = EXCLUDED.expires_at, locked_until = EXCLUDED.locked_until
+		WHERE k.expires_at < now() OR (k.status_code IS NULL AND k.locked_until < now())
+		RETURNING expires_at;
+	`
+
+	record := model.IdempotentResponse{Key: key, Fingerprint: fingerprint}
+	err := s.db.QueryRow(ctx, query, key, fingerprint, ttl.Seconds(), lease.Seconds()).Scan(&record.ExpiresAt)
+	if err == nil {
+		return record, true, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return model.IdempotentResponse{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var status sql.NullInt64
+	err = s.db.QueryRow(ctx, `
+		SELECT fingerprint, status_code, content_type, location, etag, body, expires_at
+		FROM idempotency_keys
+		WHERE key = $1;
+	`, key).Scan(&record.Fingerprint, &status, &record.ContentType, &record.Location, &record.ETag, &record.Body, &record.ExpiresAt)
+	if err != nil {
+		return model.IdempotentResponse{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	record.Completed = status.Valid
+	record.StatusCode = int(status.Int64)
+
+	return record, false, nil
+}
+
+// SaveIdempotentResponse stores the response to the request holding the key and lifts its
+// lease. The claim is told by its ExpiresAt: a response to a request whose key has been
+// taken over since is dropped.
+func (s *PostgresStorage) SaveIdempotentResponse(ctx context.Context, resp model.IdempotentResponse) error {
+	const op = "infra.storage.postgres.SaveIdempotentResponse"
+	defer s.observe(op, time.Now())
+
+	query := `
+		UPDATE idempotency_keys
+		SET status_code = $2, content_type = $3, location = $4, etag = $5, body = $6, locked_until = NULL
+		WHERE key = $1 AND expires_at = $7 AND status_code IS NULL;
+	`
+
+	_, err := s.db.Exec(ctx, query, resp.Key, resp.StatusCode, resp.ContentType, resp.Location, resp.ETag, resp.Body, resp.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ReleaseIdempotencyKey forgets the claim of a key whose request failed, so a retry runs
+// again. A key taken over since is left to its new request.
+func (s *PostgresStorage) ReleaseIdempotencyKey(ctx context.Context, claim model.IdempotentResponse) error {
+	const op = "infra.storage.postgres.ReleaseIdempotencyKey"
+	defer s.observe(op, time.Now())
+
+	query := "DELETE FROM idempotency_keys WHERE key = $1 AND expires_at = $2 AND status_code IS NULL;"
+	if _, err := s.db.Exec(ctx, query, claim.Key, claim.ExpiresAt); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// DeleteExpiredIdempotencyKeys removes the keys whose TTL has run out and returns how many
+// were removed.
+func (s *PostgresStorage) DeleteExpiredIdempotencyKeys(ctx context.Context) (int, error) {
+	const op = "infra.storage.postgres.DeleteExpiredIdempotencyKeys"
+	defer s.observe(op, time.Now())
+
+	res, err := s.db.Exec(ctx, "DELETE FROM idempotency_keys WHERE expires_at < now();")
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected := res.RowsAffected()
+
+	return int(affected), nil
+}
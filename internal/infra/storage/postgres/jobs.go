@@ -0,0 +1,325 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/storage"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"github.com/jackc/pgx/v5"
+	"time"
+)
+
+const jobColumns = `
+	id, kind, payload, status, attempts, max_attempts, run_at, COALESCE(dedupe_key, ''),
+	COALESCE(locked_by, ''), locked_at, COALESCE(last_error, ''), finished_at, created_at, updated_at
+`
+
+func scanJob(row interface{ Scan(dest ...any) error }) (model.Job, error) {
+	var job model.Job
+	var payload []byte
+	err := row.Scan(
+		&job.ID, &job.Kind, &payload, &job.Status, &job.Attempts, &job.MaxAttempts, &job.RunAt, &job.DedupeKey,
+		&job.LockedBy, &job.LockedAt, &job.LastError, &job.FinishedAt, &job.CreatedAt, &job.UpdatedAt,
+	)
+	job.Payload = payload
+	return job, err
+}
+
+func scanJobs(rows pgx.Rows) ([]model.Job, error) {
+	defer rows.Close()
+
+	var jobs []model.Job
+	for rows.Next() {
+		job, err := scanJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+
+	return jobs, rows.Err()
+}
+
+const enqueueJobQuery = `
+	INSERT INTO jobs (kind, payload, max_attempts, run_at, dedupe_key)
+	VALUES ($1, $2::jsonb, $3, $4, NULLIF($5, ''))
+	ON CONFLICT (dedupe_key) DO NOTHING
+	RETURNING id;
+`
+
+// EnqueueJob adds a job to the queue. A job whose dedupe key is already taken is not added,
+// and created is false.
+func (s *PostgresStorage) EnqueueJob(ctx context.Context, job model.Job) (id int64, created bool, err error) {
+	const op = "infra.storage.postgres.EnqueueJob"
+	defer s.observe(op, time.Now())
+
+	err = s.db.QueryRow(ctx, enqueueJobQuery, job.Kind, string(job.Payload), job.MaxAttempts, job.RunAt, job.DedupeKey).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, false, nil
+		}
+		return 0, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return id, true, nil
+}
+
+// enqueueJobs adds the jobs to the queue in tx, as an outbox of the change made in it: the
+// jobs are only run if the change commits.
+func enqueueJobs(ctx context.Context, tx pgx.Tx, jobs []model.Job) error {
+	for _, job := range jobs {
+		var id int64
+		err := tx.QueryRow(ctx, enqueueJobQuery, job.Kind, string(job.Payload), job.MaxAttempts, job.RunAt, job.DedupeKey).Scan(&id)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ScheduleJob enqueues a run of a periodic job. It is skipped when a job of the same kind
+// is still waiting or running, so a slow job does not pile up runs, and when the run's
+// dedupe key was already enqueued by another replica.
+func (s *PostgresStorage) ScheduleJob(ctx context.Context, job model.Job) (bool, error) {
+	const op = "infra.storage.postgres.ScheduleJob"
+	defer s.observe(op, time.Now())
+
+	query := `
+		INSERT INTO jobs (kind, payload, max_attempts, run_at, dedupe_key)
+		SELECT $1::text, $2::jsonb, $3::integer, $4::timestamptz, $5::text
+		WHERE NOT EXISTS (SELECT 1 FROM jobs WHERE kind = $1 AND status IN ('pending', 'running'))
+		ON CONFLICT (dedupe_key) DO NOTHING;
+	`
+
+	res, err := s.db.Exec(ctx, query, job.Kind, string(job.Payload), job.MaxAttempts, job.RunAt, job.DedupeKey)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected := res.RowsAffected()
+
+	return affected > 0, nil
+}
+
+// ClaimJobs locks up to limit due jobs of the given kinds for worker and marks them running.
+// Jobs being claimed by another replica are skipped rather than waited for.
+func (s *PostgresStorage) ClaimJobs(ctx context.Context, kinds []string, worker string, limit int) ([]model.Job, error) {
+	const op = "infra.storage.postgres.ClaimJobs"
+	defer s.observe(op, time.Now())
+
+	query := `
+		UPDATE jobs j
+		SET status = 'running', attempts = j.attempts + 1, locked_by = $2, locked_at = CURRENT_TIMESTAMP
+		FROM (
+			SELECT id FROM jobs
+			WHERE status = 'pending' AND run_at <= CURRENT_TIMESTAMP AND kind = ANY($1)
+			ORDER BY run_at, id
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		) due
+		WHERE j.id = due.id
+		RETURNING j.id, j.kind, j.payload, j.status, j.attempts, j.max_attempts, j.run_at, COALESCE(j.dedupe_key, ''),
+		          COALESCE(j.locked_by, ''), j.locked_at, COALESCE(j.last_error, ''), j.finished_at, j.created_at, j.updated_at;
+	`
+
+	rows, err := s.db.Query(ctx, query, kinds, worker, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	jobs, err := scanJobs(rows)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return jobs, nil
+}
+
+// CompleteJob marks a running job succeeded.
+func (s *PostgresStorage) CompleteJob(ctx context.Context, id int64) error {
+	const op = "infra.storage.postgres.CompleteJob"
+	defer s.observe(op, time.Now())
+
+	query := `
+		UPDATE jobs
+		SET status = 'succeeded', locked_by = NULL, locked_at = NULL, finished_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND status = 'running';
+	`
+
+	if _, err := s.db.Exec(ctx, query, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// FailJob records a failed attempt of a running job. The job is retried at retryAt, or is
+// dead when it has used all its attempts; the resulting status is returned.
+func (s *PostgresStorage) FailJob(ctx context.Context, id int64, reason string, retryAt time.Time) (model.JobStatus, error) {
+	const op = "infra.storage.postgres.FailJob"
+	defer s.observe(op, time.Now())
+
+	query := `
+		UPDATE jobs
+		SET status = CASE WHEN attempts >= max_attempts THEN 'dead' ELSE 'pending' END,
+		    run_at = CASE WHEN attempts >= max_attempts THEN run_at ELSE $3 END,
+		    finished_at = CASE WHEN attempts >= max_attempts THEN CURRENT_TIMESTAMP END,
+		    last_error = $2, locked_by = NULL, locked_at = NULL
+		WHERE id = $1 AND status = 'running'
+		RETURNING status;
+	`
+
+	var status model.JobStatus
+	if err := s.db.QueryRow(ctx, query, id, reason, retryAt).Scan(&status); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", fmt.Errorf("%s: %w", op, storage.ErrJobNotFound)
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return status, nil
+}
+
+// ReleaseJob puts a running job back to pending without counting the attempt, for a job
+// interrupted by shutdown.
+func (s *PostgresStorage) ReleaseJob(ctx context.Context, id int64) error {
+	const op = "infra.storage.postgres.ReleaseJob"
+	defer s.observe(op, time.Now())
+
+	query := `
+		UPDATE jobs
+		SET status = 'pending', attempts = attempts - 1, locked_by = NULL, locked_at = NULL
+		WHERE id = $1 AND status = 'running';
+	`
+
+	if _, err := s.db.Exec(ctx, query, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RecoverStaleJobs fails the jobs that have been running since before lockedBefore, whose
+// replica is taken to be gone, and returns how many there were. They are retried at once
+// unless they have used all their attempts.
+func (s *PostgresStorage) RecoverStaleJobs(ctx context.Context, lockedBefore time.Time) (int, error) {
+	const op = "infra.storage.postgres.RecoverStaleJobs"
+	defer s.observe(op, time.Now())
+
+	query := `
+		UPDATE jobs
+		SET status = CASE WHEN attempts >= max_attempts THEN 'dead' ELSE 'pending' END,
+		    finished_at = CASE WHEN attempts >= max_attempts THEN CURRENT_TIMESTAMP END,
+		    last_error = 'lost by worker ' || COALESCE(locked_by, ''), locked_by = NULL, locked_at = NULL
+		WHERE status = 'running' AND locked_at < $1;
+	`
+
+	res, err := s.db.Exec(ctx, query, lockedBefore)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected := res.RowsAffected()
+
+	return int(affected), nil
+}
+
+// CountJobs returns the number of jobs in every status.
+func (s *PostgresStorage) CountJobs(ctx context.Context) (map[model.JobStatus]int, error) {
+	const op = "infra.storage.postgres.CountJobs"
+	defer s.observe(op, time.Now())
+
+	rows, err := s.db.Query(ctx, "SELECT status, count(*) FROM jobs GROUP BY status;")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	counts := make(map[model.JobStatus]int)
+	for rows.Next() {
+		var status model.JobStatus
+		var n int
+		if err := rows.Scan(&status, &n); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		counts[status] = n
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return counts, nil
+}
+
+// DeleteFinishedJobs removes the jobs that succeeded before the given time and returns how
+// many were removed. Dead jobs are kept until they are retried.
+func (s *PostgresStorage) DeleteFinishedJobs(ctx context.Context, before time.Time) (int, error) {
+	const op = "infra.storage.postgres.DeleteFinishedJobs"
+	defer s.observe(op, time.Now())
+
+	res, err := s.db.Exec(ctx, "DELETE FROM jobs WHERE status = 'succeeded' AND finished_at < $1;", before)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected := res.RowsAffected()
+
+	return int(affected), nil
+}
+
+// GetJobs lists jobs matching the filter, most recently changed first.
+func (s *PostgresStorage) GetJobs(ctx context.Context, filter model.JobFilter) ([]model.Job, error) {
+	const op = "infra.storage.postgres.GetJobs"
+	defer s.observe(op, time.Now())
+
+	query := "SELECT " + jobColumns + `
+		FROM jobs
+		WHERE ($1 = '' OR status = $1) AND ($2 = '' OR kind = $2)
+		ORDER BY updated_at DESC, id DESC
+		LIMIT $3;
+	`
+
+	rows, err := s.reader().Query(ctx, query, filter.Status, filter.Kind, filter.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	jobs, err := scanJobs(rows)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return jobs, nil
+}
+
+// RetryJob brings a dead job back to the queue with a fresh set of attempts, to run at once.
+func (s *PostgresStorage) RetryJob(ctx context.Context, id int64) (model.Job, error) {
+	const op = "infra.storage.postgres.RetryJob"
+	defer s.observe(op, time.Now())
+
+	query := `
+		UPDATE jobs
+		SET status = 'pending', attempts = 0, run_at = CURRENT_TIMESTAMP, finished_at = NULL
+		WHERE id = $1 AND status = 'dead'
+		RETURNING ` + jobColumns + ";"
+
+	job, err := scanJob(s.db.QueryRow(ctx, query, id))
+	if err == nil {
+		return job, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return model.Job{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var exists bool
+	if err := s.db.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM jobs WHERE id = $1)", id).Scan(&exists); err != nil {
+		return model.Job{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if !exists {
+		return model.Job{}, storage.ErrJobNotFound
+	}
+
+	return model.Job{}, storage.ErrJobNotDead
+}
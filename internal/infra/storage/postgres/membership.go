@@ -0,0 +1,393 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/storage"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"time"
+)
+
+// GetMembership returns the membership of the member, read from the replica when there is
+// one. A member who has never paid gets a membership with an empty tier.
+func (s *PostgresStorage) GetMembership(ctx context.Context, memberID uuid.UUID) (model.Membership, error) {
+	const op = "infra.storage.postgres.GetMembership"
+	defer s.observe(op, time.Now())
+
+	membership, err := getMembership(ctx, s.reader(), memberID)
+	if err != nil {
+		if errors.Is(err, storage.ErrMemberNotFound) {
+			return model.Membership{}, err
+		}
+		return model.Membership{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return membership, nil
+}
+
+// GetMembershipFromPrimary returns the membership of the member read from the primary, for
+// decisions about writes such as a registration the membership gives access to. The row is
+// not locked, so a payment recorded right after the read is not seen by the decision.
+func (s *PostgresStorage) GetMembershipFromPrimary(ctx context.Context, memberID uuid.UUID) (model.Membership, error) {
+	const op = "infra.storage.postgres.GetMembershipFromPrimary"
+	defer s.observe(op, time.Now())
+
+	membership, err := getMembership(ctx, s.db, memberID)
+	if err != nil {
+		if errors.Is(err, storage.ErrMemberNotFound) {
+			return model.Membership{}, err
+		}
+		return model.Membership{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return membership, nil
+}
+
+func getMembership(ctx context.Context, db *pgxpool.Pool, memberID uuid.UUID) (model.Membership, error) {
+	var exists bool
+	if err := db.QueryRow(ctx, memberExistsQuery, memberID).Scan(&exists); err != nil {
+		return model.Membership{}, err
+	}
+	if !exists {
+		return model.Membership{}, storage.ErrMemberNotFound
+	}
+
+	query := "SELECT tier, starts_at, expires_at, expired_at FROM memberships WHERE member_id = $1;"
+
+	membership := model.Membership{MemberID: memberID}
+	err := db.QueryRow(ctx, query, memberID).Scan(
+		&membership.Tier, &membership.StartsAt, &membership.ExpiresAt, &membership.ExpiredAt,
+	)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return model.Membership{}, err
+	}
+
+	return membership, nil
+}
+
+// GetMemberships returns the memberships of the given members. Members without a membership
+// are left out. It reads the primary, since the import checks payments against them.
+func (s *PostgresStorage) GetMemberships(ctx context.Context, memberIDs []uuid.UUID) (map[uuid.UUID]model.Membership, error) {
+	const op = "infra.storage.postgres.GetMemberships"
+	defer s.observe(op, time.Now())
+
+	ids := make([]string, 0, len(memberIDs))
+	for _, id := range memberIDs {
+		ids = append(ids, id.String())
+	}
+
+	query := `
+		SELECT member_id, tier, starts_at, expires_at, expired_at
+		FROM memberships
+		WHERE member_id = ANY($1::uuid[]);
+	`
+
+	rows, err := s.db.Query(ctx, query, ids)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	memberships := make(map[uuid.UUID]model.Membership)
+	for rows.Next() {
+		var m model.Membership
+		if err := rows.Scan(&m.MemberID, &m.Tier, &m.StartsAt, &m.ExpiresAt, &m.ExpiredAt); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		memberships[m.MemberID] = m
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return memberships, nil
+}
+
+// GetMembershipPayments lists the dues paid by the member, latest first.
+func (s *PostgresStorage) GetMembershipPayments(ctx context.Context, memberID uuid.UUID) ([]model.MembershipPayment, error) {
+	const op = "infra.storage.postgres.GetMembershipPayments"
+	defer s.observe(op, time.Now())
+
+	query := `
+		SELECT id, member_id, tier, amount, paid_at, period_start, period_end, source,
+		       COALESCE(reference, ''), note, created_at
+		FROM membership_payments
+		WHERE member_id = $1
+		ORDER BY paid_at DESC, id DESC;
+	`
+
+	rows, err := s.reader().Query(ctx, query, memberID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var payments []model.MembershipPayment
+	for rows.Next() {
+		var p model.MembershipPayment
+		err := rows.Scan(&p.ID, &p.MemberID, &p.Tier, &p.Amount, &p.PaidAt, &p.PeriodStart, &p.PeriodEnd, &p.Source,
+			&p.Reference, &p.Note, &p.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		payments = append(payments, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return payments, nil
+}
+
+// RecordMembershipPayments saves the payments in a single transaction and renews the
+// memberships they pay for. Payments are applied in the given order with the member row
+// locked, so concurrent payments of one member do not overlap their periods.
+func (s *PostgresStorage) RecordMembershipPayments(ctx context.Context, payments []model.MembershipPayment) ([]model.MembershipPayment, error) {
+	const op = "infra.storage.postgres.RecordMembershipPayments"
+	defer s.observe(op, time.Now())
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	lockQuery := "SELECT id FROM club_members WHERE id = $1 AND erased_at IS NULL FOR UPDATE;"
+	membershipQuery := "SELECT tier, starts_at, expires_at, expired_at FROM memberships WHERE member_id = $1;"
+	insertQuery := `
+		INSERT INTO membership_payments (member_id, tier, amount, paid_at, period_start, period_end, source, reference, note)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, ''), $9)
+		RETURNING id, created_at;
+	`
+	upsertQuery := `
+		INSERT INTO memberships (member_id, tier, starts_at, expires_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (member_id) DO UPDATE
+		SET tier = EXCLUDED.tier, starts_at = EXCLUDED.starts_at, expires_at = EXCLUDED.expires_at, expired_at = NULL;
+	`
+
+	saved := make([]model.MembershipPayment, 0, len(payments))
+	for _, p := range payments {
+		var id uuid.UUID
+		if err := tx.QueryRow(ctx, lockQuery, p.MemberID).Scan(&id); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, fmt.Errorf("%s: %s: %w", op, p.MemberID, storage.ErrMemberNotFound)
+			}
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		current := model.Membership{MemberID: p.MemberID}
+		err := tx.QueryRow(ctx, membershipQuery, p.MemberID).Scan(
+			&current.Tier, &current.StartsAt, &current.ExpiresAt, &current.ExpiredAt,
+		)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		renewed, paid, ok := current.Renew(p)
+		if !ok {
+			return nil, fmt.Errorf("%s: %s: %w", op, paid.MemberID, storage.ErrTierDowngrade)
+		}
+
+		err = tx.QueryRow(ctx, insertQuery,
+			paid.MemberID, paid.Tier, paid.Amount, paid.PaidAt, paid.PeriodStart, paid.PeriodEnd, paid.Source, paid.Reference, paid.Note,
+		).Scan(&paid.ID, &paid.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s: %w", op, paid.Reference, dbError(err))
+		}
+
+		if _, err := tx.Exec(ctx, upsertQuery, renewed.MemberID, renewed.Tier, renewed.StartsAt, renewed.ExpiresAt); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		saved = append(saved, paid)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return saved, nil
+}
+
+// FindMembers returns the emails of the members among the given IDs and emails, keyed by
+// member ID. Erased members are not found.
+func (s *PostgresStorage) FindMembers(ctx context.Context, ids []uuid.UUID, emails []string) (map[uuid.UUID]string, error) {
+	const op = "infra.storage.postgres.FindMembers"
+	defer s.observe(op, time.Now())
+
+	idStrings := make([]string, 0, len(ids))
+	for _, id := range ids {
+		idStrings = append(idStrings, id.String())
+	}
+
+	query := `
+		SELECT id, email
+		FROM club_members
+		WHERE (id = ANY($1::uuid[]) OR email = ANY($2)) AND erased_at IS NULL;
+	`
+
+	rows, err := s.db.Query(ctx, query, idStrings, emails)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	found := make(map[uuid.UUID]string)
+	for rows.Next() {
+		var id uuid.UUID
+		var email string
+		if err := rows.Scan(&id, &email); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		found[id] = email
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return found, nil
+}
+
+// FindPaymentReferences reports which of the given references are already recorded.
+func (s *PostgresStorage) FindPaymentReferences(ctx context.Context, references []string) (map[string]bool, error) {
+	const op = "infra.storage.postgres.FindPaymentReferences"
+	defer s.observe(op, time.Now())
+
+	rows, err := s.db.Query(ctx, "SELECT reference FROM membership_payments WHERE reference = ANY($1);", references)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	taken := make(map[string]bool)
+	for rows.Next() {
+		var reference string
+		if err := rows.Scan(&reference); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		taken[reference] = true
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return taken, nil
+}
+
+// ExpireMemberships flags the memberships whose period has ended and returns how many were
+// flagged. Memberships flagged earlier are left untouched.
+func (s *PostgresStorage) ExpireMemberships(ctx context.Context) (int, error) {
+	const op = "infra.storage.postgres.ExpireMemberships"
+	defer s.observe(op, time.Now())
+
+	query := `
+		UPDATE memberships
+		SET expired_at = CURRENT_TIMESTAMP
+		WHERE expires_at <= CURRENT_TIMESTAMP AND expired_at IS NULL;
+	`
+
+	res, err := s.db.Exec(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected := res.RowsAffected()
+
+	return int(affected), nil
+}
+
+// GetRenewals lists the members whose membership ends before until, lapsed ones included,
+// soonest expiry first.
+func (s *PostgresStorage) GetRenewals(ctx context.Context, until time.Time) ([]model.RenewalEntry, error) {
+	const op = "infra.storage.postgres.GetRenewals"
+	defer s.observe(op, time.Now())
+
+	query := `
+		SELECT m.id, m.full_name, m.email, m.phone, m.status,
+		       ms.tier, ms.starts_at, ms.expires_at, ms.expired_at,
+		       (SELECT MAX(p.paid_at) FROM membership_payments p WHERE p.member_id = m.id)
+		FROM memberships ms
+		JOIN club_members m ON m.id = ms.member_id
+		WHERE ms.expires_at < $1 AND m.erased_at IS NULL
+		ORDER BY ms.expires_at, m.full_name;
+	`
+
+	rows, err := s.reader().Query(ctx, query, until)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var entries []model.RenewalEntry
+	for rows.Next() {
+		var e model.RenewalEntry
+		err := rows.Scan(&e.Member.ID, &e.Member.FullName, &e.Member.Email, &e.Member.Phone, &e.Member.Status,
+			&e.Membership.Tier, &e.Membership.StartsAt, &e.Membership.ExpiresAt, &e.Membership.ExpiredAt,
+			&e.LastPaidAt)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		e.Membership.MemberID = e.Member.ID
+		entries = append(entries, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return entries, nil
+}
+
+// GetEventAccess returns the registration limits of the event.
+func (s *PostgresStorage) GetEventAccess(ctx context.Context, eventID int) (model.EventAccess, error) {
+	const op = "infra.storage.postgres.GetEventAccess"
+	defer s.observe(op, time.Now())
+
+	query := "SELECT COALESCE(min_tier, ''), COALESCE(priority_tier, ''), priority_until FROM events WHERE id = $1;"
+
+	var access model.EventAccess
+	err := s.db.QueryRow(ctx, query, eventID).Scan(&access.MinTier, &access.PriorityTier, &access.PriorityUntil)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.EventAccess{}, storage.ErrEventNotFound
+		}
+		return model.EventAccess{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return access, nil
+}
+
+// SetEventAccess replaces the registration limits of the event and returns the new row
+// version. A non-zero version makes the update conditional on the row still being at that
+// version.
+func (s *PostgresStorage) SetEventAccess(ctx context.Context, eventID int, access model.EventAccess, version int) (int, error) {
+	const op = "infra.storage.postgres.SetEventAccess"
+	defer s.observe(op, time.Now())
+
+	query := `
+		UPDATE events
+		SET min_tier = NULLIF($1, ''), priority_tier = NULLIF($2, ''), priority_until = $3
+		WHERE id = $4 AND ($5 = 0 OR version = $5)
+		RETURNING version;
+	`
+
+	var newVersion int
+	err := s.db.QueryRow(ctx, query, access.MinTier, access.PriorityTier, access.PriorityUntil, eventID, version).Scan(&newVersion)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, s.missingOrStale(ctx, op, "SELECT EXISTS(SELECT 1 FROM events WHERE id = $1)", eventID, storage.ErrEventNotFound)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return newVersion, nil
+}
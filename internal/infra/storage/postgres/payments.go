@@ -0,0 +1,394 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/storage"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"time"
+)
+
+const eventPaymentQuery = `
+	SELECT p.id, p.registration_id, r.user_id, r.event_id, e.title, p.provider, COALESCE(p.external_id, ''),
+	       p.amount, p.status, p.confirmation_url, COALESCE(p.refund_id, ''), r.registration_status,
+	       r.payment_expires_at, p.created_at, p.updated_at
+	FROM event_payments p
+	JOIN registrations r ON r.id = p.registration_id
+	JOIN events e ON e.id = r.event_id
+`
+
+type rowQuerier interface {
+	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+}
+
+// getEventPayments loads the payments with the given IDs.
+func getEventPayments(ctx context.Context, q rowQuerier, ids []int64) ([]model.EventPayment, error) {
+	rows, err := q.Query(ctx, eventPaymentQuery+"WHERE p.id = ANY($1) ORDER BY p.id;", ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	payments := make([]model.EventPayment, 0, len(ids))
+	for rows.Next() {
+		var p model.EventPayment
+		err := rows.Scan(
+			&p.ID, &p.RegistrationID, &p.MemberID, &p.EventID, &p.EventTitle, &p.Provider, &p.ExternalID,
+			&p.Amount, &p.Status, &p.ConfirmationURL, &p.RefundID, &p.RegistrationStatus,
+			&p.ExpiresAt, &p.CreatedAt, &p.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		payments = append(payments, p)
+	}
+
+	return payments, rows.Err()
+}
+
+func getEventPayment(ctx context.Context, q rowQuerier, id int64) (model.EventPayment, error) {
+	payments, err := getEventPayments(ctx, q, []int64{id})
+	if err != nil {
+		return model.EventPayment{}, err
+	}
+	if len(payments) == 0 {
+		return model.EventPayment{}, storage.ErrPaymentNotFound
+	}
+
+	return payments[0], nil
+}
+
+// SetEventPrice sets the fee of the event in kopecks; zero makes the event free. Seats that
+// are already held or paid for keep the amount they were offered at.
+func (s *PostgresStorage) SetEventPrice(ctx context.Context, id int, price int64, version int) (int, error) {
+	const op = "infra.storage.postgres.SetEventPrice"
+	defer s.observe(op, time.Now())
+
+	query := `
+		UPDATE events
+		SET price = NULLIF($1, 0)
+		WHERE id = $2 AND ($3 = 0 OR version = $3)
+		RETURNING version;
+	`
+
+	var newVersion int
+	err := s.db.QueryRow(ctx, query, price, id, version).Scan(&newVersion)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, s.missingOrStale(ctx, op, "SELECT EXISTS(SELECT 1 FROM events WHERE id = $1)", id, storage.ErrEventNotFound)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return newVersion, nil
+}
+
+// CreateEventPayment starts a payment at the event's current price for the member's
+// registration awaiting payment.
+func (s *PostgresStorage) CreateEventPayment(ctx context.Context, memberID uuid.UUID, eventID int, provider string) (model.EventPayment, error) {
+	const op = "infra.storage.postgres.CreateEventPayment"
+	defer s.observe(op, time.Now())
+
+	query := `
+		INSERT INTO event_payments (registration_id, provider, amount)
+		SELECT r.id, $3, e.price
+		FROM registrations r
+		JOIN events e ON e.id = r.event_id
+		WHERE r.user_id = $1 AND r.event_id = $2
+		  AND r.registration_status = 'pending_payment' AND e.price IS NOT NULL
+		RETURNING id;
+	`
+
+	var id int64
+	if err := s.db.QueryRow(ctx, query, memberID, eventID, provider).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.EventPayment{}, storage.ErrRegNotFound
+		}
+		return model.EventPayment{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	p, err := getEventPayment(ctx, s.db, id)
+	if err != nil {
+		return model.EventPayment{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return p, nil
+}
+
+// SetPaymentConfirmation stores what the provider assigned to a created payment.
+func (s *PostgresStorage) SetPaymentConfirmation(ctx context.Context, id int, externalID, confirmationURL string) error {
+	const op = "infra.storage.postgres.SetPaymentConfirmation"
+	defer s.observe(op, time.Now())
+
+	query := "UPDATE event_payments SET external_id = $2, confirmation_url = $3 WHERE id = $1;"
+
+	res, err := s.db.Exec(ctx, query, id, externalID, confirmationURL)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if res.RowsAffected() == 0 {
+		return storage.ErrPaymentNotFound
+	}
+
+	return nil
+}
+
+// CancelEventPayment drops a pending payment and releases the seat its registration holds.
+func (s *PostgresStorage) CancelEventPayment(ctx context.Context, id int) error {
+	const op = "infra.storage.postgres.CancelEventPayment"
+	defer s.observe(op, time.Now())
+
+	query := `
+		WITH canceled AS (
+			UPDATE event_payments SET status = 'canceled'
+			WHERE id = $1 AND status = 'pending'
+			RETURNING registration_id
+		)
+		UPDATE registrations
+		SET registration_status = 'cancelled', payment_expires_at = NULL
+		FROM canceled
+		WHERE registrations.id = canceled.registration_id AND registrations.registration_status = 'pending_payment';
+	`
+
+	if _, err := s.db.Exec(ctx, query, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ReleaseUnpaidRegistrations cancels registrations whose payment hold has run out and the
+// pending payments behind them, returning those payments.
+func (s *PostgresStorage) ReleaseUnpaidRegistrations(ctx context.Context) ([]model.EventPayment, error) {
+	const op = "infra.storage.postgres.ReleaseUnpaidRegistrations"
+	defer s.observe(op, time.Now())
+
+	query := `
+		WITH released AS (
+			UPDATE registrations
+			SET registration_status = 'cancelled', payment_expires_at = NULL
+			WHERE registration_status = 'pending_payment' AND payment_expires_at <= CURRENT_TIMESTAMP
+			RETURNING id
+		)
+		UPDATE event_payments p
+		SET status = 'canceled'
+		FROM released
+		WHERE p.registration_id = released.id AND p.status = 'pending'
+		RETURNING p.id;
+	`
+
+	ids, err := s.paymentIDs(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	payments, err := getEventPayments(ctx, s.db, ids)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return payments, nil
+}
+
+// GetRefundsDue lists payments whose refund has not gone through yet.
+func (s *PostgresStorage) GetRefundsDue(ctx context.Context) ([]model.EventPayment, error) {
+	const op = "infra.storage.postgres.GetRefundsDue"
+	defer s.observe(op, time.Now())
+
+	ids, err := s.paymentIDs(ctx, "SELECT id FROM event_payments WHERE status = 'refund_pending' ORDER BY updated_at;")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	payments, err := getEventPayments(ctx, s.db, ids)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return payments, nil
+}
+
+func (s *PostgresStorage) paymentIDs(ctx context.Context, query string, args ...any) ([]int64, error) {
+	rows, err := s.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// MarkPaymentRefunded records a refund that went through at the provider.
+func (s *PostgresStorage) MarkPaymentRefunded(ctx context.Context, id int, refundID string) error {
+	const op = "infra.storage.postgres.MarkPaymentRefunded"
+	defer s.observe(op, time.Now())
+
+	query := `
+		UPDATE event_payments
+		SET status = 'refunded', refund_id = COALESCE(NULLIF($2, ''), refund_id)
+		WHERE id = $1 AND status IN ('refund_pending', 'refunded');
+	`
+
+	res, err := s.db.Exec(ctx, query, id, refundID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if res.RowsAffected() == 0 {
+		return storage.ErrPaymentNotFound
+	}
+
+	return nil
+}
+
+// ApplyPaymentNotification moves a payment and its registration along after a provider
+// webhook. Only a pending payment confirms its registration; money arriving for a payment
+// that was cancelled or a seat that was released is marked for refund. The notification key
+// is stored in the same transaction, so a redelivered notification changes nothing and is
+// reported as not applied.
+func (s *PostgresStorage) ApplyPaymentNotification(ctx context.Context, n model.PaymentNotification) (model.EventPayment, bool, error) {
+	const op = "infra.storage.postgres.ApplyPaymentNotification"
+	defer s.observe(op, time.Now())
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return model.EventPayment{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	var (
+		paymentID     int64
+		paymentStatus model.PaymentStatus
+		regID         int
+		regStatus     string
+	)
+	lockQuery := `
+		SELECT p.id, p.status, r.id, r.registration_status
+		FROM event_payments p
+		JOIN registrations r ON r.id = p.registration_id
+		WHERE p.provider = $1 AND p.external_id = $2
+		FOR UPDATE OF p, r;
+	`
+	err = tx.QueryRow(ctx, lockQuery, n.Provider, n.ExternalID).Scan(&paymentID, &paymentStatus, &regID, &regStatus)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.EventPayment{}, false, storage.ErrPaymentNotFound
+		}
+		return model.EventPayment{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	res, err := tx.Exec(ctx,
+		"INSERT INTO payment_notifications (provider, key) VALUES ($1, $2) ON CONFLICT DO NOTHING;",
+		n.Provider, n.Key,
+	)
+	if err != nil {
+		return model.EventPayment{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+	affected := res.RowsAffected()
+
+	applied := affected > 0
+	if applied {
+		newStatus, confirm, release := paymentTransition(paymentStatus, regStatus, n.Status)
+
+		if newStatus != paymentStatus {
+			if _, err := tx.Exec(ctx, "UPDATE event_payments SET status = $2 WHERE id = $1;", paymentID, newStatus); err != nil {
+				return model.EventPayment{}, false, fmt.Errorf("%s: %w", op, err)
+			}
+		}
+
+		var regQuery string
+		switch {
+		case confirm:
+			regQuery = "UPDATE registrations SET registration_status = 'registered', payment_expires_at = NULL WHERE id = $1;"
+		case release:
+			regQuery = "UPDATE registrations SET registration_status = 'cancelled', payment_expires_at = NULL WHERE id = $1;"
+		}
+		if regQuery != "" {
+			if _, err := tx.Exec(ctx, regQuery, regID); err != nil {
+				return model.EventPayment{}, false, fmt.Errorf("%s: %w", op, err)
+			}
+		}
+	}
+
+	p, err := getEventPayment(ctx, tx, paymentID)
+	if err != nil {
+		return model.EventPayment{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return model.EventPayment{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return p, applied, nil
+}
+
+// paymentTransition decides what a notification of the reported status does to a payment in
+// status whose registration is in regStatus. It returns the new payment status and whether
+// the registration is confirmed or released.
+func paymentTransition(status model.PaymentStatus, regStatus string, reported model.PaymentStatus) (model.PaymentStatus, bool, bool) {
+	switch reported {
+	case model.PaymentSucceeded:
+		switch {
+		case status == model.PaymentPending && regStatus == model.RegistrationPendingPayment:
+			return model.PaymentSucceeded, true, false
+		case status == model.PaymentPending, status == model.PaymentCanceled:
+			return model.PaymentRefundPending, false, false
+		}
+	case model.PaymentCanceled:
+		if status == model.PaymentPending {
+			return model.PaymentCanceled, false, regStatus == model.RegistrationPendingPayment
+		}
+	case model.PaymentRefunded:
+		if status == model.PaymentSucceeded || status == model.PaymentRefundPending {
+			return model.PaymentRefunded, false, false
+		}
+	}
+
+	return status, false, false
+}
+
+// settleCancelledPayment is run when a registration is cancelled: a pending payment is
+// dropped, and a paid one is marked for refund when the event starts after refundAfter.
+func settleCancelledPayment(ctx context.Context, tx pgx.Tx, regID int, refundAfter time.Time) (*model.EventPayment, error) {
+	query := `
+		UPDATE event_payments p
+		SET status = CASE WHEN p.status = 'pending' THEN 'canceled' ELSE 'refund_pending' END
+		FROM registrations r
+		JOIN events e ON e.id = r.event_id
+		WHERE p.registration_id = $1 AND r.id = p.registration_id
+		  AND (p.status = 'pending' OR (p.status = 'succeeded' AND e.event_date > $2))
+		RETURNING p.id;
+	`
+
+	var id int64
+	if err := tx.QueryRow(ctx, query, regID, refundAfter).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	p, err := getEventPayment(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
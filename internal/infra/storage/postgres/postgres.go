@@ -2,52 +2,55 @@ package postgres
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 	"fmt"
-	"github.com/Ilya-Repin/orchestra_api/internal/config"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/metrics"
 	"github.com/Ilya-Repin/orchestra_api/internal/infra/storage"
 	"github.com/Ilya-Repin/orchestra_api/internal/model"
 	"github.com/google/uuid"
-	"github.com/lib/pq"
-	_ "github.com/lib/pq"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"strings"
 	"time"
 )
 
 type PostgresStorage struct {
-	db *sql.DB
+	db      *pgxpool.Pool
+	replica *Replica
+	metrics *metrics.Metrics
 }
 
-func New(db *sql.DB) *PostgresStorage {
-	return &PostgresStorage{db: db}
-}
+// memberExistsQuery tells a missing member from a stale version. Erased members count as
+// missing: only their anonymized registrations are kept.
+const memberExistsQuery = "SELECT EXISTS(SELECT 1 FROM club_members WHERE id = $1 AND erased_at IS NULL)"
 
-func InitDB(cfg *config.StorageConfig) (db *sql.DB, err error) {
-	connStr := fmt.Sprintf(
-		"user=%s password=%s host=%s port=%d dbname=%s sslmode=%s",
-		cfg.User,
-		cfg.Password,
-		cfg.Host,
-		cfg.Port,
-		cfg.Dbname,
-		cfg.Sslmode,
-	)
+// New returns the storage on the primary db. Reads of the Get* family go to replica while it
+// is healthy; replica may be nil.
+func New(db *pgxpool.Pool, replica *Replica, metrics *metrics.Metrics) *PostgresStorage {
+	return &PostgresStorage{db: db, replica: replica, metrics: metrics}
+}
 
-	db, err = sql.Open("postgres", connStr)
-	if err != nil {
-		return nil, err
+// reader returns the database to read from: the replica while it is healthy, the primary
+// otherwise.
+func (s *PostgresStorage) reader() *pgxpool.Pool {
+	if s.replica != nil && s.replica.healthy.Load() {
+		return s.replica.db
 	}
 
-	err = db.Ping()
-	if err != nil {
-		return nil, err
-	}
+	return s.db
+}
 
-	return db, nil
+// observe records how long the storage operation op took, labelled by its name without
+// the package prefix.
+func (s *PostgresStorage) observe(op string, start time.Time) {
+	s.metrics.DBQueryDurationSeconds.
+		WithLabelValues(strings.TrimPrefix(op, "infra.storage.postgres.")).
+		Observe(time.Since(start).Seconds())
 }
+
 func (s *PostgresStorage) AddMember(ctx context.Context, fullName, email, phone string) (id uuid.UUID, err error) {
 	const op = "infra.storage.postgres.AddMember"
+	defer s.observe(op, time.Now())
 
 	if !model.IsValidEmail(email) {
 		return uuid.UUID{}, fmt.Errorf("%s: %w", op, storage.ErrInvalidEmail)
@@ -57,89 +60,184 @@ func (s *PostgresStorage) AddMember(ctx context.Context, fullName, email, phone
 		return uuid.UUID{}, fmt.Errorf("%s: %w", op, storage.ErrInvalidPhone)
 	}
 
-	stmt, err := s.db.PrepareContext(ctx, "INSERT INTO club_members (full_name, email, phone) VALUES ($1, $2, $3) RETURNING id;")
-	if err != nil {
-		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
-	}
-	defer stmt.Close()
+	query := "INSERT INTO club_members (full_name, email, phone) VALUES ($1, $2, $3) RETURNING id;"
 
-	err = stmt.QueryRowContext(ctx, fullName, email, phone).Scan(&id)
+	err = s.db.QueryRow(ctx, query, fullName, email, phone).Scan(&id)
 	if err != nil {
-		var pqErr *pq.Error
-		if errors.As(err, &pqErr) {
-			if pqErr.Code == "23505" {
-				if isEmailDuplicate(ctx, s.db, email) {
-					return uuid.UUID{}, fmt.Errorf("%s: %w", op, storage.ErrEmailDuplicate)
-				}
-			}
-			if isPhoneDuplicate(ctx, s.db, phone) {
-				return uuid.UUID{}, fmt.Errorf("%s: %w", op, storage.ErrPhoneDuplicate)
-			}
-		}
-		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, dbError(err))
 	}
 
 	return id, nil
 }
 
-func isEmailDuplicate(ctx context.Context, db *sql.DB, email string) bool {
-	var count int
-	err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM club_members WHERE email = $1", email).Scan(&count)
+// FindExistingContacts reports which of the given emails and phones are already
+// taken by club members.
+func (s *PostgresStorage) FindExistingContacts(ctx context.Context, emails, phones []string) (map[string]bool, map[string]bool, error) {
+	const op = "infra.storage.postgres.FindExistingContacts"
+	defer s.observe(op, time.Now())
+
+	query := `
+		SELECT email, phone
+		FROM club_members
+		WHERE email = ANY($1) OR phone = ANY($2);
+	`
+
+	rows, err := s.db.Query(ctx, query, emails, phones)
 	if err != nil {
-		return false
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	takenEmails, takenPhones := make(map[string]bool), make(map[string]bool)
+	for rows.Next() {
+		var email, phone string
+		if err := rows.Scan(&email, &phone); err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", op, err)
+		}
+		takenEmails[email] = true
+		takenPhones[phone] = true
 	}
-	return count > 0
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return takenEmails, takenPhones, nil
 }
 
-func isPhoneDuplicate(ctx context.Context, db *sql.DB, phone string) bool {
-	var count int
-	err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM club_members WHERE phone = $1", phone).Scan(&count)
+// AddMembers inserts all members in a single transaction: either every member
+// is saved or none is.
+func (s *PostgresStorage) AddMembers(ctx context.Context, members []model.Member) ([]uuid.UUID, error) {
+	const op = "infra.storage.postgres.AddMembers"
+	defer s.observe(op, time.Now())
+
+	tx, err := s.db.Begin(ctx)
 	if err != nil {
-		return false
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := "INSERT INTO club_members (full_name, email, phone, status) VALUES ($1, $2, $3, $4) RETURNING id;"
+
+	ids := make([]uuid.UUID, 0, len(members))
+	for _, m := range members {
+		var id uuid.UUID
+		if err := tx.QueryRow(ctx, query, m.FullName, m.Email, m.Phone, m.Status).Scan(&id); err != nil {
+			return nil, fmt.Errorf("%s: %s: %w", op, m.Email, dbError(err))
+		}
+		ids = append(ids, id)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
-	return count > 0
+
+	return ids, nil
 }
 
+// GetMember reads the member from the replica when there is one. Callers that go on to
+// change the member, or have to see their own writes, use GetMemberFromPrimary.
 func (s *PostgresStorage) GetMember(ctx context.Context, id uuid.UUID) (model.Member, error) {
 	const op = "infra.storage.postgres.GetMember"
+	defer s.observe(op, time.Now())
 
-	stmt, err := s.db.PrepareContext(ctx, "SELECT id, full_name, email, phone, status, created_at, updated_at FROM club_members WHERE id = $1;")
+	member, err := getMember(ctx, s.reader(), id)
 	if err != nil {
+		if errors.Is(err, storage.ErrMemberNotFound) {
+			return model.Member{}, err
+		}
 		return model.Member{}, fmt.Errorf("%s: %w", op, err)
 	}
-	defer stmt.Close()
+
+	return member, nil
+}
+
+// GetMemberFromPrimary reads the member from the primary. It takes no lock; writes guard
+// against concurrent changes with the version.
+func (s *PostgresStorage) GetMemberFromPrimary(ctx context.Context, id uuid.UUID) (model.Member, error) {
+	const op = "infra.storage.postgres.GetMemberFromPrimary"
+	defer s.observe(op, time.Now())
+
+	member, err := getMember(ctx, s.db, id)
+	if err != nil {
+		if errors.Is(err, storage.ErrMemberNotFound) {
+			return model.Member{}, err
+		}
+		return model.Member{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return member, nil
+}
+
+// GetMemberLanguage reads the language the member prefers, empty if none, from the primary,
+// so that a change of the preference applies to the very next response.
+func (s *PostgresStorage) GetMemberLanguage(ctx context.Context, id uuid.UUID) (string, error) {
+	const op = "infra.storage.postgres.GetMemberLanguage"
+	defer s.observe(op, time.Now())
+
+	query := "SELECT COALESCE(language, '') FROM club_members WHERE id = $1 AND erased_at IS NULL;"
+
+	var lang string
+	if err := s.db.QueryRow(ctx, query, id).Scan(&lang); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", storage.ErrMemberNotFound
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return lang, nil
+}
+
+func getMember(ctx context.Context, db *pgxpool.Pool, id uuid.UUID) (model.Member, error) {
+	query := `
+		SELECT id, full_name, email, phone, status, email_verified_at, phone_verified_at, COALESCE(language, ''),
+		       notify_email, notify_sms, created_at, updated_at, version
+		FROM club_members
+		WHERE id = $1 AND erased_at IS NULL;
+	`
 
 	var member model.Member
-	err = stmt.QueryRowContext(ctx, id).Scan(
+	err := db.QueryRow(ctx, query, id).Scan(
 		&member.ID,
 		&member.FullName,
 		&member.Email,
 		&member.Phone,
 		&member.Status,
+		&member.EmailVerifiedAt,
+		&member.PhoneVerifiedAt,
+		&member.Language,
+		&member.Notifications.Email,
+		&member.Notifications.SMS,
 		&member.CreatedAt,
 		&member.UpdatedAt,
+		&member.Version,
 	)
 
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+		if errors.Is(err, pgx.ErrNoRows) {
 			return model.Member{}, storage.ErrMemberNotFound
 		}
-		return model.Member{}, fmt.Errorf("%s: %w", op, err)
+		return model.Member{}, err
 	}
 
 	return member, nil
 }
 
-func (s *PostgresStorage) GetMembers(ctx context.Context) ([]model.Member, error) {
+func (s *PostgresStorage) GetMembers(ctx context.Context, filter model.MemberFilter) ([]model.Member, error) {
 	const op = "infra.storage.postgres.GetMembers"
+	defer s.observe(op, time.Now())
 
 	query := `
-		SELECT id, full_name, email, phone, status, created_at
+		SELECT id, full_name, email, phone, status, email_verified_at, phone_verified_at, COALESCE(language, ''), created_at
 		FROM club_members
+		WHERE erased_at IS NULL
+		  AND ($1 = '' OR status = $1)
+		  AND ($2::boolean IS NULL OR (email_verified_at IS NOT NULL) = $2)
+		  AND ($3::boolean IS NULL OR (phone_verified_at IS NOT NULL) = $3)
 		ORDER BY created_at DESC;
 	`
 
-	rows, err := s.db.QueryContext(ctx, query)
+	rows, err := s.reader().Query(ctx, query, filter.Status, filter.EmailVerified, filter.PhoneVerified)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -148,7 +246,7 @@ func (s *PostgresStorage) GetMembers(ctx context.Context) ([]model.Member, error
 	var members []model.Member
 	for rows.Next() {
 		var m model.Member
-		err := rows.Scan(&m.ID, &m.FullName, &m.Email, &m.Phone, &m.Status, &m.CreatedAt)
+		err := rows.Scan(&m.ID, &m.FullName, &m.Email, &m.Phone, &m.Status, &m.EmailVerifiedAt, &m.PhoneVerifiedAt, &m.Language, &m.CreatedAt)
 		if err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
@@ -162,133 +260,179 @@ func (s *PostgresStorage) GetMembers(ctx context.Context) ([]model.Member, error
 	return members, nil
 }
 
-func (s *PostgresStorage) GetMembersWithStatus(ctx context.Context, status model.MemberStatus) ([]model.Member, error) {
-	const op = "infra.storage.postgres.GetMembersWithStatus"
+// StreamMembers calls fn for every member, optionally filtered by status,
+// without loading the whole result into memory.
+func (s *PostgresStorage) StreamMembers(ctx context.Context, status model.MemberStatus, fn func(model.Member) error) error {
+	const op = "infra.storage.postgres.StreamMembers"
+	defer s.observe(op, time.Now())
 
 	query := `
-		SELECT id, full_name, email, phone, status, created_at
+		SELECT id, full_name, email, phone, status, created_at, updated_at
 		FROM club_members
-		WHERE status = $1
+		WHERE erased_at IS NULL AND ($1 = '' OR status = $1)
 		ORDER BY created_at DESC;
 	`
 
-	stmt, err := s.db.PrepareContext(ctx, query)
-	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
-	}
-	defer stmt.Close()
-
-	rows, err := stmt.QueryContext(ctx, status)
+	rows, err := s.db.Query(ctx, query, status)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return fmt.Errorf("%s: %w", op, err)
 	}
 	defer rows.Close()
 
-	var members []model.Member
 	for rows.Next() {
 		var m model.Member
-		if err := rows.Scan(&m.ID, &m.FullName, &m.Email, &m.Phone, &m.Status, &m.CreatedAt); err != nil {
-			return nil, fmt.Errorf("%s: %w", op, err)
+		if err := rows.Scan(&m.ID, &m.FullName, &m.Email, &m.Phone, &m.Status, &m.CreatedAt, &m.UpdatedAt); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := fn(m); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
 		}
-		members = append(members, m)
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	return members, nil
+	return nil
 }
 
-func (s *PostgresStorage) DeleteMember(ctx context.Context, id uuid.UUID) error {
-	const op = "infra.storage.postgres.DeleteMember"
-
-	stmt, err := s.db.PrepareContext(ctx, "DELETE FROM club_members WHERE id = $1;")
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
-	}
-	defer stmt.Close()
+// UpdateMember rewrites the member profile and returns the new row version. A changed email
+// or phone loses its verification mark and the codes sent to it, in the same statement. A
+// non-zero version makes the update conditional on the row still being at that version.
+func (s *PostgresStorage) UpdateMember(ctx context.Context, id uuid.UUID, fullName, email, phone string, version int) (int, error) {
+	const op = "infra.storage.postgres.UpdateMember"
+	defer s.observe(op, time.Now())
 
-	res, err := stmt.ExecContext(ctx, id)
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
-	}
+	query := `
+		WITH old AS (
+			SELECT email, phone FROM club_members WHERE id = $4
+		),
+		upd AS (
+			UPDATE club_members
+			SET full_name = $1, email = $2, phone = $3,
+			    email_verified_at = CASE WHEN email = $2 THEN email_verified_at END,
+			    phone_verified_at = CASE WHEN phone = $3 THEN phone_verified_at END
+			WHERE id = $4 AND erased_at IS NULL AND ($5 = 0 OR version = $5)
+			RETURNING version
+		),
+		codes AS (
+			DELETE FROM verification_codes v
+			USING old, upd
+			WHERE v.member_id = $4
+			  AND ((v.channel = 'email' AND old.email <> $2) OR (v.channel = 'phone' AND old.phone <> $3))
+		)
+		SELECT version FROM upd;
+	`
 
-	affected, err := res.RowsAffected()
+	var newVersion int
+	err := s.db.QueryRow(ctx, query, fullName, email, phone, id, version).Scan(&newVersion)
 	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
-	}
-	if affected == 0 {
-		return storage.ErrMemberNotFound
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, s.missingOrStale(ctx, op, memberExistsQuery, id, storage.ErrMemberNotFound)
+		}
+		return 0, fmt.Errorf("%s: %w", op, dbError(err))
 	}
 
-	return nil
+	return newVersion, nil
 }
 
-func (s *PostgresStorage) UpdateMember(ctx context.Context, id uuid.UUID, fullName, email, phone string) error {
-	const op = "infra.storage.postgres.UpdateMember"
+// PatchMember updates only the profile fields present in the patch and returns the new row
+// version. A changed email or phone loses its verification mark and the codes sent to it,
+// in the same statement. A non-zero version makes the update conditional on the row still
+// being at that version.
+func (s *PostgresStorage) PatchMember(ctx context.Context, id uuid.UUID, patch model.MemberProfilePatch, version int) (int, error) {
+	const op = "infra.storage.postgres.PatchMember"
+	defer s.observe(op, time.Now())
 
-	stmt, err := s.db.PrepareContext(ctx, `
-		UPDATE club_members 
-		SET full_name = $1, email = $2, phone = $3 
-		WHERE id = $4;
-	`)
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+	var sets, stale []string
+	var args []interface{}
+	set := func(column string, value interface{}) string {
+		args = append(args, value)
+		placeholder := fmt.Sprintf("$%d", len(args))
+		sets = append(sets, column+" = "+placeholder)
+		return placeholder
 	}
-	defer stmt.Close()
 
-	res, err := stmt.ExecContext(ctx, fullName, email, phone, id)
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+	if patch.FullName != nil {
+		set("full_name", *patch.FullName)
+	}
+	if patch.Email != nil {
+		p := set("email", *patch.Email)
+		sets = append(sets, "email_verified_at = CASE WHEN email = "+p+" THEN email_verified_at END")
+		stale = append(stale, "(v.channel = 'email' AND old.email <> "+p+")")
+	}
+	if patch.Phone != nil {
+		p := set("phone", *patch.Phone)
+		sets = append(sets, "phone_verified_at = CASE WHEN phone = "+p+" THEN phone_verified_at END")
+		stale = append(stale, "(v.channel = 'phone' AND old.phone <> "+p+")")
+	}
+	if patch.Language != nil {
+		args = append(args, *patch.Language)
+		sets = append(sets, fmt.Sprintf("language = NULLIF($%d, '')", len(args)))
+	}
+	if len(sets) == 0 {
+		return 0, fmt.Errorf("%s: empty patch", op)
 	}
 
-	affected, err := res.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+	args = append(args, id, version)
+	query := fmt.Sprintf(
+		"UPDATE club_members SET %s WHERE id = $%d AND erased_at IS NULL AND ($%d = 0 OR version = $%d) RETURNING version",
+		strings.Join(sets, ", "), len(args)-1, len(args), len(args),
+	)
+	if len(stale) > 0 {
+		query = fmt.Sprintf(`
+			WITH old AS (SELECT email, phone FROM club_members WHERE id = $%d),
+			upd AS (%s),
+			codes AS (DELETE FROM verification_codes v USING old, upd WHERE v.member_id = $%d AND (%s))
+			SELECT version FROM upd`,
+			len(args)-1, query, len(args)-1, strings.Join(stale, " OR "),
+		)
 	}
-	if affected == 0 {
-		return storage.ErrMemberNotFound
+	query += ";"
+
+	var newVersion int
+	err := s.db.QueryRow(ctx, query, args...).Scan(&newVersion)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, s.missingOrStale(ctx, op, memberExistsQuery, id, storage.ErrMemberNotFound)
+		}
+		return 0, fmt.Errorf("%s: %w", op, dbError(err))
 	}
 
-	return nil
+	return newVersion, nil
 }
 
-func (s *PostgresStorage) UpdateMemberStatus(ctx context.Context, id uuid.UUID, status model.MemberStatus) error {
+// UpdateMemberStatus sets the member status and returns the new row version. A non-zero
+// version makes the update conditional on the row still being at that version.
+func (s *PostgresStorage) UpdateMemberStatus(ctx context.Context, id uuid.UUID, status model.MemberStatus, version int) (int, error) {
 	const op = "infra.storage.postgres.UpdateMemberStatus"
+	defer s.observe(op, time.Now())
 
-	stmt, err := s.db.PrepareContext(ctx, "UPDATE club_members SET status=$1 WHERE id=$2;")
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
-	}
-	defer stmt.Close()
-
-	res, err := stmt.ExecContext(ctx, status, id)
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
-	}
+	query := "UPDATE club_members SET status=$1 WHERE id=$2 AND erased_at IS NULL AND ($3 = 0 OR version = $3) RETURNING version;"
 
-	rowsAffected, err := res.RowsAffected()
+	var newVersion int
+	err := s.db.QueryRow(ctx, query, status, id, version).Scan(&newVersion)
 	if err != nil {
-		return fmt.Errorf("%s: rows affected: %w", op, err)
-	}
-	if rowsAffected == 0 {
-		return fmt.Errorf("%s: %w", op, storage.ErrMemberNotFound)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, s.missingOrStale(ctx, op, memberExistsQuery, id, storage.ErrMemberNotFound)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return nil
+	return newVersion, nil
 }
 
 func (s *PostgresStorage) CheckIsApproved(ctx context.Context, id uuid.UUID) (bool, error) {
 	const op = "infra.storage.postgres.CheckIsApproved"
+	defer s.observe(op, time.Now())
 
-	query := "SELECT status FROM club_members WHERE id = $1"
+	query := "SELECT status FROM club_members WHERE id = $1 AND erased_at IS NULL"
 
 	var status string
 
-	err := s.db.QueryRowContext(ctx, query, id).Scan(&status)
+	err := s.db.QueryRow(ctx, query, id).Scan(&status)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+		if errors.Is(err, pgx.ErrNoRows) {
 			return false, storage.ErrMemberNotFound
 		}
 		return false, fmt.Errorf("%s: %w", op, err)
@@ -303,9 +447,81 @@ func (s *PostgresStorage) GetEvents(
 	begin, end *time.Time,
 ) ([]model.Event, error) {
 	const op = "infra.storage.postgres.GetEvents"
+	defer s.observe(op, time.Now())
+
+	var events []model.Event
+
+	query, args := eventsQuery(eventType, begin, end)
+
+	rows, err := s.reader().Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var ev model.Event
+		err := rows.Scan(
+			&ev.ID, &ev.Title, &ev.Description, &ev.EventDate, &ev.Capacity, &ev.CreatedAt, &ev.UpdatedAt,
+			&ev.EventType.ID, &ev.EventType.Name, &ev.EventType.Description,
+			&ev.Location.ID, &ev.Location.Name, &ev.Location.Route, &ev.Location.Features,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		events = append(events, ev)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return events, nil
+}
+
+// StreamEvents calls fn for every event matching the filters, in event date order,
+// without loading the whole result into memory.
+func (s *PostgresStorage) StreamEvents(
+	ctx context.Context,
+	eventType *int,
+	begin, end *time.Time,
+	fn func(model.Event) error,
+) error {
+	const op = "infra.storage.postgres.StreamEvents"
+	defer s.observe(op, time.Now())
+
+	query, args := eventsQuery(eventType, begin, end)
+
+	rows, err := s.db.Query(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var ev model.Event
+		err := rows.Scan(
+			&ev.ID, &ev.Title, &ev.Description, &ev.EventDate, &ev.Capacity, &ev.CreatedAt, &ev.UpdatedAt,
+			&ev.EventType.ID, &ev.EventType.Name, &ev.EventType.Description,
+			&ev.Location.ID, &ev.Location.Name, &ev.Location.Route, &ev.Location.Features,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := fn(ev); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
 
+func eventsQuery(eventType *int, begin, end *time.Time) (string, []interface{}) {
 	var (
-		events []model.Event
 		args   []interface{}
 		conds  []string
 		argNum = 1
@@ -343,40 +559,12 @@ func (s *PostgresStorage) GetEvents(
 
 	query += " ORDER BY e.event_date ASC"
 
-	stmt, err := s.db.PrepareContext(ctx, query)
-	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
-	}
-	defer stmt.Close()
-
-	rows, err := stmt.QueryContext(ctx, args...)
-	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var ev model.Event
-		err := rows.Scan(
-			&ev.ID, &ev.Title, &ev.Description, &ev.EventDate, &ev.Capacity, &ev.CreatedAt, &ev.UpdatedAt,
-			&ev.EventType.ID, &ev.EventType.Name, &ev.EventType.Description,
-			&ev.Location.ID, &ev.Location.Name, &ev.Location.Route, &ev.Location.Features,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("%s: %w", op, err)
-		}
-		events = append(events, ev)
-	}
-
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
-	}
-
-	return events, nil
+	return query, args
 }
 
 func (s *PostgresStorage) GetUpcomingEvents(ctx context.Context) ([]model.Event, error) {
 	const op = "infra.storage.postgres.GetUpcomingEvents"
+	defer s.observe(op, time.Now())
 
 	query := `
 		SELECT 
@@ -390,7 +578,7 @@ func (s *PostgresStorage) GetUpcomingEvents(ctx context.Context) ([]model.Event,
 		ORDER BY e.event_date ASC;
 	`
 
-	rows, err := s.db.QueryContext(ctx, query)
+	rows, err := s.reader().Query(ctx, query)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -414,8 +602,11 @@ func (s *PostgresStorage) GetUpcomingEvents(ctx context.Context) ([]model.Event,
 	return events, nil
 }
 
+// GetAvailableEvents lists upcoming events the member is not registered for. Events closed to
+// the tier of the member's running membership are left out.
 func (s *PostgresStorage) GetAvailableEvents(ctx context.Context, memberID uuid.UUID) ([]model.Event, error) {
 	const op = "infra.storage.postgres.GetAvailableEvents"
+	defer s.observe(op, time.Now())
 
 	query := `
 		SELECT 
@@ -429,18 +620,17 @@ func (s *PostgresStorage) GetAvailableEvents(ctx context.Context, memberID uuid.
 		AND e.id NOT IN (
 			SELECT reg.event_id
 			FROM registrations reg
-			WHERE reg.user_id = $1 AND reg.registration_status = 'registered'
+			WHERE reg.user_id = $1 AND reg.registration_status IN ('registered', 'pending_payment')
 		)
+		AND (e.min_tier IS NULL OR tier_rank(e.min_tier) <= (
+			SELECT COALESCE(MAX(tier_rank(ms.tier)), 0)
+			FROM memberships ms
+			WHERE ms.member_id = $1 AND ms.starts_at <= CURRENT_TIMESTAMP AND ms.expires_at > CURRENT_TIMESTAMP
+		))
 		ORDER BY e.event_date ASC;
 	`
 
-	stmt, err := s.db.PrepareContext(ctx, query)
-	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
-	}
-	defer stmt.Close()
-
-	rows, err := stmt.QueryContext(ctx, memberID)
+	rows, err := s.reader().Query(ctx, query, memberID)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -466,6 +656,7 @@ func (s *PostgresStorage) GetAvailableEvents(ctx context.Context, memberID uuid.
 
 func (s *PostgresStorage) GetRegisteredEvents(ctx context.Context, memberID uuid.UUID) ([]model.Event, error) {
 	const op = "infra.storage.postgres.GetRegisteredEvents"
+	defer s.observe(op, time.Now())
 
 	query := `
 		SELECT 
@@ -484,13 +675,7 @@ func (s *PostgresStorage) GetRegisteredEvents(ctx context.Context, memberID uuid
 		ORDER BY e.event_date ASC;
 	`
 
-	stmt, err := s.db.PrepareContext(ctx, query)
-	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
-	}
-	defer stmt.Close()
-
-	rows, err := stmt.QueryContext(ctx, memberID)
+	rows, err := s.reader().Query(ctx, query, memberID)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -514,12 +699,45 @@ func (s *PostgresStorage) GetRegisteredEvents(ctx context.Context, memberID uuid
 	return events, nil
 }
 
+// GetEvent reads the event from the replica when there is one. Callers that go on to change
+// the event use GetEventFromPrimary.
 func (s *PostgresStorage) GetEvent(ctx context.Context, id int) (model.Event, error) {
 	const op = "infra.storage.postgres.GetEvent"
+	defer s.observe(op, time.Now())
+
+	ev, err := getEvent(ctx, s.reader(), id)
+	if err != nil {
+		if errors.Is(err, storage.ErrEventNotFound) {
+			return model.Event{}, err
+		}
+		return model.Event{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return ev, nil
+}
+
+// GetEventFromPrimary reads the event from the primary. It takes no lock; writes guard
+// against concurrent changes with the version.
+func (s *PostgresStorage) GetEventFromPrimary(ctx context.Context, id int) (model.Event, error) {
+	const op = "infra.storage.postgres.GetEventFromPrimary"
+	defer s.observe(op, time.Now())
 
+	ev, err := getEvent(ctx, s.db, id)
+	if err != nil {
+		if errors.Is(err, storage.ErrEventNotFound) {
+			return model.Event{}, err
+		}
+		return model.Event{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return ev, nil
+}
+
+func getEvent(ctx context.Context, db *pgxpool.Pool, id int) (model.Event, error) {
 	query := `
-		SELECT e.id, e.title, e.description, e.event_date, e.capacity, e.created_at, e.updated_at,
-		       l.id, l.name, et.id, et.name
+		SELECT e.id, e.title, e.description, e.event_date, e.capacity, COALESCE(e.price, 0), e.created_at, e.updated_at, e.version,
+		       l.id, l.name, et.id, et.name,
+		       COALESCE(e.min_tier, ''), COALESCE(e.priority_tier, ''), e.priority_until
 		FROM events e
 		JOIN locations l ON e.location = l.id
 		JOIN event_types et ON e.event_type = et.id
@@ -528,16 +746,17 @@ func (s *PostgresStorage) GetEvent(ctx context.Context, id int) (model.Event, er
 
 	var ev model.Event
 
-	err := s.db.QueryRowContext(ctx, query, id).Scan(
-		&ev.ID, &ev.Title, &ev.Description, &ev.EventDate, &ev.Capacity, &ev.CreatedAt, &ev.UpdatedAt,
+	err := db.QueryRow(ctx, query, id).Scan(
+		&ev.ID, &ev.Title, &ev.Description, &ev.EventDate, &ev.Capacity, &ev.Price, &ev.CreatedAt, &ev.UpdatedAt, &ev.Version,
 		&ev.Location.ID, &ev.Location.Name,
 		&ev.EventType.ID, &ev.EventType.Name,
+		&ev.Access.MinTier, &ev.Access.PriorityTier, &ev.Access.PriorityUntil,
 	)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+		if errors.Is(err, pgx.ErrNoRows) {
 			return model.Event{}, storage.ErrEventNotFound
 		}
-		return model.Event{}, fmt.Errorf("%s: %w", op, err)
+		return model.Event{}, err
 	}
 
 	return ev, nil
@@ -545,6 +764,7 @@ func (s *PostgresStorage) GetEvent(ctx context.Context, id int) (model.Event, er
 
 func (s *PostgresStorage) AddEvent(ctx context.Context, title, description string, evType int, evDate time.Time, location int, capacity int) (int, error) {
 	const op = "infra.storage.postgres.AddEvent"
+	defer s.observe(op, time.Now())
 
 	query := `
 		INSERT INTO events (title, description, event_type, event_date, location, capacity)
@@ -553,87 +773,185 @@ func (s *PostgresStorage) AddEvent(ctx context.Context, title, description strin
 	`
 
 	var id int
-	err := s.db.QueryRowContext(ctx, query,
+	err := s.db.QueryRow(ctx, query,
 		title, description, evType, evDate, location, capacity,
 	).Scan(&id)
 	if err != nil {
-		return 0, fmt.Errorf("%s: %w", op, err)
+		return 0, fmt.Errorf("%s: %w", op, dbError(err))
 	}
 
 	return id, nil
 }
 
-func (s *PostgresStorage) DeleteEvent(ctx context.Context, id int) error {
+// DeleteEvent removes the event. A non-zero version makes the delete conditional on the
+// row still being at that version.
+func (s *PostgresStorage) DeleteEvent(ctx context.Context, id int, version int) error {
 	const op = "infra.storage.postgres.DeleteEvent"
+	defer s.observe(op, time.Now())
 
-	stmt, err := s.db.PrepareContext(ctx, "DELETE FROM events WHERE id = $1;")
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
-	}
-	defer stmt.Close()
+	query := "DELETE FROM events WHERE id = $1 AND ($2 = 0 OR version = $2);"
 
-	res, err := stmt.ExecContext(ctx, id)
+	res, err := s.db.Exec(ctx, query, id, version)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	rows, err := res.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
-	}
+	rows := res.RowsAffected()
 	if rows == 0 {
-		return storage.ErrEventNotFound
+		return s.missingOrStale(ctx, op, "SELECT EXISTS(SELECT 1 FROM events WHERE id = $1)", id, storage.ErrEventNotFound)
 	}
 
 	return nil
 }
 
-func (s *PostgresStorage) UpdateEvent(ctx context.Context, id int, title, description string, evType int, evDate time.Time, location int, capacity int) error {
+// UpdateEvent rewrites the event and reports what the write did to its seats. A non-zero
+// version makes the update conditional on the row still being at that version. A capacity
+// lowered below the active registrations is rejected with ErrCapacityBelowRegs. The jobs
+// announce makes of the change are enqueued in its transaction.
+func (s *PostgresStorage) UpdateEvent(ctx context.Context, id int, title, description string, evType int, evDate time.Time, location int, capacity int, version int, announce model.SeatsAnnouncer) (model.CapacityChange, error) {
 	const op = "infra.storage.postgres.UpdateEvent"
+	defer s.observe(op, time.Now())
 
 	query := `
 		UPDATE events
-		SET title = $1, description = $2, event_type = $3, event_date = $4, location = $5, capacity = $6
-		WHERE id = $7
+		SET title = $2, description = $3, event_type = $4, event_date = $5, location = $6, capacity = $7
+		WHERE id = $1
+		RETURNING version, capacity;
 	`
 
-	stmt, err := s.db.PrepareContext(ctx, query)
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+	return s.writeSeats(ctx, op, id, version, &capacity, announce, query, id, title, description, evType, evDate, location, capacity)
+}
+
+// PatchEvent updates only the fields present in the patch and reports what the write did to
+// the event's seats. A non-zero version makes the update conditional on the row still being
+// at that version. A capacity lowered below the active registrations is rejected with
+// ErrCapacityBelowRegs. The jobs announce makes of the change are enqueued in its transaction.
+func (s *PostgresStorage) PatchEvent(ctx context.Context, id int, patch model.EventPatch, version int, announce model.SeatsAnnouncer) (model.CapacityChange, error) {
+	const op = "infra.storage.postgres.PatchEvent"
+	defer s.observe(op, time.Now())
+
+	var sets []string
+	args := []interface{}{id}
+	set := func(column string, value interface{}) {
+		args = append(args, value)
+		sets = append(sets, fmt.Sprintf("%s = $%d", column, len(args)))
+	}
+
+	if patch.Title != nil {
+		set("title", *patch.Title)
+	}
+	if patch.Description != nil {
+		set("description", *patch.Description)
+	}
+	if patch.EventType != nil {
+		set("event_type", *patch.EventType)
+	}
+	if patch.EventDate != nil {
+		set("event_date", *patch.EventDate)
+	}
+	if patch.Location != nil {
+		set("location", *patch.Location)
+	}
+	if patch.Capacity != nil {
+		set("capacity", *patch.Capacity)
 	}
-	defer stmt.Close()
+	if len(sets) == 0 {
+		return model.CapacityChange{}, fmt.Errorf("%s: empty patch", op)
+	}
+
+	query := fmt.Sprintf("UPDATE events SET %s WHERE id = $1 RETURNING version, capacity;", strings.Join(sets, ", "))
 
-	res, err := stmt.ExecContext(ctx, title, description, evType, evDate, location, capacity, id)
+	return s.writeSeats(ctx, op, id, version, patch.Capacity, announce, query, args...)
+}
+
+// writeSeats runs query, a write of the event that may change its capacity to *capacity, and
+// enqueues the jobs announce makes of its outcome in the same transaction. Like
+// SetEventCapacity it locks the event row before counting the active registrations, so a
+// registration in flight is either counted or waits for the write and sees the new capacity.
+func (s *PostgresStorage) writeSeats(ctx context.Context, op string, id, version int, capacity *int, announce model.SeatsAnnouncer, query string, args ...any) (model.CapacityChange, error) {
+	tx, err := s.db.Begin(ctx)
 	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+		return model.CapacityChange{}, fmt.Errorf("%s: %w", op, err)
 	}
+	defer tx.Rollback(ctx)
 
-	rows, err := res.RowsAffected()
+	change := model.CapacityChange{EventID: id}
+
+	var current int
+	err = tx.QueryRow(ctx, "SELECT capacity, version FROM events WHERE id = $1 FOR UPDATE;", id).Scan(&change.Previous, &current)
 	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.CapacityChange{}, storage.ErrEventNotFound
+		}
+		return model.CapacityChange{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if version != 0 && current != version {
+		return model.CapacityChange{}, fmt.Errorf("%s: %w", op, storage.ErrVersionMismatch)
 	}
 
-	if rows == 0 {
-		return storage.ErrEventNotFound
+	countQuery := `
+		SELECT COUNT(*) FROM registrations
+		WHERE event_id = $1 AND registration_status IN ('registered', 'pending_payment');
+	`
+	if err := tx.QueryRow(ctx, countQuery, id).Scan(&change.Active); err != nil {
+		return model.CapacityChange{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if capacity != nil && *capacity < change.Previous && *capacity < change.Active {
+		return model.CapacityChange{}, fmt.Errorf("%s: %w", op, storage.ErrCapacityBelowRegs)
 	}
 
-	return nil
+	if err := tx.QueryRow(ctx, query, args...).Scan(&change.Version, &change.Capacity); err != nil {
+		return model.CapacityChange{}, fmt.Errorf("%s: %w", op, dbError(err))
+	}
+
+	if err := announceSeats(ctx, tx, change, announce); err != nil {
+		return model.CapacityChange{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return model.CapacityChange{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return change, nil
 }
 
-func (s *PostgresStorage) RegisterForEvent(ctx context.Context, memberID uuid.UUID, eventID int) (string, error) {
+// missingOrStale explains why a conditional write touched no rows: either the row is gone
+// (notFound) or it has moved past the expected version.
+func (s *PostgresStorage) missingOrStale(ctx context.Context, op, existsQuery string, id any, notFound error) error {
+	var exists bool
+	if err := s.db.QueryRow(ctx, existsQuery, id).Scan(&exists); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if !exists {
+		return notFound
+	}
+
+	return fmt.Errorf("%s: %w", op, storage.ErrVersionMismatch)
+}
+
+// RegisterForEvent takes a seat at the event for the member. The seat of a paid event is
+// only held, in status pending_payment, until holdUntil. The event row is locked for share
+// while the seats are counted; every change of the capacity locks the row for update before
+// counting, so it waits for the registration and counts it. A member already holding a seat gets ErrRegAlreadyExists, full event or not.
+func (s *PostgresStorage) RegisterForEvent(ctx context.Context, memberID uuid.UUID, eventID int, holdUntil time.Time) (string, error) {
 	const op = "infra.storage.postgres.RegisterForEvent"
+	defer s.observe(op, time.Now())
 
 	query := `
 	WITH event_data AS (
 		SELECT 
 			e.capacity,
-			(SELECT COUNT(*) FROM registrations r WHERE r.event_id = e.id AND r.registration_status = 'registered') AS current_count
+			CASE WHEN e.price IS NULL THEN 'registered' ELSE 'pending_payment' END AS new_status,
+			CASE WHEN e.price IS NULL THEN NULL ELSE $3::timestamptz END AS expires_at,
+			(SELECT COUNT(*) FROM registrations r WHERE r.event_id = e.id AND r.registration_status IN ('registered', 'pending_payment')) AS current_count
 		FROM events e
 		WHERE e.id = $1
+		FOR SHARE OF e
 	),
 	upd AS (
 		UPDATE registrations
-		SET registration_status = 'registered'
+		SET registration_status = event_data.new_status, payment_expires_at = event_data.expires_at,
+		    registered_at = CURRENT_TIMESTAMP, bumped_at = NULL
 		FROM event_data
 		WHERE registrations.user_id = $2
 		  AND registrations.event_id = $1
@@ -642,8 +960,8 @@ func (s *PostgresStorage) RegisterForEvent(ctx context.Context, memberID uuid.UU
 		RETURNING registrations.registration_status
 	),
 	ins AS (
-		INSERT INTO registrations(user_id, event_id, registration_status)
-		SELECT $2, $1, 'registered'
+		INSERT INTO registrations(user_id, event_id, registration_status, payment_expires_at)
+		SELECT $2, $1, event_data.new_status, event_data.expires_at
 		FROM event_data
 		WHERE event_data.current_count < event_data.capacity
 		  AND NOT EXISTS (
@@ -658,17 +976,14 @@ func (s *PostgresStorage) RegisterForEvent(ctx context.Context, memberID uuid.UU
 	`
 
 	var status string
-	err := s.db.QueryRowContext(ctx, query, eventID, memberID).Scan(&status)
+	err := s.db.QueryRow(ctx, query, eventID, memberID, holdUntil).Scan(&status)
 	if err != nil {
-		var pqErr *pq.Error
-		if errors.As(err, &pqErr) {
-			if pqErr.Code == "23505" {
-				return "", fmt.Errorf("%s: %w", op, storage.ErrRegAlreadyExists)
-			}
+		if errors.Is(dbError(err), storage.ErrRegAlreadyExists) {
+			return "", fmt.Errorf("%s: %w", op, storage.ErrRegAlreadyExists)
 		}
 
 		var exists bool
-		errEvent := s.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM events WHERE id = $1)", eventID).Scan(&exists)
+		errEvent := s.db.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM events WHERE id = $1)", eventID).Scan(&exists)
 		if errEvent != nil {
 			return "", fmt.Errorf("%s: %w", op, errEvent)
 		}
@@ -676,7 +991,7 @@ func (s *PostgresStorage) RegisterForEvent(ctx context.Context, memberID uuid.UU
 			return "", fmt.Errorf("%s: %w", op, storage.ErrEventNotFound)
 		}
 
-		errMember := s.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM club_members WHERE id = $1)", memberID).Scan(&exists)
+		errMember := s.db.QueryRow(ctx, memberExistsQuery, memberID).Scan(&exists)
 		if errMember != nil {
 			return "", fmt.Errorf("%s: %w", op, errMember)
 		}
@@ -684,7 +999,20 @@ func (s *PostgresStorage) RegisterForEvent(ctx context.Context, memberID uuid.UU
 			return "", fmt.Errorf("%s: %w", op, storage.ErrMemberNotFound)
 		}
 
-		if errors.Is(err, sql.ErrNoRows) {
+		if errors.Is(err, pgx.ErrNoRows) {
+			activeQuery := `
+				SELECT EXISTS(
+				    SELECT 1 FROM registrations
+				    WHERE user_id = $1 AND event_id = $2 AND registration_status IN ('registered', 'pending_payment')
+				);
+			`
+			if errActive := s.db.QueryRow(ctx, activeQuery, memberID, eventID).Scan(&exists); errActive != nil {
+				return "", fmt.Errorf("%s: %w", op, errActive)
+			}
+			if exists {
+				return "", fmt.Errorf("%s: %w", op, storage.ErrRegAlreadyExists)
+			}
+
 			return "", fmt.Errorf("%s: %w", op, storage.ErrEventFull)
 		}
 
@@ -694,30 +1022,53 @@ func (s *PostgresStorage) RegisterForEvent(ctx context.Context, memberID uuid.UU
 	return status, nil
 }
 
-func (s *PostgresStorage) CancelRegistration(ctx context.Context, memberID uuid.UUID, eventID int) (string, error) {
+// CancelRegistration cancels the member's registration. A payment still pending is dropped
+// and a paid one is marked for refund if the event starts after refundAfter; that payment
+// is returned so the caller can settle it with the provider.
+func (s *PostgresStorage) CancelRegistration(ctx context.Context, memberID uuid.UUID, eventID int, refundAfter time.Time) (string, *model.EventPayment, error) {
 	const op = "infra.storage.postgres.CancelRegistration"
+	defer s.observe(op, time.Now())
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return "", nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
 
 	query := `
 		UPDATE registrations
-		SET registration_status = 'cancelled'
+		SET registration_status = 'cancelled', payment_expires_at = NULL, bumped_at = NULL
 		WHERE user_id = $1 AND event_id = $2
-		RETURNING registration_status;
+		RETURNING id, registration_status;
 	`
 
-	var status string
-	err := s.db.QueryRowContext(ctx, query, memberID, eventID).Scan(&status)
+	var (
+		regID  int
+		status string
+	)
+	err = tx.QueryRow(ctx, query, memberID, eventID).Scan(&regID, &status)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return "", storage.ErrRegNotFound
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil, storage.ErrRegNotFound
 		}
-		return "", fmt.Errorf("%s: %w", op, err)
+		return "", nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return status, nil
+	payment, err := settleCancelledPayment(ctx, tx, regID, refundAfter)
+	if err != nil {
+		return "", nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return "", nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return status, payment, nil
 }
 
 func (s *PostgresStorage) GetRegistrationStatus(ctx context.Context, memberID uuid.UUID, eventID int) (string, error) {
 	const op = "infra.storage.postgres.GetRegistrationStatus"
+	defer s.observe(op, time.Now())
 
 	query := `
 		SELECT registration_status
@@ -726,9 +1077,9 @@ func (s *PostgresStorage) GetRegistrationStatus(ctx context.Context, memberID uu
 	`
 
 	var status string
-	err := s.db.QueryRowContext(ctx, query, memberID, eventID).Scan(&status)
+	err := s.reader().QueryRow(ctx, query, memberID, eventID).Scan(&status)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+		if errors.Is(err, pgx.ErrNoRows) {
 			return "", storage.ErrRegNotFound
 		}
 		return "", fmt.Errorf("%s: %w", op, err)
@@ -737,10 +1088,94 @@ func (s *PostgresStorage) GetRegistrationStatus(ctx context.Context, memberID uu
 	return status, nil
 }
 
-func (s *PostgresStorage) GetEventTypes(ctx context.Context) ([]model.EventType, error) {
+func (s *PostgresStorage) GetEventRoster(ctx context.Context, eventID int) ([]model.Member, error) {
+	const op = "infra.storage.postgres.GetEventRoster"
+	defer s.observe(op, time.Now())
+
+	query := `
+		SELECT m.id, m.full_name, m.email, m.phone, m.status, m.created_at, m.updated_at
+		FROM registrations r
+		JOIN club_members m ON r.user_id = m.id
+		WHERE r.event_id = $1 AND r.registration_status = 'registered' AND m.erased_at IS NULL
+		ORDER BY r.created_at ASC;
+	`
+
+	rows, err := s.reader().Query(ctx, query, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var members []model.Member
+	for rows.Next() {
+		var m model.Member
+		if err := rows.Scan(&m.ID, &m.FullName, &m.Email, &m.Phone, &m.Status, &m.CreatedAt, &m.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		members = append(members, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return members, nil
+}
+
+// StreamEventRoster calls fn for every member with an active registration for the event,
+// in registration order, without loading the whole result into memory.
+func (s *PostgresStorage) StreamEventRoster(ctx context.Context, eventID int, fn func(model.RosterEntry) error) error {
+	const op = "infra.storage.postgres.StreamEventRoster"
+	defer s.observe(op, time.Now())
+
+	query := `
+		SELECT m.id, m.full_name, m.email, m.phone, m.status, m.created_at, m.updated_at, r.created_at
+		FROM registrations r
+		JOIN club_members m ON r.user_id = m.id
+		WHERE r.event_id = $1 AND r.registration_status = 'registered' AND m.erased_at IS NULL
+		ORDER BY r.created_at ASC;
+	`
+
+	rows, err := s.db.Query(ctx, query, eventID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var e model.RosterEntry
+		err := rows.Scan(
+			&e.Member.ID, &e.Member.FullName, &e.Member.Email, &e.Member.Phone, &e.Member.Status,
+			&e.Member.CreatedAt, &e.Member.UpdatedAt, &e.RegisteredAt,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := fn(e); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetEventTypes lists event types with their name and description in lang, falling back
+// to the main record for types that have no translation.
+func (s *PostgresStorage) GetEventTypes(ctx context.Context, lang string) ([]model.EventType, error) {
 	const op = "infra.storage.postgres.GetEventTypes"
+	defer s.observe(op, time.Now())
 
-	rows, err := s.db.QueryContext(ctx, "SELECT id, name, description FROM event_types")
+	query := `
+		SELECT et.id, COALESCE(t.name, et.name), COALESCE(t.description, et.description), et.version
+		FROM event_types et
+		LEFT JOIN event_type_translations t ON t.event_type_id = et.id AND t.lang = $1;
+	`
+
+	rows, err := s.reader().Query(ctx, query, lang)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -749,7 +1184,7 @@ func (s *PostgresStorage) GetEventTypes(ctx context.Context) ([]model.EventType,
 	var types []model.EventType
 	for rows.Next() {
 		var et model.EventType
-		if err := rows.Scan(&et.ID, &et.Name, &et.Description); err != nil {
+		if err := rows.Scan(&et.ID, &et.Name, &et.Description, &et.Version); err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
 		types = append(types, et)
@@ -762,10 +1197,19 @@ func (s *PostgresStorage) GetEventTypes(ctx context.Context) ([]model.EventType,
 	return types, nil
 }
 
-func (s *PostgresStorage) GetLocations(ctx context.Context) ([]model.Location, error) {
+// GetLocations lists locations described in lang, falling back to the main record for
+// locations that have no translation.
+func (s *PostgresStorage) GetLocations(ctx context.Context, lang string) ([]model.Location, error) {
 	const op = "infra.storage.postgres.GetLocations"
+	defer s.observe(op, time.Now())
+
+	query := `
+		SELECT l.id, COALESCE(t.name, l.name), COALESCE(t.route, l.route), COALESCE(t.features, l.features), l.version
+		FROM locations l
+		LEFT JOIN location_translations t ON t.location_id = l.id AND t.lang = $1;
+	`
 
-	rows, err := s.db.QueryContext(ctx, "SELECT id, name, route, features FROM locations")
+	rows, err := s.reader().Query(ctx, query, lang)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -774,7 +1218,7 @@ func (s *PostgresStorage) GetLocations(ctx context.Context) ([]model.Location, e
 	var locations []model.Location
 	for rows.Next() {
 		var loc model.Location
-		if err := rows.Scan(&loc.ID, &loc.Name, &loc.Route, &loc.Features); err != nil {
+		if err := rows.Scan(&loc.ID, &loc.Name, &loc.Route, &loc.Features, &loc.Version); err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
 		locations = append(locations, loc)
@@ -789,17 +1233,18 @@ func (s *PostgresStorage) GetLocations(ctx context.Context) ([]model.Location, e
 
 func (s *PostgresStorage) GetLocation(ctx context.Context, id int) (model.Location, error) {
 	const op = "infra.storage.postgres.GetLocation"
+	defer s.observe(op, time.Now())
 
 	query := `
-		SELECT id, name, route, features
+		SELECT id, name, route, features, version
 		FROM locations
 		WHERE id = $1;
 	`
 
 	var loc model.Location
-	err := s.db.QueryRowContext(ctx, query, id).Scan(&loc.ID, &loc.Name, &loc.Route, &loc.Features)
+	err := s.reader().QueryRow(ctx, query, id).Scan(&loc.ID, &loc.Name, &loc.Route, &loc.Features, &loc.Version)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+		if errors.Is(err, pgx.ErrNoRows) {
 			return model.Location{}, fmt.Errorf("%s: %w", op, storage.ErrLocationNotFound)
 		}
 		return model.Location{}, fmt.Errorf("%s: %w", op, err)
@@ -810,17 +1255,18 @@ func (s *PostgresStorage) GetLocation(ctx context.Context, id int) (model.Locati
 
 func (s *PostgresStorage) GetEventType(ctx context.Context, id int) (model.EventType, error) {
 	const op = "infra.storage.postgres.GetEventType"
+	defer s.observe(op, time.Now())
 
 	query := `
-		SELECT id, name, description
+		SELECT id, name, description, version
 		FROM event_types
 		WHERE id = $1;
 	`
 
 	var et model.EventType
-	err := s.db.QueryRowContext(ctx, query, id).Scan(&et.ID, &et.Name, &et.Description)
+	err := s.reader().QueryRow(ctx, query, id).Scan(&et.ID, &et.Name, &et.Description, &et.Version)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+		if errors.Is(err, pgx.ErrNoRows) {
 			return model.EventType{}, fmt.Errorf("%s: %w", op, storage.ErrEventTypeNotFound)
 		}
 		return model.EventType{}, fmt.Errorf("%s: %w", op, err)
@@ -831,20 +1277,17 @@ func (s *PostgresStorage) GetEventType(ctx context.Context, id int) (model.Event
 
 func (s *PostgresStorage) GetOrchestraInfo(ctx context.Context, key string) (model.OrchestraInfo, error) {
 	const op = "infra.storage.postgres.GetOrchestraInfo"
-	stmt, err := s.db.PrepareContext(ctx, "SELECT key, value FROM orchestra_info WHERE key = $1;")
-	if err != nil {
-		return model.OrchestraInfo{}, fmt.Errorf("%s: %w", op, err)
-	}
-	defer stmt.Close()
+	defer s.observe(op, time.Now())
+	query := "SELECT key, value FROM orchestra_info WHERE key = $1;"
 
 	var info model.OrchestraInfo
-	err = stmt.QueryRowContext(ctx, key).Scan(
+	err := s.reader().QueryRow(ctx, query, key).Scan(
 		&info.Key,
 		&info.Value,
 	)
 
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+		if errors.Is(err, pgx.ErrNoRows) {
 			return model.OrchestraInfo{}, storage.ErrInfoNotFound
 		}
 		return model.OrchestraInfo{}, fmt.Errorf("%s: %w", op, err)
@@ -855,6 +1298,7 @@ func (s *PostgresStorage) GetOrchestraInfo(ctx context.Context, key string) (mod
 
 func (s *PostgresStorage) AddEventType(ctx context.Context, name, description string) (id int, err error) {
 	const op = "infra.storage.postgres.AddEventType"
+	defer s.observe(op, time.Now())
 
 	query := `
 		INSERT INTO event_types (name, description)
@@ -862,9 +1306,9 @@ func (s *PostgresStorage) AddEventType(ctx context.Context, name, description st
 		RETURNING id;
 	`
 
-	err = s.db.QueryRowContext(ctx, query, name, description).Scan(&id)
+	err = s.db.QueryRow(ctx, query, name, description).Scan(&id)
 	if err != nil {
-		return 0, fmt.Errorf("%s: %w", op, err)
+		return 0, fmt.Errorf("%s: %w", op, dbError(err))
 	}
 
 	return id, nil
@@ -872,6 +1316,7 @@ func (s *PostgresStorage) AddEventType(ctx context.Context, name, description st
 
 func (s *PostgresStorage) AddLocation(ctx context.Context, name, route, features string) (id int, err error) {
 	const op = "infra.storage.postgres.AddLocation"
+	defer s.observe(op, time.Now())
 
 	query := `
 		INSERT INTO locations (name, route, features)
@@ -879,9 +1324,9 @@ func (s *PostgresStorage) AddLocation(ctx context.Context, name, route, features
 		RETURNING id;
 	`
 
-	err = s.db.QueryRowContext(ctx, query, name, route, features).Scan(&id)
+	err = s.db.QueryRow(ctx, query, name, route, features).Scan(&id)
 	if err != nil {
-		return 0, fmt.Errorf("%s: %w", op, err)
+		return 0, fmt.Errorf("%s: %w", op, dbError(err))
 	}
 
 	return id, nil
@@ -889,15 +1334,16 @@ func (s *PostgresStorage) AddLocation(ctx context.Context, name, route, features
 
 func (s *PostgresStorage) AddOrchestraInfo(ctx context.Context, key, value string) error {
 	const op = "infra.storage.postgres.AddOrchestraInfo"
+	defer s.observe(op, time.Now())
 
 	query := `
-		INSERT INTO orchestra_info (info_key, info_value)
+		INSERT INTO orchestra_info (key, value)
 		VALUES ($1, $2)
-		ON CONFLICT (info_key)
-		DO UPDATE SET info_value = EXCLUDED.info_value;
+		ON CONFLICT (key)
+		DO UPDATE SET value = EXCLUDED.value;
 	`
 
-	_, err := s.db.ExecContext(ctx, query, key, value)
+	_, err := s.db.Exec(ctx, query, key, value)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
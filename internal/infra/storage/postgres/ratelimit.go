@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/ratelimit"
+	"github.com/jackc/pgx/v5"
+	"time"
+)
+
+// Take implements ratelimit.Store on top of the rate_limit_buckets table, so every
+// replica sees the same buckets. The bucket is refilled and a token is taken in one
+// statement; a rejected request leaves the row untouched.
+func (s *PostgresStorage) Take(ctx context.Context, key string, bucket ratelimit.Bucket) (ratelimit.Decision, error) {
+	const op = "infra.storage.postgres.Take"
+	defer s.observe(op, time.Now())
+
+	query := `
+		INSERT INTO rate_limit_buckets AS b (key, tokens, updated_at)
+		VALUES ($1, $2::double precision - 1, now())
+		ON CONFLICT (key) DO UPDATE
+		SET tokens = LEAST($2::double precision, b.tokens + EXTRACT(EPOCH FROM (now() - b.updated_at))::double precision * $3::double precision) - 1,
+		    updated_at = now()
+		WHERE LEAST($2::double precision, b.tokens + EXTRACT(EPOCH FROM (now() - b.updated_at))::double precision * $3::double precision) >= 1
+		RETURNING tokens;
+	`
+
+	var tokens float64
+	err := s.db.QueryRow(ctx, query, key, bucket.Burst, bucket.Rate).Scan(&tokens)
+	if err == nil {
+		return ratelimit.Decision{Allowed: true}, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return ratelimit.Decision{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = s.db.QueryRow(ctx, `
+		SELECT LEAST($2::double precision, tokens + EXTRACT(EPOCH FROM (now() - updated_at))::double precision * $3::double precision)
+		FROM rate_limit_buckets
+		WHERE key = $1;
+	`, key, bucket.Burst, bucket.Rate).Scan(&tokens)
+	if err != nil {
+		return ratelimit.Decision{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return ratelimit.Decision{RetryAfter: bucket.RetryAfter(tokens)}, nil
+}
+
+// DeleteIdleRateLimitBuckets removes the buckets not touched since before and returns how
+// many were removed. A bucket idle that long is full again, so nothing is lost.
+func (s *PostgresStorage) DeleteIdleRateLimitBuckets(ctx context.Context, before time.Time) (int, error) {
+	const op = "infra.storage.postgres.DeleteIdleRateLimitBuckets"
+	defer s.observe(op, time.Now())
+
+	res, err := s.db.Exec(ctx, "DELETE FROM rate_limit_buckets WHERE updated_at < $1;", before)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected := res.RowsAffected()
+
+	return int(affected), nil
+}
@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"time"
+)
+
+// ClaimDueReminders picks the reminders that are due and claims them for this replica.
+// For every active registration only the nearest offset the event has come within is due,
+// and only if the registration was made before that reminder's time, so a late sign-up does
+// not get a reminder meant for days ahead. The sign-up time is registered_at: updated_at
+// also moves when a paid registration is confirmed or the row is touched for other reasons.
+// Registrations being claimed elsewhere are skipped. A reminder claimed earlier but not
+// sent is claimed again once its claim is older than retryBefore, up to maxAttempts
+// attempts. Reminders are keyed by the event date, so moving the event schedules them anew.
+func (s *PostgresStorage) ClaimDueReminders(ctx context.Context, offsets []time.Duration, retryBefore time.Time, maxAttempts, limit int) ([]model.Reminder, error) {
+	const op = "infra.storage.postgres.ClaimDueReminders"
+	defer s.observe(op, time.Now())
+
+	seconds := make([]int64, 0, len(offsets))
+	for _, offset := range offsets {
+		seconds = append(seconds, int64(offset.Seconds()))
+	}
+
+	query := `
+		WITH offsets AS (
+			SELECT unnest($1::bigint[]) AS seconds
+		),
+		due AS (
+			SELECT r.id AS registration_id, o.seconds, e.event_date
+			FROM registrations r
+			JOIN events e ON e.id = r.event_id
+			JOIN club_members m ON m.id = r.user_id
+			JOIN offsets o ON o.seconds = (
+				SELECT min(seconds) FROM offsets
+				WHERE make_interval(secs => seconds) >= e.event_date - CURRENT_TIMESTAMP
+			)
+			WHERE r.registration_status = 'registered'
+			  AND e.event_date > CURRENT_TIMESTAMP
+			  AND e.event_date - make_interval(secs => o.seconds) >= r.registered_at
+			  AND m.erased_at IS NULL AND (m.notify_email OR m.notify_sms)
+			  AND NOT EXISTS (
+				SELECT 1 FROM event_reminders er
+				WHERE er.registration_id = r.id AND er.offset_seconds = o.seconds AND er.event_date = e.event_date
+				  AND (er.sent_at IS NOT NULL OR er.claimed_at > $2 OR er.attempts >= $3)
+			  )
+			ORDER BY e.event_date
+			LIMIT $4
+			FOR UPDATE OF r SKIP LOCKED
+		)
+		INSERT INTO event_reminders AS er (registration_id, offset_seconds, event_date)
+		SELECT registration_id, seconds, event_date FROM due
+		ON CONFLICT (registration_id, offset_seconds, event_date)
+		DO UPDATE SET attempts = er.attempts + 1, claimed_at = CURRENT_TIMESTAMP
+		WHERE er.sent_at IS NULL AND er.claimed_at <= $2 AND er.attempts < $3
+		RETURNING er.id;
+	`
+
+	rows, err := s.db.Query(ctx, query, seconds, retryBefore, maxAttempts, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	reminders, err := s.getReminders(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return reminders, nil
+}
+
+// getReminders loads the claimed reminders with the member and the event, the location
+// named in the member's language where there is a translation.
+func (s *PostgresStorage) getReminders(ctx context.Context, ids []int64) ([]model.Reminder, error) {
+	query := `
+		SELECT er.id, er.registration_id, er.offset_seconds, er.attempts,
+		       m.id, m.full_name, m.email, m.phone, COALESCE(m.language, ''), m.notify_email, m.notify_sms,
+		       e.id, e.title, er.event_date, COALESCE(t.name, l.name)
+		FROM event_reminders er
+		JOIN registrations r ON r.id = er.registration_id
+		JOIN club_members m ON m.id = r.user_id
+		JOIN events e ON e.id = r.event_id
+		JOIN locations l ON l.id = e.location
+		LEFT JOIN location_translations t ON t.location_id = l.id AND t.lang = m.language
+		WHERE er.id = ANY($1)
+		ORDER BY er.event_date, er.id;
+	`
+
+	rows, err := s.db.Query(ctx, query, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reminders := make([]model.Reminder, 0, len(ids))
+	for rows.Next() {
+		var r model.Reminder
+		var offset int64
+		err := rows.Scan(
+			&r.ID, &r.RegistrationID, &offset, &r.Attempts,
+			&r.MemberID, &r.FullName, &r.Email, &r.Phone, &r.Language, &r.Notifications.Email, &r.Notifications.SMS,
+			&r.EventID, &r.EventTitle, &r.EventDate, &r.Location,
+		)
+		if err != nil {
+			return nil, err
+		}
+		r.Offset = time.Duration(offset) * time.Second
+		reminders = append(reminders, r)
+	}
+
+	return reminders, rows.Err()
+}
+
+// MarkReminderSent records that the reminder has been delivered.
+func (s *PostgresStorage) MarkReminderSent(ctx context.Context, id int) error {
+	const op = "infra.storage.postgres.MarkReminderSent"
+	defer s.observe(op, time.Now())
+
+	_, err := s.db.Exec(ctx, "UPDATE event_reminders SET sent_at = CURRENT_TIMESTAMP WHERE id = $1;", id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/storage"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"time"
+)
+
+// SaveVerificationCode stores a new code for the channel, replacing the previous one
+// and resetting its attempt counter. A previous code created after replaceBefore is
+// kept and storage.ErrCodeRecentlySent is returned.
+func (s *PostgresStorage) SaveVerificationCode(ctx context.Context, code model.VerificationCode, replaceBefore time.Time) error {
+	const op = "infra.storage.postgres.SaveVerificationCode"
+	defer s.observe(op, time.Now())
+
+	query := `
+		INSERT INTO verification_codes (member_id, channel, code_hash, attempts, expires_at)
+		VALUES ($1, $2, $3, 0, $4)
+		ON CONFLICT (member_id, channel)
+		DO UPDATE SET code_hash = EXCLUDED.code_hash, attempts = 0, expires_at = EXCLUDED.expires_at, created_at = CURRENT_TIMESTAMP
+		WHERE verification_codes.created_at < $5;
+	`
+
+	res, err := s.db.Exec(ctx, query, code.MemberID, code.Channel, code.CodeHash, code.ExpiresAt, replaceBefore)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, dbError(err))
+	}
+
+	affected := res.RowsAffected()
+	if affected == 0 {
+		return storage.ErrCodeRecentlySent
+	}
+
+	return nil
+}
+
+// UseVerificationCode counts an attempt against the member's code and returns the code
+// with the updated counter. Counting before the comparison keeps concurrent guesses
+// within the attempt limit.
+func (s *PostgresStorage) UseVerificationCode(ctx context.Context, memberID uuid.UUID, channel model.VerificationChannel) (model.VerificationCode, error) {
+	const op = "infra.storage.postgres.UseVerificationCode"
+	defer s.observe(op, time.Now())
+
+	query := `
+		UPDATE verification_codes
+		SET attempts = attempts + 1
+		WHERE member_id = $1 AND channel = $2
+		RETURNING member_id, channel, code_hash, attempts, expires_at, created_at;
+	`
+
+	var code model.VerificationCode
+	err := s.db.QueryRow(ctx, query, memberID, channel).Scan(
+		&code.MemberID,
+		&code.Channel,
+		&code.CodeHash,
+		&code.Attempts,
+		&code.ExpiresAt,
+		&code.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.VerificationCode{}, storage.ErrCodeNotFound
+		}
+		return model.VerificationCode{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return code, nil
+}
+
+// ConfirmVerification marks the channel as verified and removes the used code.
+func (s *PostgresStorage) ConfirmVerification(ctx context.Context, memberID uuid.UUID, channel model.VerificationChannel, at time.Time) error {
+	const op = "infra.storage.postgres.ConfirmVerification"
+	defer s.observe(op, time.Now())
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	column := "email_verified_at"
+	if channel == model.ChannelPhone {
+		column = "phone_verified_at"
+	}
+
+	res, err := tx.Exec(ctx, "UPDATE club_members SET "+column+" = $1 WHERE id = $2 AND erased_at IS NULL;", at, memberID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected := res.RowsAffected()
+	if affected == 0 {
+		return storage.ErrMemberNotFound
+	}
+
+	if _, err := tx.Exec(ctx, "DELETE FROM verification_codes WHERE member_id = $1 AND channel = $2;", memberID, channel); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
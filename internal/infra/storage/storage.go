@@ -16,4 +16,22 @@ var (
 	ErrPhoneDuplicate    = errors.New("phone number already exists")
 	ErrInvalidEmail      = errors.New("invalid email format")
 	ErrInvalidPhone      = errors.New("invalid phone number format")
+	ErrCodeNotFound      = errors.New("verification code not found")
+	ErrCodeRecentlySent  = errors.New("verification code was sent recently")
+	ErrVersionMismatch   = errors.New("version mismatch")
+	ErrTierDowngrade     = errors.New("lower tier while a higher one is active")
+	ErrPaymentDuplicate  = errors.New("payment reference already exists")
+	ErrPaymentNotFound   = errors.New("event payment not found")
+	ErrJobNotFound       = errors.New("job not found")
+	ErrJobNotDead        = errors.New("job is not dead")
+	ErrEventNotStarted   = errors.New("event has not started")
+	ErrEventTypeExists   = errors.New("event type already exists")
+	ErrLocationExists    = errors.New("location already exists")
+	ErrEventInPast       = errors.New("event date is in the past")
+	ErrInvalidPrice      = errors.New("invalid price")
+	ErrInvalidAmount     = errors.New("invalid amount")
+	ErrDuplicate         = errors.New("duplicate value")
+	ErrReferenceNotFound = errors.New("referenced row not found")
+	ErrCheckViolation    = errors.New("check constraint violated")
+	ErrCapacityBelowRegs = errors.New("capacity below active registrations")
 )
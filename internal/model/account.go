@@ -0,0 +1,49 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// NotificationPreferences says through which channels the member agrees to be notified.
+type NotificationPreferences struct {
+	Email bool
+	SMS   bool
+}
+
+// MemberRegistration is a registration as the member sees it, including cancelled ones.
+type MemberRegistration struct {
+	EventID      int
+	Title        string
+	EventDate    time.Time
+	Location     string
+	Status       string
+	RegisteredAt time.Time
+	UpdatedAt    time.Time
+}
+
+// CodePurpose is what a code sent to the member's email confirms.
+type CodePurpose string
+
+const (
+	CodeErasure CodePurpose = "erasure"
+	CodeExport  CodePurpose = "export"
+)
+
+// AccountCode is a pending request of the member, such as to erase or to export their data.
+// It is carried out once the member confirms it with the code sent to their email.
+type AccountCode struct {
+	MemberID  uuid.UUID
+	Purpose   CodePurpose
+	CodeHash  string
+	Attempts  int
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+// MemberData is everything stored about a member, as handed over by a data export.
+type MemberData struct {
+	Member        Member
+	Registrations []MemberRegistration
+	ExportedAt    time.Time
+}
@@ -0,0 +1,176 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"math"
+	"time"
+)
+
+// Interval is the length of the periods a time series is split into.
+type Interval string
+
+const (
+	IntervalDay   Interval = "day"
+	IntervalWeek  Interval = "week"
+	IntervalMonth Interval = "month"
+)
+
+func (i Interval) Valid() bool {
+	switch i {
+	case IntervalDay, IntervalWeek, IntervalMonth:
+		return true
+	default:
+		return false
+	}
+}
+
+// Next returns the start of the period following the one starting at t.
+func (i Interval) Next(t time.Time) time.Time {
+	switch i {
+	case IntervalDay:
+		return t.AddDate(0, 0, 1)
+	case IntervalWeek:
+		return t.AddDate(0, 0, 7)
+	default:
+		return t.AddDate(0, 1, 0)
+	}
+}
+
+// DateRange bounds an analytics query: From is included, To is not.
+type DateRange struct {
+	From time.Time
+	To   time.Time
+}
+
+// RegistrationCounts are the registrations of one or more events by outcome. Attended and
+// NoShow only cover events whose attendance was recorded.
+type RegistrationCounts struct {
+	Registered int
+	Pending    int
+	Cancelled  int
+	Attended   int
+	NoShow     int
+}
+
+// FillRate is the share of the seats taken, held seats included.
+func (c RegistrationCounts) FillRate(capacity int) *float64 {
+	return ratio(c.Registered+c.Pending, capacity)
+}
+
+// CancellationRate is the share of the registrations that were cancelled.
+func (c RegistrationCounts) CancellationRate() *float64 {
+	return ratio(c.Cancelled, c.Registered+c.Pending+c.Cancelled)
+}
+
+// NoShowRate is the share of the registered members who did not come, among events with
+// recorded attendance.
+func (c RegistrationCounts) NoShowRate() *float64 {
+	return ratio(c.NoShow, c.Attended+c.NoShow)
+}
+
+// ratio returns n/d rounded to four places, or nil when there is nothing to divide by.
+func ratio(n, d int) *float64 {
+	if d <= 0 {
+		return nil
+	}
+	r := math.Round(float64(n)/float64(d)*1e4) / 1e4
+	return &r
+}
+
+// EventStats are the registration figures of one event. SoldOutAt is when the last seat was
+// taken; the event may have free seats again since.
+type EventStats struct {
+	EventID            int
+	Title              string
+	EventDate          time.Time
+	EventType          int
+	Location           int
+	Capacity           int
+	CreatedAt          time.Time
+	SoldOutAt          *time.Time
+	AttendanceRecorded bool
+	Counts             RegistrationCounts
+}
+
+// TimeToSellout is how long after its creation the event sold out.
+func (s EventStats) TimeToSellout() *time.Duration {
+	if s.SoldOutAt == nil {
+		return nil
+	}
+	d := s.SoldOutAt.Sub(s.CreatedAt)
+	return &d
+}
+
+// GroupStats sum up the events of one event type or location.
+type GroupStats struct {
+	ID               int
+	Name             string
+	Events           int
+	Capacity         int
+	SoldOut          int
+	AvgTimeToSellout *time.Duration
+	Counts           RegistrationCounts
+}
+
+// GrowthPoint is the change of the membership in the period starting at Start. Members is
+// the number of approved members at the end of the period. Decisions made before their time
+// was recorded are in no period; such members count from their application.
+type GrowthPoint struct {
+	Start    time.Time
+	Applied  int
+	Approved int
+	Declined int
+	Members  int
+}
+
+// Funnel follows the members who applied in a date range through the club: verification,
+// the decision on the application, the first registration and the first attended event.
+// UndatedDecisions are the approved and declined applications decided before the time of
+// decisions was recorded; MedianDecision leaves them out.
+type Funnel struct {
+	Applied          int
+	Verified         int
+	Pending          int
+	Approved         int
+	Declined         int
+	UndatedDecisions int
+	Registered       int
+	Attended         int
+	MedianDecision   *time.Duration
+}
+
+// ActiveMember is a member ranked by the events they registered for in a date range.
+type ActiveMember struct {
+	MemberID      uuid.UUID
+	FullName      string
+	Registrations int
+	Attended      int
+	Cancelled     int
+	LastEventDate *time.Time
+}
+
+// ClubSummary is the dashboard of the club: the membership now and the events in a date range.
+type ClubSummary struct {
+	Members       map[MemberStatus]int
+	NewMembers    int
+	Events        int
+	Capacity      int
+	SoldOutEvents int
+	Counts        RegistrationCounts
+}
+
+// AttendanceReport is the outcome of recording who came to an event. NotRegistered lists
+// the members marked as present who have no active registration for it.
+type AttendanceReport struct {
+	Attended      int
+	NoShow        int
+	NotRegistered []uuid.UUID
+}
+
+// ClubGauges are the figures of the club exported as metrics: the applications awaiting a
+// decision and the free seats over the events that have not started yet.
+type ClubGauges struct {
+	PendingMembers int
+	UpcomingEvents int
+	SeatsLeft      int
+}
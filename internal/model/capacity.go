@@ -0,0 +1,58 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// CapacityChange is the outcome of a write that may change the capacity of an event.
+// Previous and Active are the capacity and the active registrations before the write. Bumped
+// are the members whose registrations were cancelled to fit a forced shrink, latest
+// registered first, and Payments are their payments now to be cancelled or refunded.
+type CapacityChange struct {
+	EventID  int
+	Version  int
+	Previous int
+	Capacity int
+	Active   int
+	Bumped   []uuid.UUID
+	Payments []EventPayment
+}
+
+// FreedSeats is how many seats the change opened up: those above both the old capacity and
+// the registrations the event already had.
+func (c CapacityChange) FreedSeats() int {
+	return max(0, c.Capacity-max(c.Previous, c.Active))
+}
+
+// SeatsAnnouncer makes the jobs that announce what a capacity change did to the seats of the
+// event. They are enqueued in the transaction of the change, so both commit or neither does.
+type SeatsAnnouncer func(CapacityChange) ([]Job, error)
+
+// SeatsBumped is announced when a forced shrink of an event cancelled registrations.
+type SeatsBumped struct {
+	EventID  int
+	Members  []uuid.UUID
+	Payments []EventPayment
+}
+
+// SeatsFreed is announced when a raise of the capacity of an event opened up seats.
+type SeatsFreed struct {
+	EventID int
+	Seats   int
+}
+
+// SeatNotice is a member to tell about a change of the seats at an event: the member's
+// contacts and the event it is about.
+type SeatNotice struct {
+	MemberID      uuid.UUID
+	FullName      string
+	Email         string
+	Phone         string
+	Language      string
+	Notifications NotificationPreferences
+	EventID       int
+	EventTitle    string
+	EventDate     time.Time
+	Location      string
+}
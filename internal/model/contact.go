@@ -0,0 +1,127 @@
+package model
+
+import (
+	"golang.org/x/net/idna"
+	"strings"
+)
+
+// nationalLengths holds the allowed [min, max] length of the national number for
+// calling codes we see in practice. Numbers with other calling codes are rejected;
+// a country is added here once its members show up.
+var nationalLengths = map[string][2]int{
+	"1":   {10, 10}, // US, Canada
+	"7":   {10, 10}, // Russia, Kazakhstan
+	"20":  {9, 10},  // Egypt
+	"30":  {10, 10}, // Greece
+	"31":  {9, 9},   // Netherlands
+	"32":  {8, 9},   // Belgium
+	"33":  {9, 9},   // France
+	"34":  {9, 9},   // Spain
+	"36":  {8, 9},   // Hungary
+	"39":  {6, 11},  // Italy
+	"40":  {9, 9},   // Romania
+	"41":  {9, 9},   // Switzerland
+	"43":  {4, 13},  // Austria
+	"44":  {10, 10}, // United Kingdom
+	"45":  {8, 8},   // Denmark
+	"46":  {7, 9},   // Sweden
+	"47":  {8, 8},   // Norway
+	"48":  {9, 9},   // Poland
+	"49":  {6, 11},  // Germany
+	"52":  {10, 10}, // Mexico
+	"54":  {10, 11}, // Argentina
+	"55":  {10, 11}, // Brazil
+	"61":  {9, 9},   // Australia
+	"81":  {9, 10},  // Japan
+	"82":  {8, 10},  // South Korea
+	"84":  {9, 10},  // Vietnam
+	"86":  {11, 11}, // China
+	"90":  {10, 10}, // Turkey
+	"91":  {10, 10}, // India
+	"351": {9, 9},   // Portugal
+	"353": {7, 9},   // Ireland
+	"358": {6, 10},  // Finland
+	"359": {8, 9},   // Bulgaria
+	"370": {8, 8},   // Lithuania
+	"371": {8, 8},   // Latvia
+	"372": {7, 8},   // Estonia
+	"373": {8, 8},   // Moldova
+	"374": {8, 8},   // Armenia
+	"375": {9, 9},   // Belarus
+	"380": {9, 9},   // Ukraine
+	"381": {8, 9},   // Serbia
+	"420": {9, 9},   // Czech Republic
+	"421": {9, 9},   // Slovakia
+	"972": {8, 9},   // Israel
+	"992": {9, 9},   // Tajikistan
+	"993": {8, 8},   // Turkmenistan
+	"994": {9, 9},   // Azerbaijan
+	"995": {9, 9},   // Georgia
+	"996": {9, 9},   // Kyrgyzstan
+	"998": {9, 9},   // Uzbekistan
+}
+
+// NormalizePhone parses a phone number as people type it and returns it in E.164
+// form. International numbers must start with + or 00; numbers without a prefix
+// are read as russian ones (8xxxxxxxxxx, 7xxxxxxxxxx or a bare 9xxxxxxxxx).
+func NormalizePhone(phone string) (string, bool) {
+	phone = strings.TrimSpace(phone)
+	international := strings.HasPrefix(phone, "+")
+
+	var digits strings.Builder
+	for _, r := range phone {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case r == ' ' || r == '-' || r == '(' || r == ')' || r == '.' || r == '/' || r == '\u00a0':
+		case r == '+' && digits.Len() == 0:
+		default:
+			return "", false
+		}
+	}
+
+	number := digits.String()
+	switch {
+	case international:
+	case strings.HasPrefix(number, "00"):
+		number = number[2:]
+	case strings.HasPrefix(number, "810") && len(number) > 11:
+		number = number[3:]
+	case len(number) == 11 && (number[0] == '8' || number[0] == '7'):
+		number = "7" + number[1:]
+	case len(number) == 10 && number[0] == '9':
+		number = "7" + number
+	default:
+		return "", false
+	}
+
+	number = "+" + number
+	if !IsValidPhone(number) {
+		return "", false
+	}
+
+	return number, true
+}
+
+// NormalizeEmail lowercases the address and converts an internationalized domain
+// to its ASCII (punycode) form, so the same mailbox is always stored the same way.
+func NormalizeEmail(email string) (string, bool) {
+	email = strings.ToLower(strings.TrimSpace(email))
+
+	at := strings.LastIndex(email, "@")
+	if at <= 0 || at == len(email)-1 {
+		return "", false
+	}
+
+	domain, err := idna.Lookup.ToASCII(email[at+1:])
+	if err != nil {
+		return "", false
+	}
+
+	email = email[:at+1] + domain
+	if !IsValidEmail(email) {
+		return "", false
+	}
+
+	return email, true
+}
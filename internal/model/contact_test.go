@@ -0,0 +1,43 @@
+package model
+
+import "testing"
+
+func TestNormalizePhone(t *testing.T) {
+	tests := []struct {
+		name   string
+		phone  string
+		want   string
+		wantOK bool
+	}{
+		{"international russian", "+7 (912) 345-67-89", "+79123456789", true},
+		{"russian with 8", "8 912 345 67 89", "+79123456789", true},
+		{"russian with 7", "79123456789", "+79123456789", true},
+		{"bare russian mobile", "912.345.67.89", "+79123456789", true},
+		{"surrounding spaces", "  +79123456789 ", "+79123456789", true},
+		{"united kingdom", "+44 20 7946 0958", "+442079460958", true},
+		{"00 prefix", "0044 20 7946 0958", "+442079460958", true},
+		{"810 prefix", "810 44 20 7946 0958", "+442079460958", true},
+		{"three digit calling code", "+375 (29) 123-45-67", "+375291234567", true},
+		{"united states", "+1 415/555-2671", "+14155552671", true},
+
+		{"empty", "", "", false},
+		{"letters", "+7 912 ABC 67 89", "", false},
+		{"plus inside", "7+9123456789", "", false},
+		{"no international prefix", "442079460958", "", false},
+		{"too short for its country", "+7 912 345 67 8", "", false},
+		{"too long for its country", "+44 20 7946 09581", "", false},
+		{"unknown calling code", "+888 1234 5678", "", false},
+		{"unassigned calling code", "+69 1234 5678", "", false},
+		{"calling code zero", "+0 123 456 789", "", false},
+		{"over the e.164 length", "+7 912 345 67 89 01 23 45", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := NormalizePhone(tt.phone)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("NormalizePhone(%q) = %q, %v, want %q, %v", tt.phone, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package model
 
 import "time"
 
+// Event is a club event. Price is the fee in kopecks, zero for a free event.
 type Event struct {
 	ID          int
 	Title       string
@@ -10,6 +11,9 @@ type Event struct {
 	EventDate   time.Time
 	Location    Location
 	Capacity    int
+	Price       int64
+	Access      EventAccess
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	Version     int
 }
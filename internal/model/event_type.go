@@ -4,4 +4,5 @@ type EventType struct {
 	ID          int
 	Name        string
 	Description string
+	Version     int
 }
@@ -0,0 +1,49 @@
+package model
+
+import "time"
+
+// Check is the outcome of one readiness check. Detail explains a failure or qualifies a pass,
+// e.g. with the schema version found.
+type Check struct {
+	Name    string
+	OK      bool
+	Latency time.Duration
+	Detail  string
+}
+
+// Readiness tells whether the replica should receive traffic: it is ready when every check passed.
+type Readiness struct {
+	Ready  bool
+	Checks []Check
+}
+
+// BuildInfo describes the running binary. Revision and BuildTime are empty when the binary
+// was built without version control information.
+type BuildInfo struct {
+	Version   string
+	GoVersion string
+	Revision  string
+	BuildTime string
+}
+
+// Status is the diagnostic report of the replica.
+type Status struct {
+	Build     BuildInfo
+	StartedAt time.Time
+	Uptime    time.Duration
+	Draining  bool
+	Config    map[string]any
+	Readiness Readiness
+	Pool      PoolStats
+}
+
+// PoolStats is the state of the database connection pool. MaxOpen is zero when the pool is
+// not limited.
+type PoolStats struct {
+	MaxOpen      int
+	Open         int
+	InUse        int
+	Idle         int
+	WaitCount    int64
+	WaitDuration time.Duration
+}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// IdempotentResponse is the stored outcome of a request made with an Idempotency-Key.
+// Completed is false while the first request is still being handled. Location and ETag are
+// the headers of the response replayed along with its body.
+type IdempotentResponse struct {
+	Key         string
+	Fingerprint string
+	Completed   bool
+	StatusCode  int
+	ContentType string
+	Location    string
+	ETag        string
+	Body        []byte
+	ExpiresAt   time.Time
+}
@@ -0,0 +1,90 @@
+package model
+
+// ImportOutcome is the per-row verdict of a bulk member or payment import.
+type ImportOutcome string
+
+const (
+	ImportOK             ImportOutcome = "ok"
+	ImportMissingFields  ImportOutcome = "missing_fields"
+	ImportInvalidEmail   ImportOutcome = "invalid_email"
+	ImportInvalidPhone   ImportOutcome = "invalid_phone"
+	ImportUnknownStatus  ImportOutcome = "unknown_status"
+	ImportDuplicateEmail ImportOutcome = "duplicate_email"
+	ImportDuplicatePhone ImportOutcome = "duplicate_phone"
+
+	ImportUnknownMember      ImportOutcome = "unknown_member"
+	ImportUnknownTier        ImportOutcome = "unknown_tier"
+	ImportInvalidAmount      ImportOutcome = "invalid_amount"
+	ImportInvalidDate        ImportOutcome = "invalid_date"
+	ImportDuplicateReference ImportOutcome = "duplicate_reference"
+	ImportTierDowngrade      ImportOutcome = "tier_downgrade"
+)
+
+// ImportRow is one line of an import file as supplied by the caller.
+type ImportRow struct {
+	Line     int
+	FullName string
+	Email    string
+	Phone    string
+	Status   MemberStatus
+}
+
+type ImportResult struct {
+	Line    int
+	Member  Member
+	Outcome ImportOutcome
+}
+
+type ImportReport struct {
+	DryRun    bool
+	Committed bool
+	Results   []ImportResult
+}
+
+// Failed returns the number of rows that can not be imported.
+func (r ImportReport) Failed() int {
+	failed := 0
+	for _, res := range r.Results {
+		if res.Outcome != ImportOK {
+			failed++
+		}
+	}
+	return failed
+}
+
+// PaymentImportRow is one line of a dues import file as supplied by the caller. The member is
+// named by MemberID or, failing that, by Email; Amount is in roubles.
+type PaymentImportRow struct {
+	Line      int
+	MemberID  string
+	Email     string
+	Tier      string
+	Amount    string
+	PaidAt    string
+	Reference string
+	Note      string
+}
+
+type PaymentImportResult struct {
+	Line    int
+	Email   string
+	Payment MembershipPayment
+	Outcome ImportOutcome
+}
+
+type PaymentImportReport struct {
+	DryRun    bool
+	Committed bool
+	Results   []PaymentImportResult
+}
+
+// Failed returns the number of rows that can not be imported.
+func (r PaymentImportReport) Failed() int {
+	failed := 0
+	for _, res := range r.Results {
+		if res.Outcome != ImportOK {
+			failed++
+		}
+	}
+	return failed
+}
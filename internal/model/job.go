@@ -0,0 +1,51 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type JobStatus string
+
+const (
+	JobPending   JobStatus = "pending"
+	JobRunning   JobStatus = "running"
+	JobSucceeded JobStatus = "succeeded"
+	JobDead      JobStatus = "dead"
+)
+
+func (s JobStatus) Valid() bool {
+	switch s {
+	case JobPending, JobRunning, JobSucceeded, JobDead:
+		return true
+	default:
+		return false
+	}
+}
+
+// Job is a unit of background work in the queue. Kind selects the handler and Payload is
+// its argument as JSON. A failed job goes back to pending with RunAt moved forward until it
+// has used MaxAttempts, then it is dead.
+type Job struct {
+	ID          int64
+	Kind        string
+	Payload     json.RawMessage
+	Status      JobStatus
+	Attempts    int
+	MaxAttempts int
+	RunAt       time.Time
+	DedupeKey   string
+	LockedBy    string
+	LockedAt    *time.Time
+	LastError   string
+	FinishedAt  *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// JobFilter narrows a job listing. Empty fields match any job.
+type JobFilter struct {
+	Status JobStatus
+	Kind   string
+	Limit  int
+}
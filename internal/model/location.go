@@ -5,4 +5,5 @@ type Location struct {
 	Name     string
 	Route    string
 	Features string
+	Version  int
 }
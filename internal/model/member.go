@@ -15,21 +15,47 @@ const (
 )
 
 type Member struct {
-	ID        uuid.UUID
-	FullName  string
-	Email     string
-	Phone     string
-	Status    MemberStatus
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID              uuid.UUID
+	FullName        string
+	Email           string
+	Phone           string
+	Status          MemberStatus
+	EmailVerifiedAt *time.Time
+	PhoneVerifiedAt *time.Time
+	Language        string
+	Notifications   NotificationPreferences
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	Version         int
+}
+
+// MemberFilter narrows a member listing. Nil verification flags match any member.
+type MemberFilter struct {
+	Status        MemberStatus
+	EmailVerified *bool
+	PhoneVerified *bool
 }
 
 func IsValidEmail(email string) bool {
-	re := regexp.MustCompile(`^[^@]+@[^@]+\.[^@]+$`)
+	re := regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
 	return re.MatchString(email)
 }
 
+// IsValidPhone reports whether phone is an E.164 number with a known calling code whose
+// national part has a length allowed for its country.
 func IsValidPhone(phone string) bool {
-	re := regexp.MustCompile(`^7\d{10}$`)
-	return re.MatchString(phone)
+	re := regexp.MustCompile(`^\+[1-9]\d{6,14}$`)
+	if !re.MatchString(phone) {
+		return false
+	}
+
+	digits := phone[1:]
+	for n := 3; n >= 1; n-- {
+		if lengths, ok := nationalLengths[digits[:n]]; ok {
+			national := len(digits) - n
+			return national >= lengths[0] && national <= lengths[1]
+		}
+	}
+
+	return false
 }
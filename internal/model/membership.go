@@ -0,0 +1,156 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// Tier is the level of a paid membership. Members without a running membership have no tier.
+type Tier string
+
+const (
+	TierBasic      Tier = "basic"
+	TierPatron     Tier = "patron"
+	TierBenefactor Tier = "benefactor"
+)
+
+// Rank orders tiers: a higher tier is admitted wherever a lower one is. The empty tier and
+// unknown tiers rank below basic.
+func (t Tier) Rank() int {
+	switch t {
+	case TierBasic:
+		return 1
+	case TierPatron:
+		return 2
+	case TierBenefactor:
+		return 3
+	default:
+		return 0
+	}
+}
+
+func (t Tier) Valid() bool {
+	return t.Rank() > 0
+}
+
+type MembershipStatus string
+
+const (
+	MembershipNone    MembershipStatus = "none"
+	MembershipActive  MembershipStatus = "active"
+	MembershipExpired MembershipStatus = "expired"
+)
+
+// Membership is the current membership of a member. ExpiredAt is set by the expiry job once
+// ExpiresAt has passed and cleared by the next payment.
+type Membership struct {
+	MemberID  uuid.UUID
+	Tier      Tier
+	StartsAt  time.Time
+	ExpiresAt time.Time
+	ExpiredAt *time.Time
+}
+
+// ActiveTier returns the tier in effect at now, or the empty tier when the membership is not
+// running.
+func (m Membership) ActiveTier(now time.Time) Tier {
+	if m.Tier == "" || now.Before(m.StartsAt) || !now.Before(m.ExpiresAt) {
+		return ""
+	}
+	return m.Tier
+}
+
+func (m Membership) Status(now time.Time) MembershipStatus {
+	switch {
+	case m.Tier == "":
+		return MembershipNone
+	case now.Before(m.ExpiresAt):
+		return MembershipActive
+	default:
+		return MembershipExpired
+	}
+}
+
+// Renew applies a yearly payment to the membership and returns the renewed membership and the
+// payment with its period filled in. Paying for the same tier extends a running membership by
+// a year, a higher tier starts a new year at once, and a lapsed membership restarts from the
+// payment date. A lower tier can not be bought while a higher one is running, so ok is false.
+func (m Membership) Renew(p MembershipPayment) (Membership, MembershipPayment, bool) {
+	start := p.PaidAt
+	if active := m.ActiveTier(p.PaidAt); active != "" {
+		switch {
+		case p.Tier.Rank() < active.Rank():
+			return m, p, false
+		case p.Tier == active:
+			start = m.ExpiresAt
+		}
+	}
+
+	p.PeriodStart = start
+	p.PeriodEnd = start.AddDate(1, 0, 0)
+
+	renewed := Membership{MemberID: p.MemberID, Tier: p.Tier, StartsAt: m.StartsAt, ExpiresAt: p.PeriodEnd}
+	if m.ActiveTier(p.PaidAt) != p.Tier {
+		renewed.StartsAt = p.PeriodStart
+	}
+
+	return renewed, p, true
+}
+
+type PaymentSource string
+
+const (
+	PaymentManual PaymentSource = "manual"
+	PaymentImport PaymentSource = "import"
+)
+
+// MembershipPayment is one yearly due. Amount is in kopecks; Reference identifies the payment
+// in the bank or payment system and is unique when set.
+type MembershipPayment struct {
+	ID          int
+	MemberID    uuid.UUID
+	Tier        Tier
+	Amount      int64
+	PaidAt      time.Time
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	Source      PaymentSource
+	Reference   string
+	Note        string
+	CreatedAt   time.Time
+}
+
+// RenewalEntry is a member whose membership has expired or expires soon.
+type RenewalEntry struct {
+	Member     Member
+	Membership Membership
+	LastPaidAt *time.Time
+}
+
+// AccessDenial says why a member may not register for an event.
+type AccessDenial string
+
+const (
+	AccessGranted        AccessDenial = ""
+	AccessTierRequired   AccessDenial = "tier_required"
+	AccessPriorityWindow AccessDenial = "priority_window"
+)
+
+// EventAccess limits registration for an event. MinTier closes the event to members below
+// it; until PriorityUntil only members of PriorityTier and above may register.
+type EventAccess struct {
+	MinTier       Tier
+	PriorityTier  Tier
+	PriorityUntil *time.Time
+}
+
+// Check tells whether a member holding tier may register at now.
+func (a EventAccess) Check(tier Tier, now time.Time) AccessDenial {
+	if a.MinTier != "" && tier.Rank() < a.MinTier.Rank() {
+		return AccessTierRequired
+	}
+	if a.PriorityTier != "" && a.PriorityUntil != nil && now.Before(*a.PriorityUntil) && tier.Rank() < a.PriorityTier.Rank() {
+		return AccessPriorityWindow
+	}
+	return AccessGranted
+}
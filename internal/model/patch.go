@@ -0,0 +1,75 @@
+package model
+
+import "time"
+
+// EventPatch is a partial event update. Nil fields are left untouched.
+type EventPatch struct {
+	Title       *string
+	Description *string
+	EventType   *int
+	EventDate   *time.Time
+	Location    *int
+	Capacity    *int
+}
+
+// Empty reports whether the patch changes nothing.
+func (p EventPatch) Empty() bool {
+	return p.Title == nil && p.Description == nil && p.EventType == nil &&
+		p.EventDate == nil && p.Location == nil && p.Capacity == nil
+}
+
+// Apply returns e with the provided fields replaced.
+func (p EventPatch) Apply(e Event) Event {
+	if p.Title != nil {
+		e.Title = *p.Title
+	}
+	if p.Description != nil {
+		e.Description = *p.Description
+	}
+	if p.EventType != nil {
+		e.EventType = EventType{ID: *p.EventType}
+	}
+	if p.EventDate != nil {
+		e.EventDate = *p.EventDate
+	}
+	if p.Location != nil {
+		e.Location = Location{ID: *p.Location}
+	}
+	if p.Capacity != nil {
+		e.Capacity = *p.Capacity
+	}
+
+	return e
+}
+
+// MemberProfilePatch is a partial member profile update. Nil fields are left untouched;
+// an empty Language clears the preference.
+type MemberProfilePatch struct {
+	FullName *string
+	Email    *string
+	Phone    *string
+	Language *string
+}
+
+// Empty reports whether the patch changes nothing.
+func (p MemberProfilePatch) Empty() bool {
+	return p.FullName == nil && p.Email == nil && p.Phone == nil && p.Language == nil
+}
+
+// Apply returns m with the provided fields replaced.
+func (p MemberProfilePatch) Apply(m Member) Member {
+	if p.FullName != nil {
+		m.FullName = *p.FullName
+	}
+	if p.Email != nil {
+		m.Email = *p.Email
+	}
+	if p.Phone != nil {
+		m.Phone = *p.Phone
+	}
+	if p.Language != nil {
+		m.Language = *p.Language
+	}
+
+	return m
+}
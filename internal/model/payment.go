@@ -0,0 +1,52 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	RegistrationRegistered     = "registered"
+	RegistrationPendingPayment = "pending_payment"
+	RegistrationCancelled      = "cancelled"
+)
+
+type PaymentStatus string
+
+const (
+	PaymentPending       PaymentStatus = "pending"
+	PaymentSucceeded     PaymentStatus = "succeeded"
+	PaymentCanceled      PaymentStatus = "canceled"
+	PaymentRefundPending PaymentStatus = "refund_pending"
+	PaymentRefunded      PaymentStatus = "refunded"
+)
+
+// EventPayment is the payment for a seat at a paid event. Amount is in kopecks; ExternalID
+// and ConfirmationURL are assigned by the provider once the payment is created there, and
+// ExpiresAt is when the seat held by an unpaid registration is released.
+type EventPayment struct {
+	ID                 int
+	RegistrationID     int
+	MemberID           uuid.UUID
+	EventID            int
+	EventTitle         string
+	Provider           string
+	ExternalID         string
+	Amount             int64
+	Status             PaymentStatus
+	ConfirmationURL    string
+	RefundID           string
+	RegistrationStatus string
+	ExpiresAt          *time.Time
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// PaymentNotification is a webhook from the payment provider whose signature has been checked.
+// Key identifies the notification itself, so a redelivered notification is recognised.
+type PaymentNotification struct {
+	Provider   string
+	Key        string
+	ExternalID string
+	Status     PaymentStatus
+}
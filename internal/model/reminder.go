@@ -0,0 +1,25 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// Reminder is a reminder claimed for sending: the registered member and the event it is
+// about. Offset is how long before the event it was due.
+type Reminder struct {
+	ID             int
+	RegistrationID int
+	Offset         time.Duration
+	Attempts       int
+	MemberID       uuid.UUID
+	FullName       string
+	Email          string
+	Phone          string
+	Language       string
+	Notifications  NotificationPreferences
+	EventID        int
+	EventTitle     string
+	EventDate      time.Time
+	Location       string
+}
@@ -0,0 +1,9 @@
+package model
+
+import "time"
+
+// RosterEntry is a member with an active registration for an event.
+type RosterEntry struct {
+	Member       Member
+	RegisteredAt time.Time
+}
@@ -0,0 +1,23 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type VerificationChannel string
+
+const (
+	ChannelEmail VerificationChannel = "email"
+	ChannelPhone VerificationChannel = "phone"
+)
+
+// VerificationCode is a pending one-time code. Only the hash of the code is stored.
+type VerificationCode struct {
+	MemberID  uuid.UUID
+	Channel   VerificationChannel
+	CodeHash  string
+	Attempts  int
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
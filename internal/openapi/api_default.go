@@ -23,30 +23,37 @@ import (
 // DefaultAPIService DefaultAPI service
 type DefaultAPIService service
 
-type ApiEventsAvailableGetRequest struct {
+type ApiAnalyticsEventTypesGetRequest struct {
 	ctx        context.Context
 	ApiService *DefaultAPIService
-	memberId   *string
+	dateFrom   *time.Time
+	dateTo     *time.Time
 }
 
-// UUID участника
-func (r ApiEventsAvailableGetRequest) MemberId(memberId string) ApiEventsAvailableGetRequest {
-	r.memberId = &memberId
+// Начало периода (ISO 8601), включительно. По умолчанию - год назад.
+func (r ApiAnalyticsEventTypesGetRequest) DateFrom(dateFrom time.Time) ApiAnalyticsEventTypesGetRequest {
+	r.dateFrom = &dateFrom
 	return r
 }
 
-func (r ApiEventsAvailableGetRequest) Execute() ([]EventResponse, *http.Response, error) {
-	return r.ApiService.EventsAvailableGetExecute(r)
+// Конец периода (ISO 8601), не включительно. По умолчанию для событий - через год, для участников - текущий момент.
+func (r ApiAnalyticsEventTypesGetRequest) DateTo(dateTo time.Time) ApiAnalyticsEventTypesGetRequest {
+	r.dateTo = &dateTo
+	return r
+}
+
+func (r ApiAnalyticsEventTypesGetRequest) Execute() ([]GroupStatsResponse, *http.Response, error) {
+	return r.ApiService.AnalyticsEventTypesGetExecute(r)
 }
 
 /*
-EventsAvailableGet События, доступные для регистрации конкретному участнику
+AnalyticsEventTypesGet Показатели по типам событий
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiEventsAvailableGetRequest
+	@return ApiAnalyticsEventTypesGetRequest
 */
-func (a *DefaultAPIService) EventsAvailableGet(ctx context.Context) ApiEventsAvailableGetRequest {
-	return ApiEventsAvailableGetRequest{
+func (a *DefaultAPIService) AnalyticsEventTypesGet(ctx context.Context) ApiAnalyticsEventTypesGetRequest {
+	return ApiAnalyticsEventTypesGetRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -54,30 +61,32 @@ func (a *DefaultAPIService) EventsAvailableGet(ctx context.Context) ApiEventsAva
 
 // Execute executes the request
 //
-//	@return []EventResponse
-func (a *DefaultAPIService) EventsAvailableGetExecute(r ApiEventsAvailableGetRequest) ([]EventResponse, *http.Response, error) {
+//	@return []GroupStatsResponse
+func (a *DefaultAPIService) AnalyticsEventTypesGetExecute(r ApiAnalyticsEventTypesGetRequest) ([]GroupStatsResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue []EventResponse
+		localVarReturnValue []GroupStatsResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.EventsAvailableGet")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.AnalyticsEventTypesGet")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/events/available"
+	localVarPath := localBasePath + "/analytics/event-types"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-	if r.memberId == nil {
-		return localVarReturnValue, nil, reportError("memberId is required and must be specified")
-	}
 
-	parameterAddToHeaderOrQuery(localVarQueryParams, "memberId", r.memberId, "")
+	if r.dateFrom != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "date_from", r.dateFrom, "")
+	}
+	if r.dateTo != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "date_to", r.dateTo, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -118,7 +127,7 @@ func (a *DefaultAPIService) EventsAvailableGetExecute(r ApiEventsAvailableGetReq
 			error: localVarHTTPResponse.Status,
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
-			var v ErrorResponse
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -128,8 +137,8 @@ func (a *DefaultAPIService) EventsAvailableGetExecute(r ApiEventsAvailableGetReq
 			newErr.model = v
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
-		if localVarHTTPResponse.StatusCode == 404 {
-			var v ErrorResponse
+		if localVarHTTPResponse.StatusCode == 422 {
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -140,7 +149,7 @@ func (a *DefaultAPIService) EventsAvailableGetExecute(r ApiEventsAvailableGetReq
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
-			var v ErrorResponse
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -164,51 +173,90 @@ func (a *DefaultAPIService) EventsAvailableGetExecute(r ApiEventsAvailableGetReq
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiEventsEventIdDeleteRequest struct {
+type ApiAnalyticsEventsGetRequest struct {
 	ctx        context.Context
 	ApiService *DefaultAPIService
-	eventId    int32
+	dateFrom   *time.Time
+	dateTo     *time.Time
+	type_      *int32
+	location   *int32
 }
 
-func (r ApiEventsEventIdDeleteRequest) Execute() (*http.Response, error) {
-	return r.ApiService.EventsEventIdDeleteExecute(r)
+// Начало периода (ISO 8601), включительно. По умолчанию - год назад.
+func (r ApiAnalyticsEventsGetRequest) DateFrom(dateFrom time.Time) ApiAnalyticsEventsGetRequest {
+	r.dateFrom = &dateFrom
+	return r
+}
+
+// Конец периода (ISO 8601), не включительно. По умолчанию для событий - через год, для участников - текущий момент.
+func (r ApiAnalyticsEventsGetRequest) DateTo(dateTo time.Time) ApiAnalyticsEventsGetRequest {
+	r.dateTo = &dateTo
+	return r
+}
+
+// ID типа события
+func (r ApiAnalyticsEventsGetRequest) Type_(type_ int32) ApiAnalyticsEventsGetRequest {
+	r.type_ = &type_
+	return r
+}
+
+// ID площадки
+func (r ApiAnalyticsEventsGetRequest) Location(location int32) ApiAnalyticsEventsGetRequest {
+	r.location = &location
+	return r
+}
+
+func (r ApiAnalyticsEventsGetRequest) Execute() ([]EventStatsResponse, *http.Response, error) {
+	return r.ApiService.AnalyticsEventsGetExecute(r)
 }
 
 /*
-EventsEventIdDelete Удаление события
+AnalyticsEventsGet Показатели событий
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@param eventId
-	@return ApiEventsEventIdDeleteRequest
+	@return ApiAnalyticsEventsGetRequest
 */
-func (a *DefaultAPIService) EventsEventIdDelete(ctx context.Context, eventId int32) ApiEventsEventIdDeleteRequest {
-	return ApiEventsEventIdDeleteRequest{
+func (a *DefaultAPIService) AnalyticsEventsGet(ctx context.Context) ApiAnalyticsEventsGetRequest {
+	return ApiAnalyticsEventsGetRequest{
 		ApiService: a,
 		ctx:        ctx,
-		eventId:    eventId,
 	}
 }
 
 // Execute executes the request
-func (a *DefaultAPIService) EventsEventIdDeleteExecute(r ApiEventsEventIdDeleteRequest) (*http.Response, error) {
+//
+//	@return []EventStatsResponse
+func (a *DefaultAPIService) AnalyticsEventsGetExecute(r ApiAnalyticsEventsGetRequest) ([]EventStatsResponse, *http.Response, error) {
 	var (
-		localVarHTTPMethod = http.MethodDelete
-		localVarPostBody   interface{}
-		formFiles          []formFile
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue []EventStatsResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.EventsEventIdDelete")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.AnalyticsEventsGet")
 	if err != nil {
-		return nil, &GenericOpenAPIError{error: err.Error()}
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/events/{eventId}"
-	localVarPath = strings.Replace(localVarPath, "{"+"eventId"+"}", url.PathEscape(parameterValueToString(r.eventId, "eventId")), -1)
+	localVarPath := localBasePath + "/analytics/events"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.dateFrom != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "date_from", r.dateFrom, "")
+	}
+	if r.dateTo != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "date_to", r.dateTo, "")
+	}
+	if r.type_ != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "type", r.type_, "")
+	}
+	if r.location != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "location", r.location, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -228,19 +276,19 @@ func (a *DefaultAPIService) EventsEventIdDeleteExecute(r ApiEventsEventIdDeleteR
 	}
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
-		return nil, err
+		return localVarReturnValue, nil, err
 	}
 
 	localVarHTTPResponse, err := a.client.callAPI(req)
 	if err != nil || localVarHTTPResponse == nil {
-		return localVarHTTPResponse, err
+		return localVarReturnValue, localVarHTTPResponse, err
 	}
 
 	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
 	if err != nil {
-		return localVarHTTPResponse, err
+		return localVarReturnValue, localVarHTTPResponse, err
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
@@ -248,81 +296,117 @@ func (a *DefaultAPIService) EventsEventIdDeleteExecute(r ApiEventsEventIdDeleteR
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
 		}
-		if localVarHTTPResponse.StatusCode == 404 {
-			var v ErrorResponse
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
-				return localVarHTTPResponse, newErr
+				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
 			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
 			newErr.model = v
-			return localVarHTTPResponse, newErr
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 422 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
-			var v ErrorResponse
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
-				return localVarHTTPResponse, newErr
+				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
 			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
 			newErr.model = v
 		}
-		return localVarHTTPResponse, newErr
+		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
 
-	return localVarHTTPResponse, nil
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiEventsEventIdGetRequest struct {
+type ApiAnalyticsLocationsGetRequest struct {
 	ctx        context.Context
 	ApiService *DefaultAPIService
-	eventId    int32
+	dateFrom   *time.Time
+	dateTo     *time.Time
 }
 
-func (r ApiEventsEventIdGetRequest) Execute() (*EventResponse, *http.Response, error) {
-	return r.ApiService.EventsEventIdGetExecute(r)
+// Начало периода (ISO 8601), включительно. По умолчанию - год назад.
+func (r ApiAnalyticsLocationsGetRequest) DateFrom(dateFrom time.Time) ApiAnalyticsLocationsGetRequest {
+	r.dateFrom = &dateFrom
+	return r
+}
+
+// Конец периода (ISO 8601), не включительно. По умолчанию для событий - через год, для участников - текущий момент.
+func (r ApiAnalyticsLocationsGetRequest) DateTo(dateTo time.Time) ApiAnalyticsLocationsGetRequest {
+	r.dateTo = &dateTo
+	return r
+}
+
+func (r ApiAnalyticsLocationsGetRequest) Execute() ([]GroupStatsResponse, *http.Response, error) {
+	return r.ApiService.AnalyticsLocationsGetExecute(r)
 }
 
 /*
-EventsEventIdGet Детали события по ID
+AnalyticsLocationsGet Показатели по площадкам
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@param eventId
-	@return ApiEventsEventIdGetRequest
+	@return ApiAnalyticsLocationsGetRequest
 */
-func (a *DefaultAPIService) EventsEventIdGet(ctx context.Context, eventId int32) ApiEventsEventIdGetRequest {
-	return ApiEventsEventIdGetRequest{
+func (a *DefaultAPIService) AnalyticsLocationsGet(ctx context.Context) ApiAnalyticsLocationsGetRequest {
+	return ApiAnalyticsLocationsGetRequest{
 		ApiService: a,
 		ctx:        ctx,
-		eventId:    eventId,
 	}
 }
 
 // Execute executes the request
 //
-//	@return EventResponse
-func (a *DefaultAPIService) EventsEventIdGetExecute(r ApiEventsEventIdGetRequest) (*EventResponse, *http.Response, error) {
+//	@return []GroupStatsResponse
+func (a *DefaultAPIService) AnalyticsLocationsGetExecute(r ApiAnalyticsLocationsGetRequest) ([]GroupStatsResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *EventResponse
+		localVarReturnValue []GroupStatsResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.EventsEventIdGet")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.AnalyticsLocationsGet")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/events/{eventId}"
-	localVarPath = strings.Replace(localVarPath, "{"+"eventId"+"}", url.PathEscape(parameterValueToString(r.eventId, "eventId")), -1)
+	localVarPath := localBasePath + "/analytics/locations"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.dateFrom != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "date_from", r.dateFrom, "")
+	}
+	if r.dateTo != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "date_to", r.dateTo, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -362,8 +446,19 @@ func (a *DefaultAPIService) EventsEventIdGetExecute(r ApiEventsEventIdGetRequest
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
 		}
-		if localVarHTTPResponse.StatusCode == 404 {
-			var v ErrorResponse
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 422 {
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -374,7 +469,7 @@ func (a *DefaultAPIService) EventsEventIdGetExecute(r ApiEventsEventIdGetRequest
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
-			var v ErrorResponse
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -398,65 +493,72 @@ func (a *DefaultAPIService) EventsEventIdGetExecute(r ApiEventsEventIdGetRequest
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiEventsEventIdPutRequest struct {
-	ctx                context.Context
-	ApiService         *DefaultAPIService
-	eventId            int32
-	updateEventRequest *UpdateEventRequest
+type ApiAnalyticsMembersFunnelGetRequest struct {
+	ctx        context.Context
+	ApiService *DefaultAPIService
+	dateFrom   *time.Time
+	dateTo     *time.Time
 }
 
-func (r ApiEventsEventIdPutRequest) UpdateEventRequest(updateEventRequest UpdateEventRequest) ApiEventsEventIdPutRequest {
-	r.updateEventRequest = &updateEventRequest
+// Начало периода (ISO 8601), включительно. По умолчанию - год назад.
+func (r ApiAnalyticsMembersFunnelGetRequest) DateFrom(dateFrom time.Time) ApiAnalyticsMembersFunnelGetRequest {
+	r.dateFrom = &dateFrom
 	return r
 }
 
-func (r ApiEventsEventIdPutRequest) Execute() (int32, *http.Response, error) {
-	return r.ApiService.EventsEventIdPutExecute(r)
+// Конец периода (ISO 8601), не включительно. По умолчанию для событий - через год, для участников - текущий момент.
+func (r ApiAnalyticsMembersFunnelGetRequest) DateTo(dateTo time.Time) ApiAnalyticsMembersFunnelGetRequest {
+	r.dateTo = &dateTo
+	return r
+}
+
+func (r ApiAnalyticsMembersFunnelGetRequest) Execute() (*FunnelResponse, *http.Response, error) {
+	return r.ApiService.AnalyticsMembersFunnelGetExecute(r)
 }
 
 /*
-EventsEventIdPut Обновление события
+AnalyticsMembersFunnelGet Воронка вступления в клуб
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@param eventId
-	@return ApiEventsEventIdPutRequest
+	@return ApiAnalyticsMembersFunnelGetRequest
 */
-func (a *DefaultAPIService) EventsEventIdPut(ctx context.Context, eventId int32) ApiEventsEventIdPutRequest {
-	return ApiEventsEventIdPutRequest{
+func (a *DefaultAPIService) AnalyticsMembersFunnelGet(ctx context.Context) ApiAnalyticsMembersFunnelGetRequest {
+	return ApiAnalyticsMembersFunnelGetRequest{
 		ApiService: a,
 		ctx:        ctx,
-		eventId:    eventId,
 	}
 }
 
 // Execute executes the request
 //
-//	@return int32
-func (a *DefaultAPIService) EventsEventIdPutExecute(r ApiEventsEventIdPutRequest) (int32, *http.Response, error) {
+//	@return FunnelResponse
+func (a *DefaultAPIService) AnalyticsMembersFunnelGetExecute(r ApiAnalyticsMembersFunnelGetRequest) (*FunnelResponse, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodPut
+		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue int32
+		localVarReturnValue *FunnelResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.EventsEventIdPut")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.AnalyticsMembersFunnelGet")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/events/{eventId}"
-	localVarPath = strings.Replace(localVarPath, "{"+"eventId"+"}", url.PathEscape(parameterValueToString(r.eventId, "eventId")), -1)
+	localVarPath := localBasePath + "/analytics/members/funnel"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-	if r.updateEventRequest == nil {
-		return localVarReturnValue, nil, reportError("updateEventRequest is required and must be specified")
-	}
 
+	if r.dateFrom != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "date_from", r.dateFrom, "")
+	}
+	if r.dateTo != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "date_to", r.dateTo, "")
+	}
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{"application/json"}
+	localVarHTTPContentTypes := []string{}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -472,8 +574,6 @@ func (a *DefaultAPIService) EventsEventIdPutExecute(r ApiEventsEventIdPutRequest
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	// body params
-	localVarPostBody = r.updateEventRequest
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -497,7 +597,7 @@ func (a *DefaultAPIService) EventsEventIdPutExecute(r ApiEventsEventIdPutRequest
 			error: localVarHTTPResponse.Status,
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
-			var v ErrorResponse
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -507,8 +607,8 @@ func (a *DefaultAPIService) EventsEventIdPutExecute(r ApiEventsEventIdPutRequest
 			newErr.model = v
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
-		if localVarHTTPResponse.StatusCode == 404 {
-			var v ErrorResponse
+		if localVarHTTPResponse.StatusCode == 422 {
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -519,7 +619,7 @@ func (a *DefaultAPIService) EventsEventIdPutExecute(r ApiEventsEventIdPutRequest
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
-			var v ErrorResponse
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -543,65 +643,80 @@ func (a *DefaultAPIService) EventsEventIdPutExecute(r ApiEventsEventIdPutRequest
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiEventsEventIdRegistrationDeleteRequest struct {
+type ApiAnalyticsMembersGrowthGetRequest struct {
 	ctx        context.Context
 	ApiService *DefaultAPIService
-	eventId    int32
-	memberId   *string
+	dateFrom   *time.Time
+	dateTo     *time.Time
+	interval   *string
 }
 
-// UUID участника
-func (r ApiEventsEventIdRegistrationDeleteRequest) MemberId(memberId string) ApiEventsEventIdRegistrationDeleteRequest {
-	r.memberId = &memberId
+// Начало периода (ISO 8601), включительно. По умолчанию - год назад.
+func (r ApiAnalyticsMembersGrowthGetRequest) DateFrom(dateFrom time.Time) ApiAnalyticsMembersGrowthGetRequest {
+	r.dateFrom = &dateFrom
 	return r
 }
 
-func (r ApiEventsEventIdRegistrationDeleteRequest) Execute() (*EventsEventIdRegistrationGet200Response, *http.Response, error) {
-	return r.ApiService.EventsEventIdRegistrationDeleteExecute(r)
+// Конец периода (ISO 8601), не включительно. По умолчанию для событий - через год, для участников - текущий момент.
+func (r ApiAnalyticsMembersGrowthGetRequest) DateTo(dateTo time.Time) ApiAnalyticsMembersGrowthGetRequest {
+	r.dateTo = &dateTo
+	return r
+}
+
+// Длина интервала; по умолчанию month
+func (r ApiAnalyticsMembersGrowthGetRequest) Interval(interval string) ApiAnalyticsMembersGrowthGetRequest {
+	r.interval = &interval
+	return r
+}
+
+func (r ApiAnalyticsMembersGrowthGetRequest) Execute() ([]GrowthPointResponse, *http.Response, error) {
+	return r.ApiService.AnalyticsMembersGrowthGetExecute(r)
 }
 
 /*
-EventsEventIdRegistrationDelete Отмена регистрации участника на событие
+AnalyticsMembersGrowthGet Рост клуба
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@param eventId
-	@return ApiEventsEventIdRegistrationDeleteRequest
+	@return ApiAnalyticsMembersGrowthGetRequest
 */
-func (a *DefaultAPIService) EventsEventIdRegistrationDelete(ctx context.Context, eventId int32) ApiEventsEventIdRegistrationDeleteRequest {
-	return ApiEventsEventIdRegistrationDeleteRequest{
+func (a *DefaultAPIService) AnalyticsMembersGrowthGet(ctx context.Context) ApiAnalyticsMembersGrowthGetRequest {
+	return ApiAnalyticsMembersGrowthGetRequest{
 		ApiService: a,
 		ctx:        ctx,
-		eventId:    eventId,
 	}
 }
 
 // Execute executes the request
 //
-//	@return EventsEventIdRegistrationGet200Response
-func (a *DefaultAPIService) EventsEventIdRegistrationDeleteExecute(r ApiEventsEventIdRegistrationDeleteRequest) (*EventsEventIdRegistrationGet200Response, *http.Response, error) {
+//	@return []GrowthPointResponse
+func (a *DefaultAPIService) AnalyticsMembersGrowthGetExecute(r ApiAnalyticsMembersGrowthGetRequest) ([]GrowthPointResponse, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodDelete
+		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *EventsEventIdRegistrationGet200Response
+		localVarReturnValue []GrowthPointResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.EventsEventIdRegistrationDelete")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.AnalyticsMembersGrowthGet")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/events/{eventId}/registration"
-	localVarPath = strings.Replace(localVarPath, "{"+"eventId"+"}", url.PathEscape(parameterValueToString(r.eventId, "eventId")), -1)
+	localVarPath := localBasePath + "/analytics/members/growth"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-	if r.memberId == nil {
-		return localVarReturnValue, nil, reportError("memberId is required and must be specified")
-	}
 
-	parameterAddToHeaderOrQuery(localVarQueryParams, "memberId", r.memberId, "")
+	if r.dateFrom != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "date_from", r.dateFrom, "")
+	}
+	if r.dateTo != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "date_to", r.dateTo, "")
+	}
+	if r.interval != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "interval", r.interval, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -641,8 +756,8 @@ func (a *DefaultAPIService) EventsEventIdRegistrationDeleteExecute(r ApiEventsEv
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
 		}
-		if localVarHTTPResponse.StatusCode == 404 {
-			var v ErrorResponse
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -652,8 +767,19 @@ func (a *DefaultAPIService) EventsEventIdRegistrationDeleteExecute(r ApiEventsEv
 			newErr.model = v
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
-		if localVarHTTPResponse.StatusCode == 500 {
-			var v ErrorResponse
+		if localVarHTTPResponse.StatusCode == 422 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -677,65 +803,80 @@ func (a *DefaultAPIService) EventsEventIdRegistrationDeleteExecute(r ApiEventsEv
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiEventsEventIdRegistrationGetRequest struct {
+type ApiAnalyticsMembersTopGetRequest struct {
 	ctx        context.Context
 	ApiService *DefaultAPIService
-	eventId    int32
-	memberId   *string
+	dateFrom   *time.Time
+	dateTo     *time.Time
+	limit      *int32
 }
 
-// UUID участника
-func (r ApiEventsEventIdRegistrationGetRequest) MemberId(memberId string) ApiEventsEventIdRegistrationGetRequest {
-	r.memberId = &memberId
+// Начало периода (ISO 8601), включительно. По умолчанию - год назад.
+func (r ApiAnalyticsMembersTopGetRequest) DateFrom(dateFrom time.Time) ApiAnalyticsMembersTopGetRequest {
+	r.dateFrom = &dateFrom
 	return r
 }
 
-func (r ApiEventsEventIdRegistrationGetRequest) Execute() (*EventsEventIdRegistrationGet200Response, *http.Response, error) {
-	return r.ApiService.EventsEventIdRegistrationGetExecute(r)
+// Конец периода (ISO 8601), не включительно. По умолчанию для событий - через год, для участников - текущий момент.
+func (r ApiAnalyticsMembersTopGetRequest) DateTo(dateTo time.Time) ApiAnalyticsMembersTopGetRequest {
+	r.dateTo = &dateTo
+	return r
+}
+
+// Сколько участников вернуть; по умолчанию 10
+func (r ApiAnalyticsMembersTopGetRequest) Limit(limit int32) ApiAnalyticsMembersTopGetRequest {
+	r.limit = &limit
+	return r
+}
+
+func (r ApiAnalyticsMembersTopGetRequest) Execute() ([]ActiveMemberResponse, *http.Response, error) {
+	return r.ApiService.AnalyticsMembersTopGetExecute(r)
 }
 
 /*
-EventsEventIdRegistrationGet Получение информации о регистрации участника на событие
+AnalyticsMembersTopGet Самые активные участники
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@param eventId
-	@return ApiEventsEventIdRegistrationGetRequest
+	@return ApiAnalyticsMembersTopGetRequest
 */
-func (a *DefaultAPIService) EventsEventIdRegistrationGet(ctx context.Context, eventId int32) ApiEventsEventIdRegistrationGetRequest {
-	return ApiEventsEventIdRegistrationGetRequest{
+func (a *DefaultAPIService) AnalyticsMembersTopGet(ctx context.Context) ApiAnalyticsMembersTopGetRequest {
+	return ApiAnalyticsMembersTopGetRequest{
 		ApiService: a,
 		ctx:        ctx,
-		eventId:    eventId,
 	}
 }
 
 // Execute executes the request
 //
-//	@return EventsEventIdRegistrationGet200Response
-func (a *DefaultAPIService) EventsEventIdRegistrationGetExecute(r ApiEventsEventIdRegistrationGetRequest) (*EventsEventIdRegistrationGet200Response, *http.Response, error) {
+//	@return []ActiveMemberResponse
+func (a *DefaultAPIService) AnalyticsMembersTopGetExecute(r ApiAnalyticsMembersTopGetRequest) ([]ActiveMemberResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *EventsEventIdRegistrationGet200Response
+		localVarReturnValue []ActiveMemberResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.EventsEventIdRegistrationGet")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.AnalyticsMembersTopGet")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/events/{eventId}/registration"
-	localVarPath = strings.Replace(localVarPath, "{"+"eventId"+"}", url.PathEscape(parameterValueToString(r.eventId, "eventId")), -1)
+	localVarPath := localBasePath + "/analytics/members/top"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-	if r.memberId == nil {
-		return localVarReturnValue, nil, reportError("memberId is required and must be specified")
-	}
 
-	parameterAddToHeaderOrQuery(localVarQueryParams, "memberId", r.memberId, "")
+	if r.dateFrom != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "date_from", r.dateFrom, "")
+	}
+	if r.dateTo != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "date_to", r.dateTo, "")
+	}
+	if r.limit != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "limit", r.limit, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -775,8 +916,19 @@ func (a *DefaultAPIService) EventsEventIdRegistrationGetExecute(r ApiEventsEvent
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
 		}
-		if localVarHTTPResponse.StatusCode == 404 {
-			var v ErrorResponse
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 422 {
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -787,7 +939,7 @@ func (a *DefaultAPIService) EventsEventIdRegistrationGetExecute(r ApiEventsEvent
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
-			var v ErrorResponse
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -811,65 +963,70 @@ func (a *DefaultAPIService) EventsEventIdRegistrationGetExecute(r ApiEventsEvent
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiEventsEventIdRegistrationPostRequest struct {
+type ApiAnalyticsSummaryGetRequest struct {
 	ctx        context.Context
 	ApiService *DefaultAPIService
-	eventId    int32
-	memberId   *string
+	dateFrom   *time.Time
+	dateTo     *time.Time
 }
 
-// UUID участника
-func (r ApiEventsEventIdRegistrationPostRequest) MemberId(memberId string) ApiEventsEventIdRegistrationPostRequest {
-	r.memberId = &memberId
+// Начало периода (ISO 8601), включительно. По умолчанию - год назад.
+func (r ApiAnalyticsSummaryGetRequest) DateFrom(dateFrom time.Time) ApiAnalyticsSummaryGetRequest {
+	r.dateFrom = &dateFrom
 	return r
 }
 
-func (r ApiEventsEventIdRegistrationPostRequest) Execute() (*EventsEventIdRegistrationGet200Response, *http.Response, error) {
-	return r.ApiService.EventsEventIdRegistrationPostExecute(r)
+// Конец периода (ISO 8601), не включительно. По умолчанию для событий - через год, для участников - текущий момент.
+func (r ApiAnalyticsSummaryGetRequest) DateTo(dateTo time.Time) ApiAnalyticsSummaryGetRequest {
+	r.dateTo = &dateTo
+	return r
+}
+
+func (r ApiAnalyticsSummaryGetRequest) Execute() (*ClubSummaryResponse, *http.Response, error) {
+	return r.ApiService.AnalyticsSummaryGetExecute(r)
 }
 
 /*
-EventsEventIdRegistrationPost Регистрация участника на событие
+AnalyticsSummaryGet Сводка по клубу
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@param eventId ID события
-	@return ApiEventsEventIdRegistrationPostRequest
+	@return ApiAnalyticsSummaryGetRequest
 */
-func (a *DefaultAPIService) EventsEventIdRegistrationPost(ctx context.Context, eventId int32) ApiEventsEventIdRegistrationPostRequest {
-	return ApiEventsEventIdRegistrationPostRequest{
+func (a *DefaultAPIService) AnalyticsSummaryGet(ctx context.Context) ApiAnalyticsSummaryGetRequest {
+	return ApiAnalyticsSummaryGetRequest{
 		ApiService: a,
 		ctx:        ctx,
-		eventId:    eventId,
 	}
 }
 
 // Execute executes the request
 //
-//	@return EventsEventIdRegistrationGet200Response
-func (a *DefaultAPIService) EventsEventIdRegistrationPostExecute(r ApiEventsEventIdRegistrationPostRequest) (*EventsEventIdRegistrationGet200Response, *http.Response, error) {
+//	@return ClubSummaryResponse
+func (a *DefaultAPIService) AnalyticsSummaryGetExecute(r ApiAnalyticsSummaryGetRequest) (*ClubSummaryResponse, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodPost
+		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *EventsEventIdRegistrationGet200Response
+		localVarReturnValue *ClubSummaryResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.EventsEventIdRegistrationPost")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.AnalyticsSummaryGet")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/events/{eventId}/registration"
-	localVarPath = strings.Replace(localVarPath, "{"+"eventId"+"}", url.PathEscape(parameterValueToString(r.eventId, "eventId")), -1)
+	localVarPath := localBasePath + "/analytics/summary"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-	if r.memberId == nil {
-		return localVarReturnValue, nil, reportError("memberId is required and must be specified")
-	}
 
-	parameterAddToHeaderOrQuery(localVarQueryParams, "memberId", r.memberId, "")
+	if r.dateFrom != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "date_from", r.dateFrom, "")
+	}
+	if r.dateTo != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "date_to", r.dateTo, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -909,8 +1066,8 @@ func (a *DefaultAPIService) EventsEventIdRegistrationPostExecute(r ApiEventsEven
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
 		}
-		if localVarHTTPResponse.StatusCode == 404 {
-			var v ErrorResponse
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -920,8 +1077,8 @@ func (a *DefaultAPIService) EventsEventIdRegistrationPostExecute(r ApiEventsEven
 			newErr.model = v
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
-		if localVarHTTPResponse.StatusCode == 400 {
-			var v ErrorResponse
+		if localVarHTTPResponse.StatusCode == 422 {
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -932,7 +1089,7 @@ func (a *DefaultAPIService) EventsEventIdRegistrationPostExecute(r ApiEventsEven
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
-			var v ErrorResponse
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -956,44 +1113,30 @@ func (a *DefaultAPIService) EventsEventIdRegistrationPostExecute(r ApiEventsEven
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiEventsGetRequest struct {
+type ApiEventsAvailableGetRequest struct {
 	ctx        context.Context
 	ApiService *DefaultAPIService
-	type_      *int32
-	dateFrom   *time.Time
-	dateTo     *time.Time
-}
-
-// Фильтр по типу события
-func (r ApiEventsGetRequest) Type_(type_ int32) ApiEventsGetRequest {
-	r.type_ = &type_
-	return r
-}
-
-// Начальная дата (ISO 8601)
-func (r ApiEventsGetRequest) DateFrom(dateFrom time.Time) ApiEventsGetRequest {
-	r.dateFrom = &dateFrom
-	return r
+	memberId   *string
 }
 
-// Конечная дата (ISO 8601)
-func (r ApiEventsGetRequest) DateTo(dateTo time.Time) ApiEventsGetRequest {
-	r.dateTo = &dateTo
+// UUID участника
+func (r ApiEventsAvailableGetRequest) MemberId(memberId string) ApiEventsAvailableGetRequest {
+	r.memberId = &memberId
 	return r
 }
 
-func (r ApiEventsGetRequest) Execute() ([]EventResponse, *http.Response, error) {
-	return r.ApiService.EventsGetExecute(r)
+func (r ApiEventsAvailableGetRequest) Execute() ([]EventResponse, *http.Response, error) {
+	return r.ApiService.EventsAvailableGetExecute(r)
 }
 
 /*
-EventsGet Получение списка событий
+EventsAvailableGet События, доступные для регистрации конкретному участнику
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiEventsGetRequest
+	@return ApiEventsAvailableGetRequest
 */
-func (a *DefaultAPIService) EventsGet(ctx context.Context) ApiEventsGetRequest {
-	return ApiEventsGetRequest{
+func (a *DefaultAPIService) EventsAvailableGet(ctx context.Context) ApiEventsAvailableGetRequest {
+	return ApiEventsAvailableGetRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -1002,7 +1145,7 @@ func (a *DefaultAPIService) EventsGet(ctx context.Context) ApiEventsGetRequest {
 // Execute executes the request
 //
 //	@return []EventResponse
-func (a *DefaultAPIService) EventsGetExecute(r ApiEventsGetRequest) ([]EventResponse, *http.Response, error) {
+func (a *DefaultAPIService) EventsAvailableGetExecute(r ApiEventsAvailableGetRequest) ([]EventResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
@@ -1010,26 +1153,21 @@ func (a *DefaultAPIService) EventsGetExecute(r ApiEventsGetRequest) ([]EventResp
 		localVarReturnValue []EventResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.EventsGet")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.EventsAvailableGet")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/events"
+	localVarPath := localBasePath + "/events/available"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-
-	if r.type_ != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "type", r.type_, "")
-	}
-	if r.dateFrom != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "date_from", r.dateFrom, "")
-	}
-	if r.dateTo != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "date_to", r.dateTo, "")
+	if r.memberId == nil {
+		return localVarReturnValue, nil, reportError("memberId is required and must be specified")
 	}
+
+	parameterAddToHeaderOrQuery(localVarQueryParams, "memberId", r.memberId, "")
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -1070,7 +1208,18 @@ func (a *DefaultAPIService) EventsGetExecute(r ApiEventsGetRequest) ([]EventResp
 			error: localVarHTTPResponse.Status,
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
-			var v ErrorResponse
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -1081,7 +1230,7 @@ func (a *DefaultAPIService) EventsGetExecute(r ApiEventsGetRequest) ([]EventResp
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
-			var v ErrorResponse
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -1105,57 +1254,67 @@ func (a *DefaultAPIService) EventsGetExecute(r ApiEventsGetRequest) ([]EventResp
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiEventsPostRequest struct {
-	ctx             context.Context
-	ApiService      *DefaultAPIService
-	newEventRequest *NewEventRequest
+type ApiEventsEventIdAccessPutRequest struct {
+	ctx         context.Context
+	ApiService  *DefaultAPIService
+	eventId     int32
+	ifMatch     *string
+	eventAccess *EventAccess
 }
 
-func (r ApiEventsPostRequest) NewEventRequest(newEventRequest NewEventRequest) ApiEventsPostRequest {
-	r.newEventRequest = &newEventRequest
+func (r ApiEventsEventIdAccessPutRequest) IfMatch(ifMatch string) ApiEventsEventIdAccessPutRequest {
+	r.ifMatch = &ifMatch
 	return r
 }
 
-func (r ApiEventsPostRequest) Execute() (*EventsPost201Response, *http.Response, error) {
-	return r.ApiService.EventsPostExecute(r)
+func (r ApiEventsEventIdAccessPutRequest) EventAccess(eventAccess EventAccess) ApiEventsEventIdAccessPutRequest {
+	r.eventAccess = &eventAccess
+	return r
+}
+
+func (r ApiEventsEventIdAccessPutRequest) Execute() (int32, *http.Response, error) {
+	return r.ApiService.EventsEventIdAccessPutExecute(r)
 }
 
 /*
-EventsPost Создание нового события
+EventsEventIdAccessPut Ограничение регистрации по уровню членства
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiEventsPostRequest
+	@param eventId ID события
+	@return ApiEventsEventIdAccessPutRequest
 */
-func (a *DefaultAPIService) EventsPost(ctx context.Context) ApiEventsPostRequest {
-	return ApiEventsPostRequest{
+func (a *DefaultAPIService) EventsEventIdAccessPut(ctx context.Context, eventId int32) ApiEventsEventIdAccessPutRequest {
+	return ApiEventsEventIdAccessPutRequest{
 		ApiService: a,
 		ctx:        ctx,
+		eventId:    eventId,
 	}
 }
 
 // Execute executes the request
 //
-//	@return EventsPost201Response
-func (a *DefaultAPIService) EventsPostExecute(r ApiEventsPostRequest) (*EventsPost201Response, *http.Response, error) {
+//	@return int32
+func (a *DefaultAPIService) EventsEventIdAccessPutExecute(r ApiEventsEventIdAccessPutRequest) (int32, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodPost
+		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *EventsPost201Response
+		localVarReturnValue int32
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.EventsPost")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.EventsEventIdAccessPut")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/events"
+	localVarPath := localBasePath + "/events/{eventId}/access"
+	localVarPath = strings.Replace(localVarPath, "{"+"eventId"+"}", url.PathEscape(parameterValueToString(r.eventId, "eventId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-	if r.newEventRequest == nil {
-		return localVarReturnValue, nil, reportError("newEventRequest is required and must be specified")
+	if r.eventAccess == nil {
+		return localVarReturnValue, nil, reportError("eventAccess is required and must be specified")
 	}
 
 	// to determine the Content-Type header
@@ -1175,8 +1334,11 @@ func (a *DefaultAPIService) EventsPostExecute(r ApiEventsPostRequest) (*EventsPo
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	if r.ifMatch != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "If-Match", r.ifMatch, "")
+	}
 	// body params
-	localVarPostBody = r.newEventRequest
+	localVarPostBody = r.eventAccess
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -1200,7 +1362,40 @@ func (a *DefaultAPIService) EventsPostExecute(r ApiEventsPostRequest) (*EventsPo
 			error: localVarHTTPResponse.Status,
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
-			var v ErrorResponse
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 412 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 422 {
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -1211,7 +1406,7 @@ func (a *DefaultAPIService) EventsPostExecute(r ApiEventsPostRequest) (*EventsPo
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
-			var v ErrorResponse
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -1235,63 +1430,65 @@ func (a *DefaultAPIService) EventsPostExecute(r ApiEventsPostRequest) (*EventsPo
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiEventsRegisteredGetRequest struct {
-	ctx        context.Context
-	ApiService *DefaultAPIService
-	memberId   *string
+type ApiEventsEventIdAttendancePutRequest struct {
+	ctx               context.Context
+	ApiService        *DefaultAPIService
+	eventId           int32
+	attendanceRequest *AttendanceRequest
 }
 
-// UUID участника
-func (r ApiEventsRegisteredGetRequest) MemberId(memberId string) ApiEventsRegisteredGetRequest {
-	r.memberId = &memberId
+func (r ApiEventsEventIdAttendancePutRequest) AttendanceRequest(attendanceRequest AttendanceRequest) ApiEventsEventIdAttendancePutRequest {
+	r.attendanceRequest = &attendanceRequest
 	return r
 }
 
-func (r ApiEventsRegisteredGetRequest) Execute() ([]EventResponse, *http.Response, error) {
-	return r.ApiService.EventsRegisteredGetExecute(r)
+func (r ApiEventsEventIdAttendancePutRequest) Execute() (*AttendanceReport, *http.Response, error) {
+	return r.ApiService.EventsEventIdAttendancePutExecute(r)
 }
 
 /*
-EventsRegisteredGet События, на которые записан участник
+EventsEventIdAttendancePut Отметка посещаемости события
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiEventsRegisteredGetRequest
+	@param eventId ID события
+	@return ApiEventsEventIdAttendancePutRequest
 */
-func (a *DefaultAPIService) EventsRegisteredGet(ctx context.Context) ApiEventsRegisteredGetRequest {
-	return ApiEventsRegisteredGetRequest{
+func (a *DefaultAPIService) EventsEventIdAttendancePut(ctx context.Context, eventId int32) ApiEventsEventIdAttendancePutRequest {
+	return ApiEventsEventIdAttendancePutRequest{
 		ApiService: a,
 		ctx:        ctx,
+		eventId:    eventId,
 	}
 }
 
 // Execute executes the request
 //
-//	@return []EventResponse
-func (a *DefaultAPIService) EventsRegisteredGetExecute(r ApiEventsRegisteredGetRequest) ([]EventResponse, *http.Response, error) {
+//	@return AttendanceReport
+func (a *DefaultAPIService) EventsEventIdAttendancePutExecute(r ApiEventsEventIdAttendancePutRequest) (*AttendanceReport, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodGet
+		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue []EventResponse
+		localVarReturnValue *AttendanceReport
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.EventsRegisteredGet")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.EventsEventIdAttendancePut")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/events/registered"
+	localVarPath := localBasePath + "/events/{eventId}/attendance"
+	localVarPath = strings.Replace(localVarPath, "{"+"eventId"+"}", url.PathEscape(parameterValueToString(r.eventId, "eventId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-	if r.memberId == nil {
-		return localVarReturnValue, nil, reportError("memberId is required and must be specified")
+	if r.attendanceRequest == nil {
+		return localVarReturnValue, nil, reportError("attendanceRequest is required and must be specified")
 	}
 
-	parameterAddToHeaderOrQuery(localVarQueryParams, "memberId", r.memberId, "")
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{}
+	localVarHTTPContentTypes := []string{"application/json"}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -1307,6 +1504,8 @@ func (a *DefaultAPIService) EventsRegisteredGetExecute(r ApiEventsRegisteredGetR
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	// body params
+	localVarPostBody = r.attendanceRequest
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -1330,7 +1529,7 @@ func (a *DefaultAPIService) EventsRegisteredGetExecute(r ApiEventsRegisteredGetR
 			error: localVarHTTPResponse.Status,
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
-			var v ErrorResponse
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -1341,7 +1540,7 @@ func (a *DefaultAPIService) EventsRegisteredGetExecute(r ApiEventsRegisteredGetR
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 404 {
-			var v ErrorResponse
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -1351,8 +1550,4692 @@ func (a *DefaultAPIService) EventsRegisteredGetExecute(r ApiEventsRegisteredGetR
 			newErr.model = v
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
-		if localVarHTTPResponse.StatusCode == 500 {
-			var v ErrorResponse
+		if localVarHTTPResponse.StatusCode == 409 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiEventsEventIdDeleteRequest struct {
+	ctx        context.Context
+	ApiService *DefaultAPIService
+	eventId    int32
+	ifMatch    *string
+}
+
+func (r ApiEventsEventIdDeleteRequest) IfMatch(ifMatch string) ApiEventsEventIdDeleteRequest {
+	r.ifMatch = &ifMatch
+	return r
+}
+
+func (r ApiEventsEventIdDeleteRequest) Execute() (*http.Response, error) {
+	return r.ApiService.EventsEventIdDeleteExecute(r)
+}
+
+/*
+EventsEventIdDelete Удаление события
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param eventId
+	@return ApiEventsEventIdDeleteRequest
+*/
+func (a *DefaultAPIService) EventsEventIdDelete(ctx context.Context, eventId int32) ApiEventsEventIdDeleteRequest {
+	return ApiEventsEventIdDeleteRequest{
+		ApiService: a,
+		ctx:        ctx,
+		eventId:    eventId,
+	}
+}
+
+// Execute executes the request
+func (a *DefaultAPIService) EventsEventIdDeleteExecute(r ApiEventsEventIdDeleteRequest) (*http.Response, error) {
+	var (
+		localVarHTTPMethod = http.MethodDelete
+		localVarPostBody   interface{}
+		formFiles          []formFile
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.EventsEventIdDelete")
+	if err != nil {
+		return nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/events/{eventId}"
+	localVarPath = strings.Replace(localVarPath, "{"+"eventId"+"}", url.PathEscape(parameterValueToString(r.eventId, "eventId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ifMatch != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "If-Match", r.ifMatch, "")
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 412 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarHTTPResponse, newErr
+	}
+
+	return localVarHTTPResponse, nil
+}
+
+type ApiEventsEventIdGetRequest struct {
+	ctx         context.Context
+	ApiService  *DefaultAPIService
+	eventId     int32
+	ifNoneMatch *string
+}
+
+func (r ApiEventsEventIdGetRequest) IfNoneMatch(ifNoneMatch string) ApiEventsEventIdGetRequest {
+	r.ifNoneMatch = &ifNoneMatch
+	return r
+}
+
+func (r ApiEventsEventIdGetRequest) Execute() (*EventResponse, *http.Response, error) {
+	return r.ApiService.EventsEventIdGetExecute(r)
+}
+
+/*
+EventsEventIdGet Детали события по ID
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param eventId
+	@return ApiEventsEventIdGetRequest
+*/
+func (a *DefaultAPIService) EventsEventIdGet(ctx context.Context, eventId int32) ApiEventsEventIdGetRequest {
+	return ApiEventsEventIdGetRequest{
+		ApiService: a,
+		ctx:        ctx,
+		eventId:    eventId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return EventResponse
+func (a *DefaultAPIService) EventsEventIdGetExecute(r ApiEventsEventIdGetRequest) (*EventResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *EventResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.EventsEventIdGet")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/events/{eventId}"
+	localVarPath = strings.Replace(localVarPath, "{"+"eventId"+"}", url.PathEscape(parameterValueToString(r.eventId, "eventId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ifNoneMatch != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "If-None-Match", r.ifNoneMatch, "")
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiEventsEventIdPatchRequest struct {
+	ctx        context.Context
+	ApiService *DefaultAPIService
+	eventId    int32
+	ifMatch    *string
+	eventPatch *EventPatch
+}
+
+func (r ApiEventsEventIdPatchRequest) IfMatch(ifMatch string) ApiEventsEventIdPatchRequest {
+	r.ifMatch = &ifMatch
+	return r
+}
+
+func (r ApiEventsEventIdPatchRequest) EventPatch(eventPatch EventPatch) ApiEventsEventIdPatchRequest {
+	r.eventPatch = &eventPatch
+	return r
+}
+
+func (r ApiEventsEventIdPatchRequest) Execute() (int32, *http.Response, error) {
+	return r.ApiService.EventsEventIdPatchExecute(r)
+}
+
+/*
+EventsEventIdPatch Частичное обновление события (JSON Merge Patch, RFC 7396)
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param eventId
+	@return ApiEventsEventIdPatchRequest
+*/
+func (a *DefaultAPIService) EventsEventIdPatch(ctx context.Context, eventId int32) ApiEventsEventIdPatchRequest {
+	return ApiEventsEventIdPatchRequest{
+		ApiService: a,
+		ctx:        ctx,
+		eventId:    eventId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return int32
+func (a *DefaultAPIService) EventsEventIdPatchExecute(r ApiEventsEventIdPatchRequest) (int32, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPatch
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue int32
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.EventsEventIdPatch")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/events/{eventId}"
+	localVarPath = strings.Replace(localVarPath, "{"+"eventId"+"}", url.PathEscape(parameterValueToString(r.eventId, "eventId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.eventPatch == nil {
+		return localVarReturnValue, nil, reportError("eventPatch is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/merge-patch+json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ifMatch != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "If-Match", r.ifMatch, "")
+	}
+	// body params
+	localVarPostBody = r.eventPatch
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 412 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 415 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 422 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiEventsEventIdPricePutRequest struct {
+	ctx        context.Context
+	ApiService *DefaultAPIService
+	eventId    int32
+	ifMatch    *string
+	eventPrice *EventPrice
+}
+
+// Версия события
+func (r ApiEventsEventIdPricePutRequest) IfMatch(ifMatch string) ApiEventsEventIdPricePutRequest {
+	r.ifMatch = &ifMatch
+	return r
+}
+
+func (r ApiEventsEventIdPricePutRequest) EventPrice(eventPrice EventPrice) ApiEventsEventIdPricePutRequest {
+	r.eventPrice = &eventPrice
+	return r
+}
+
+func (r ApiEventsEventIdPricePutRequest) Execute() (int32, *http.Response, error) {
+	return r.ApiService.EventsEventIdPricePutExecute(r)
+}
+
+/*
+EventsEventIdPricePut Установка цены события
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param eventId ID события
+	@return ApiEventsEventIdPricePutRequest
+*/
+func (a *DefaultAPIService) EventsEventIdPricePut(ctx context.Context, eventId int32) ApiEventsEventIdPricePutRequest {
+	return ApiEventsEventIdPricePutRequest{
+		ApiService: a,
+		ctx:        ctx,
+		eventId:    eventId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return int32
+func (a *DefaultAPIService) EventsEventIdPricePutExecute(r ApiEventsEventIdPricePutRequest) (int32, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPut
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue int32
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.EventsEventIdPricePut")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/events/{eventId}/price"
+	localVarPath = strings.Replace(localVarPath, "{"+"eventId"+"}", url.PathEscape(parameterValueToString(r.eventId, "eventId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.eventPrice == nil {
+		return localVarReturnValue, nil, reportError("eventPrice is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ifMatch != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "If-Match", r.ifMatch, "")
+	}
+	// body params
+	localVarPostBody = r.eventPrice
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 412 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 422 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiEventsEventIdPutRequest struct {
+	ctx                context.Context
+	ApiService         *DefaultAPIService
+	eventId            int32
+	ifMatch            *string
+	updateEventRequest *UpdateEventRequest
+}
+
+func (r ApiEventsEventIdPutRequest) IfMatch(ifMatch string) ApiEventsEventIdPutRequest {
+	r.ifMatch = &ifMatch
+	return r
+}
+
+func (r ApiEventsEventIdPutRequest) UpdateEventRequest(updateEventRequest UpdateEventRequest) ApiEventsEventIdPutRequest {
+	r.updateEventRequest = &updateEventRequest
+	return r
+}
+
+func (r ApiEventsEventIdPutRequest) Execute() (int32, *http.Response, error) {
+	return r.ApiService.EventsEventIdPutExecute(r)
+}
+
+/*
+EventsEventIdPut Обновление события
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param eventId
+	@return ApiEventsEventIdPutRequest
+*/
+func (a *DefaultAPIService) EventsEventIdPut(ctx context.Context, eventId int32) ApiEventsEventIdPutRequest {
+	return ApiEventsEventIdPutRequest{
+		ApiService: a,
+		ctx:        ctx,
+		eventId:    eventId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return int32
+func (a *DefaultAPIService) EventsEventIdPutExecute(r ApiEventsEventIdPutRequest) (int32, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPut
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue int32
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.EventsEventIdPut")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/events/{eventId}"
+	localVarPath = strings.Replace(localVarPath, "{"+"eventId"+"}", url.PathEscape(parameterValueToString(r.eventId, "eventId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.updateEventRequest == nil {
+		return localVarReturnValue, nil, reportError("updateEventRequest is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ifMatch != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "If-Match", r.ifMatch, "")
+	}
+	// body params
+	localVarPostBody = r.updateEventRequest
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 412 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiEventsEventIdRegistrationDeleteRequest struct {
+	ctx        context.Context
+	ApiService *DefaultAPIService
+	eventId    int32
+	memberId   *string
+}
+
+// UUID участника
+func (r ApiEventsEventIdRegistrationDeleteRequest) MemberId(memberId string) ApiEventsEventIdRegistrationDeleteRequest {
+	r.memberId = &memberId
+	return r
+}
+
+func (r ApiEventsEventIdRegistrationDeleteRequest) Execute() (*EventsEventIdRegistrationGet200Response, *http.Response, error) {
+	return r.ApiService.EventsEventIdRegistrationDeleteExecute(r)
+}
+
+/*
+EventsEventIdRegistrationDelete Отмена регистрации участника на событие
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param eventId
+	@return ApiEventsEventIdRegistrationDeleteRequest
+*/
+func (a *DefaultAPIService) EventsEventIdRegistrationDelete(ctx context.Context, eventId int32) ApiEventsEventIdRegistrationDeleteRequest {
+	return ApiEventsEventIdRegistrationDeleteRequest{
+		ApiService: a,
+		ctx:        ctx,
+		eventId:    eventId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return EventsEventIdRegistrationGet200Response
+func (a *DefaultAPIService) EventsEventIdRegistrationDeleteExecute(r ApiEventsEventIdRegistrationDeleteRequest) (*EventsEventIdRegistrationGet200Response, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodDelete
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *EventsEventIdRegistrationGet200Response
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.EventsEventIdRegistrationDelete")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/events/{eventId}/registration"
+	localVarPath = strings.Replace(localVarPath, "{"+"eventId"+"}", url.PathEscape(parameterValueToString(r.eventId, "eventId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.memberId == nil {
+		return localVarReturnValue, nil, reportError("memberId is required and must be specified")
+	}
+
+	parameterAddToHeaderOrQuery(localVarQueryParams, "memberId", r.memberId, "")
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiEventsEventIdRegistrationGetRequest struct {
+	ctx        context.Context
+	ApiService *DefaultAPIService
+	eventId    int32
+	memberId   *string
+}
+
+// UUID участника
+func (r ApiEventsEventIdRegistrationGetRequest) MemberId(memberId string) ApiEventsEventIdRegistrationGetRequest {
+	r.memberId = &memberId
+	return r
+}
+
+func (r ApiEventsEventIdRegistrationGetRequest) Execute() (*EventsEventIdRegistrationGet200Response, *http.Response, error) {
+	return r.ApiService.EventsEventIdRegistrationGetExecute(r)
+}
+
+/*
+EventsEventIdRegistrationGet Получение информации о регистрации участника на событие
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param eventId
+	@return ApiEventsEventIdRegistrationGetRequest
+*/
+func (a *DefaultAPIService) EventsEventIdRegistrationGet(ctx context.Context, eventId int32) ApiEventsEventIdRegistrationGetRequest {
+	return ApiEventsEventIdRegistrationGetRequest{
+		ApiService: a,
+		ctx:        ctx,
+		eventId:    eventId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return EventsEventIdRegistrationGet200Response
+func (a *DefaultAPIService) EventsEventIdRegistrationGetExecute(r ApiEventsEventIdRegistrationGetRequest) (*EventsEventIdRegistrationGet200Response, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *EventsEventIdRegistrationGet200Response
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.EventsEventIdRegistrationGet")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/events/{eventId}/registration"
+	localVarPath = strings.Replace(localVarPath, "{"+"eventId"+"}", url.PathEscape(parameterValueToString(r.eventId, "eventId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.memberId == nil {
+		return localVarReturnValue, nil, reportError("memberId is required and must be specified")
+	}
+
+	parameterAddToHeaderOrQuery(localVarQueryParams, "memberId", r.memberId, "")
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiEventsEventIdRegistrationPostRequest struct {
+	ctx        context.Context
+	ApiService *DefaultAPIService
+	eventId    int32
+	memberId   *string
+}
+
+// UUID участника
+func (r ApiEventsEventIdRegistrationPostRequest) MemberId(memberId string) ApiEventsEventIdRegistrationPostRequest {
+	r.memberId = &memberId
+	return r
+}
+
+func (r ApiEventsEventIdRegistrationPostRequest) Execute() (*RegistrationCreatedResponse, *http.Response, error) {
+	return r.ApiService.EventsEventIdRegistrationPostExecute(r)
+}
+
+/*
+EventsEventIdRegistrationPost Регистрация участника на событие
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param eventId ID события
+	@return ApiEventsEventIdRegistrationPostRequest
+*/
+func (a *DefaultAPIService) EventsEventIdRegistrationPost(ctx context.Context, eventId int32) ApiEventsEventIdRegistrationPostRequest {
+	return ApiEventsEventIdRegistrationPostRequest{
+		ApiService: a,
+		ctx:        ctx,
+		eventId:    eventId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return RegistrationCreatedResponse
+func (a *DefaultAPIService) EventsEventIdRegistrationPostExecute(r ApiEventsEventIdRegistrationPostRequest) (*RegistrationCreatedResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *RegistrationCreatedResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.EventsEventIdRegistrationPost")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/events/{eventId}/registration"
+	localVarPath = strings.Replace(localVarPath, "{"+"eventId"+"}", url.PathEscape(parameterValueToString(r.eventId, "eventId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.memberId == nil {
+		return localVarReturnValue, nil, reportError("memberId is required and must be specified")
+	}
+
+	parameterAddToHeaderOrQuery(localVarQueryParams, "memberId", r.memberId, "")
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 502 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiEventsEventIdRegistrationsGetRequest struct {
+	ctx        context.Context
+	ApiService *DefaultAPIService
+	eventId    int32
+}
+
+func (r ApiEventsEventIdRegistrationsGetRequest) Execute() ([]MemberResponse, *http.Response, error) {
+	return r.ApiService.EventsEventIdRegistrationsGetExecute(r)
+}
+
+/*
+EventsEventIdRegistrationsGet Список участников, записанных на событие
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param eventId
+	@return ApiEventsEventIdRegistrationsGetRequest
+*/
+func (a *DefaultAPIService) EventsEventIdRegistrationsGet(ctx context.Context, eventId int32) ApiEventsEventIdRegistrationsGetRequest {
+	return ApiEventsEventIdRegistrationsGetRequest{
+		ApiService: a,
+		ctx:        ctx,
+		eventId:    eventId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return []MemberResponse
+func (a *DefaultAPIService) EventsEventIdRegistrationsGetExecute(r ApiEventsEventIdRegistrationsGetRequest) ([]MemberResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue []MemberResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.EventsEventIdRegistrationsGet")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/events/{eventId}/registrations"
+	localVarPath = strings.Replace(localVarPath, "{"+"eventId"+"}", url.PathEscape(parameterValueToString(r.eventId, "eventId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiEventsGetRequest struct {
+	ctx        context.Context
+	ApiService *DefaultAPIService
+	type_      *int32
+	dateFrom   *time.Time
+	dateTo     *time.Time
+}
+
+// Фильтр по типу события
+func (r ApiEventsGetRequest) Type_(type_ int32) ApiEventsGetRequest {
+	r.type_ = &type_
+	return r
+}
+
+// Начальная дата (ISO 8601)
+func (r ApiEventsGetRequest) DateFrom(dateFrom time.Time) ApiEventsGetRequest {
+	r.dateFrom = &dateFrom
+	return r
+}
+
+// Конечная дата (ISO 8601)
+func (r ApiEventsGetRequest) DateTo(dateTo time.Time) ApiEventsGetRequest {
+	r.dateTo = &dateTo
+	return r
+}
+
+func (r ApiEventsGetRequest) Execute() ([]EventResponse, *http.Response, error) {
+	return r.ApiService.EventsGetExecute(r)
+}
+
+/*
+EventsGet Получение списка событий
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiEventsGetRequest
+*/
+func (a *DefaultAPIService) EventsGet(ctx context.Context) ApiEventsGetRequest {
+	return ApiEventsGetRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return []EventResponse
+func (a *DefaultAPIService) EventsGetExecute(r ApiEventsGetRequest) ([]EventResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue []EventResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.EventsGet")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/events"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.type_ != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "type", r.type_, "")
+	}
+	if r.dateFrom != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "date_from", r.dateFrom, "")
+	}
+	if r.dateTo != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "date_to", r.dateTo, "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiEventsPostRequest struct {
+	ctx             context.Context
+	ApiService      *DefaultAPIService
+	newEventRequest *NewEventRequest
+}
+
+func (r ApiEventsPostRequest) NewEventRequest(newEventRequest NewEventRequest) ApiEventsPostRequest {
+	r.newEventRequest = &newEventRequest
+	return r
+}
+
+func (r ApiEventsPostRequest) Execute() (*EventsPost201Response, *http.Response, error) {
+	return r.ApiService.EventsPostExecute(r)
+}
+
+/*
+EventsPost Создание нового события
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiEventsPostRequest
+*/
+func (a *DefaultAPIService) EventsPost(ctx context.Context) ApiEventsPostRequest {
+	return ApiEventsPostRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return EventsPost201Response
+func (a *DefaultAPIService) EventsPostExecute(r ApiEventsPostRequest) (*EventsPost201Response, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *EventsPost201Response
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.EventsPost")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/events"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.newEventRequest == nil {
+		return localVarReturnValue, nil, reportError("newEventRequest is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.newEventRequest
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiEventsRegisteredGetRequest struct {
+	ctx        context.Context
+	ApiService *DefaultAPIService
+	memberId   *string
+}
+
+// UUID участника
+func (r ApiEventsRegisteredGetRequest) MemberId(memberId string) ApiEventsRegisteredGetRequest {
+	r.memberId = &memberId
+	return r
+}
+
+func (r ApiEventsRegisteredGetRequest) Execute() ([]EventResponse, *http.Response, error) {
+	return r.ApiService.EventsRegisteredGetExecute(r)
+}
+
+/*
+EventsRegisteredGet События, на которые записан участник
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiEventsRegisteredGetRequest
+*/
+func (a *DefaultAPIService) EventsRegisteredGet(ctx context.Context) ApiEventsRegisteredGetRequest {
+	return ApiEventsRegisteredGetRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return []EventResponse
+func (a *DefaultAPIService) EventsRegisteredGetExecute(r ApiEventsRegisteredGetRequest) ([]EventResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue []EventResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.EventsRegisteredGet")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/events/registered"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.memberId == nil {
+		return localVarReturnValue, nil, reportError("memberId is required and must be specified")
+	}
+
+	parameterAddToHeaderOrQuery(localVarQueryParams, "memberId", r.memberId, "")
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiEventsUpcomingGetRequest struct {
+	ctx        context.Context
+	ApiService *DefaultAPIService
+}
+
+func (r ApiEventsUpcomingGetRequest) Execute() ([]EventResponse, *http.Response, error) {
+	return r.ApiService.EventsUpcomingGetExecute(r)
+}
+
+/*
+EventsUpcomingGet Ближайшие события
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiEventsUpcomingGetRequest
+*/
+func (a *DefaultAPIService) EventsUpcomingGet(ctx context.Context) ApiEventsUpcomingGetRequest {
+	return ApiEventsUpcomingGetRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return []EventResponse
+func (a *DefaultAPIService) EventsUpcomingGetExecute(r ApiEventsUpcomingGetRequest) ([]EventResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue []EventResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.EventsUpcomingGet")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/events/upcoming"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiInfoKeyGetRequest struct {
+	ctx        context.Context
+	ApiService *DefaultAPIService
+	key        string
+}
+
+func (r ApiInfoKeyGetRequest) Execute() (*OrchestraInfoResponse, *http.Response, error) {
+	return r.ApiService.InfoKeyGetExecute(r)
+}
+
+/*
+InfoKeyGet Получение информации об оркестре по ключу
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param key
+	@return ApiInfoKeyGetRequest
+*/
+func (a *DefaultAPIService) InfoKeyGet(ctx context.Context, key string) ApiInfoKeyGetRequest {
+	return ApiInfoKeyGetRequest{
+		ApiService: a,
+		ctx:        ctx,
+		key:        key,
+	}
+}
+
+// Execute executes the request
+//
+//	@return OrchestraInfoResponse
+func (a *DefaultAPIService) InfoKeyGetExecute(r ApiInfoKeyGetRequest) (*OrchestraInfoResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *OrchestraInfoResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.InfoKeyGet")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/info/{key}"
+	localVarPath = strings.Replace(localVarPath, "{"+"key"+"}", url.PathEscape(parameterValueToString(r.key, "key")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiInfoKeyPutRequest struct {
+	ctx                  context.Context
+	ApiService           *DefaultAPIService
+	key                  string
+	orchestraInfoRequest *OrchestraInfoRequest
+}
+
+func (r ApiInfoKeyPutRequest) OrchestraInfoRequest(orchestraInfoRequest OrchestraInfoRequest) ApiInfoKeyPutRequest {
+	r.orchestraInfoRequest = &orchestraInfoRequest
+	return r
+}
+
+func (r ApiInfoKeyPutRequest) Execute() (*http.Response, error) {
+	return r.ApiService.InfoKeyPutExecute(r)
+}
+
+/*
+InfoKeyPut Установка информации об оркестре по ключу
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param key
+	@return ApiInfoKeyPutRequest
+*/
+func (a *DefaultAPIService) InfoKeyPut(ctx context.Context, key string) ApiInfoKeyPutRequest {
+	return ApiInfoKeyPutRequest{
+		ApiService: a,
+		ctx:        ctx,
+		key:        key,
+	}
+}
+
+// Execute executes the request
+func (a *DefaultAPIService) InfoKeyPutExecute(r ApiInfoKeyPutRequest) (*http.Response, error) {
+	var (
+		localVarHTTPMethod = http.MethodPut
+		localVarPostBody   interface{}
+		formFiles          []formFile
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.InfoKeyPut")
+	if err != nil {
+		return nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/info/{key}"
+	localVarPath = strings.Replace(localVarPath, "{"+"key"+"}", url.PathEscape(parameterValueToString(r.key, "key")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.orchestraInfoRequest == nil {
+		return nil, reportError("orchestraInfoRequest is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.orchestraInfoRequest
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarHTTPResponse, newErr
+	}
+
+	return localVarHTTPResponse, nil
+}
+
+type ApiJobsGetRequest struct {
+	ctx        context.Context
+	ApiService *DefaultAPIService
+	status     *string
+	kind       *string
+	limit      *int32
+}
+
+func (r ApiJobsGetRequest) Status(status string) ApiJobsGetRequest {
+	r.status = &status
+	return r
+}
+
+// Тип задачи, например membership.expire
+func (r ApiJobsGetRequest) Kind(kind string) ApiJobsGetRequest {
+	r.kind = &kind
+	return r
+}
+
+// Сколько задач вернуть; по умолчанию 100
+func (r ApiJobsGetRequest) Limit(limit int32) ApiJobsGetRequest {
+	r.limit = &limit
+	return r
+}
+
+func (r ApiJobsGetRequest) Execute() ([]JobResponse, *http.Response, error) {
+	return r.ApiService.JobsGetExecute(r)
+}
+
+/*
+JobsGet Фоновые задачи
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiJobsGetRequest
+*/
+func (a *DefaultAPIService) JobsGet(ctx context.Context) ApiJobsGetRequest {
+	return ApiJobsGetRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return []JobResponse
+func (a *DefaultAPIService) JobsGetExecute(r ApiJobsGetRequest) ([]JobResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue []JobResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.JobsGet")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/jobs"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.status != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "status", r.status, "")
+	}
+	if r.kind != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "kind", r.kind, "")
+	}
+	if r.limit != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "limit", r.limit, "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiJobsJobIdRetryPostRequest struct {
+	ctx        context.Context
+	ApiService *DefaultAPIService
+	jobId      int64
+}
+
+func (r ApiJobsJobIdRetryPostRequest) Execute() (*JobResponse, *http.Response, error) {
+	return r.ApiService.JobsJobIdRetryPostExecute(r)
+}
+
+/*
+JobsJobIdRetryPost Перезапуск задачи в статусе dead
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param jobId ID задачи
+	@return ApiJobsJobIdRetryPostRequest
+*/
+func (a *DefaultAPIService) JobsJobIdRetryPost(ctx context.Context, jobId int64) ApiJobsJobIdRetryPostRequest {
+	return ApiJobsJobIdRetryPostRequest{
+		ApiService: a,
+		ctx:        ctx,
+		jobId:      jobId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return JobResponse
+func (a *DefaultAPIService) JobsJobIdRetryPostExecute(r ApiJobsJobIdRetryPostRequest) (*JobResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *JobResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.JobsJobIdRetryPost")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/jobs/{jobId}/retry"
+	localVarPath = strings.Replace(localVarPath, "{"+"jobId"+"}", url.PathEscape(parameterValueToString(r.jobId, "jobId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 409 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiLocationsGetRequest struct {
+	ctx            context.Context
+	ApiService     *DefaultAPIService
+	ifNoneMatch    *string
+	acceptLanguage *string
+}
+
+func (r ApiLocationsGetRequest) IfNoneMatch(ifNoneMatch string) ApiLocationsGetRequest {
+	r.ifNoneMatch = &ifNoneMatch
+	return r
+}
+
+func (r ApiLocationsGetRequest) AcceptLanguage(acceptLanguage string) ApiLocationsGetRequest {
+	r.acceptLanguage = &acceptLanguage
+	return r
+}
+
+func (r ApiLocationsGetRequest) Execute() ([]LocationResponse, *http.Response, error) {
+	return r.ApiService.LocationsGetExecute(r)
+}
+
+/*
+LocationsGet Получение списка локаций
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiLocationsGetRequest
+*/
+func (a *DefaultAPIService) LocationsGet(ctx context.Context) ApiLocationsGetRequest {
+	return ApiLocationsGetRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return []LocationResponse
+func (a *DefaultAPIService) LocationsGetExecute(r ApiLocationsGetRequest) ([]LocationResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue []LocationResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.LocationsGet")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/locations"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ifNoneMatch != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "If-None-Match", r.ifNoneMatch, "")
+	}
+	if r.acceptLanguage != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "Accept-Language", r.acceptLanguage, "")
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiLocationsLocationIdTranslationsLangPutRequest struct {
+	ctx                context.Context
+	ApiService         *DefaultAPIService
+	locationId         int32
+	lang               string
+	newLocationRequest *NewLocationRequest
+}
+
+func (r ApiLocationsLocationIdTranslationsLangPutRequest) NewLocationRequest(newLocationRequest NewLocationRequest) ApiLocationsLocationIdTranslationsLangPutRequest {
+	r.newLocationRequest = &newLocationRequest
+	return r
+}
+
+func (r ApiLocationsLocationIdTranslationsLangPutRequest) Execute() (*http.Response, error) {
+	return r.ApiService.LocationsLocationIdTranslationsLangPutExecute(r)
+}
+
+/*
+LocationsLocationIdTranslationsLangPut Перевод локации
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param locationId
+	@param lang Язык перевода (ru, en)
+	@return ApiLocationsLocationIdTranslationsLangPutRequest
+*/
+func (a *DefaultAPIService) LocationsLocationIdTranslationsLangPut(ctx context.Context, locationId int32, lang string) ApiLocationsLocationIdTranslationsLangPutRequest {
+	return ApiLocationsLocationIdTranslationsLangPutRequest{
+		ApiService: a,
+		ctx:        ctx,
+		locationId: locationId,
+		lang:       lang,
+	}
+}
+
+// Execute executes the request
+func (a *DefaultAPIService) LocationsLocationIdTranslationsLangPutExecute(r ApiLocationsLocationIdTranslationsLangPutRequest) (*http.Response, error) {
+	var (
+		localVarHTTPMethod = http.MethodPut
+		localVarPostBody   interface{}
+		formFiles          []formFile
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.LocationsLocationIdTranslationsLangPut")
+	if err != nil {
+		return nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/locations/{locationId}/translations/{lang}"
+	localVarPath = strings.Replace(localVarPath, "{"+"locationId"+"}", url.PathEscape(parameterValueToString(r.locationId, "locationId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"lang"+"}", url.PathEscape(parameterValueToString(r.lang, "lang")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.newLocationRequest == nil {
+		return nil, reportError("newLocationRequest is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.newLocationRequest
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 422 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarHTTPResponse, newErr
+	}
+
+	return localVarHTTPResponse, nil
+}
+
+type ApiLocationsPostRequest struct {
+	ctx                context.Context
+	ApiService         *DefaultAPIService
+	newLocationRequest *NewLocationRequest
+}
+
+func (r ApiLocationsPostRequest) NewLocationRequest(newLocationRequest NewLocationRequest) ApiLocationsPostRequest {
+	r.newLocationRequest = &newLocationRequest
+	return r
+}
+
+func (r ApiLocationsPostRequest) Execute() (*EventsPost201Response, *http.Response, error) {
+	return r.ApiService.LocationsPostExecute(r)
+}
+
+/*
+LocationsPost Создание новой локации
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiLocationsPostRequest
+*/
+func (a *DefaultAPIService) LocationsPost(ctx context.Context) ApiLocationsPostRequest {
+	return ApiLocationsPostRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return EventsPost201Response
+func (a *DefaultAPIService) LocationsPostExecute(r ApiLocationsPostRequest) (*EventsPost201Response, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *EventsPost201Response
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.LocationsPost")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/locations"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.newLocationRequest == nil {
+		return localVarReturnValue, nil, reportError("newLocationRequest is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.newLocationRequest
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiMeErasureConfirmPostRequest struct {
+	ctx                        context.Context
+	ApiService                 *DefaultAPIService
+	xMemberId                  *string
+	verificationConfirmRequest *VerificationConfirmRequest
+}
+
+func (r ApiMeErasureConfirmPostRequest) XMemberId(xMemberId string) ApiMeErasureConfirmPostRequest {
+	r.xMemberId = &xMemberId
+	return r
+}
+
+func (r ApiMeErasureConfirmPostRequest) VerificationConfirmRequest(verificationConfirmRequest VerificationConfirmRequest) ApiMeErasureConfirmPostRequest {
+	r.verificationConfirmRequest = &verificationConfirmRequest
+	return r
+}
+
+func (r ApiMeErasureConfirmPostRequest) Execute() (*http.Response, error) {
+	return r.ApiService.MeErasureConfirmPostExecute(r)
+}
+
+/*
+MeErasureConfirmPost Подтверждение удаления персональных данных
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiMeErasureConfirmPostRequest
+*/
+func (a *DefaultAPIService) MeErasureConfirmPost(ctx context.Context) ApiMeErasureConfirmPostRequest {
+	return ApiMeErasureConfirmPostRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+func (a *DefaultAPIService) MeErasureConfirmPostExecute(r ApiMeErasureConfirmPostRequest) (*http.Response, error) {
+	var (
+		localVarHTTPMethod = http.MethodPost
+		localVarPostBody   interface{}
+		formFiles          []formFile
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.MeErasureConfirmPost")
+	if err != nil {
+		return nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/me/erasure/confirm"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.verificationConfirmRequest == nil {
+		return nil, reportError("verificationConfirmRequest is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/problem+json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.xMemberId != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "X-Member-Id", r.xMemberId, "")
+	}
+	// body params
+	localVarPostBody = r.verificationConfirmRequest
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 410 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 429 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarHTTPResponse, newErr
+	}
+
+	return localVarHTTPResponse, nil
+}
+
+type ApiMeErasurePostRequest struct {
+	ctx        context.Context
+	ApiService *DefaultAPIService
+	xMemberId  *string
+}
+
+func (r ApiMeErasurePostRequest) XMemberId(xMemberId string) ApiMeErasurePostRequest {
+	r.xMemberId = &xMemberId
+	return r
+}
+
+func (r ApiMeErasurePostRequest) Execute() (*VerificationSentResponse, *http.Response, error) {
+	return r.ApiService.MeErasurePostExecute(r)
+}
+
+/*
+MeErasurePost Запрос на удаление персональных данных
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiMeErasurePostRequest
+*/
+func (a *DefaultAPIService) MeErasurePost(ctx context.Context) ApiMeErasurePostRequest {
+	return ApiMeErasurePostRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return VerificationSentResponse
+func (a *DefaultAPIService) MeErasurePostExecute(r ApiMeErasurePostRequest) (*VerificationSentResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *VerificationSentResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.MeErasurePost")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/me/erasure"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.xMemberId != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "X-Member-Id", r.xMemberId, "")
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 429 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 502 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiMeExportGetRequest struct {
+	ctx        context.Context
+	ApiService *DefaultAPIService
+	format     *string
+	xMemberId  *string
+}
+
+func (r ApiMeExportGetRequest) Format(format string) ApiMeExportGetRequest {
+	r.format = &format
+	return r
+}
+
+func (r ApiMeExportGetRequest) XMemberId(xMemberId string) ApiMeExportGetRequest {
+	r.xMemberId = &xMemberId
+	return r
+}
+
+func (r ApiMeExportGetRequest) Execute() (*MemberDataExport, *http.Response, error) {
+	return r.ApiService.MeExportGetExecute(r)
+}
+
+/*
+MeExportGet Выгрузка всех своих данных
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiMeExportGetRequest
+*/
+func (a *DefaultAPIService) MeExportGet(ctx context.Context) ApiMeExportGetRequest {
+	return ApiMeExportGetRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return MemberDataExport
+func (a *DefaultAPIService) MeExportGetExecute(r ApiMeExportGetRequest) (*MemberDataExport, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *MemberDataExport
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.MeExportGet")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/me/export"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.format != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "format", r.format, "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json", "application/zip", "application/problem+json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.xMemberId != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "X-Member-Id", r.xMemberId, "")
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiMeGetRequest struct {
+	ctx         context.Context
+	ApiService  *DefaultAPIService
+	xMemberId   *string
+	ifNoneMatch *string
+}
+
+func (r ApiMeGetRequest) XMemberId(xMemberId string) ApiMeGetRequest {
+	r.xMemberId = &xMemberId
+	return r
+}
+
+func (r ApiMeGetRequest) IfNoneMatch(ifNoneMatch string) ApiMeGetRequest {
+	r.ifNoneMatch = &ifNoneMatch
+	return r
+}
+
+func (r ApiMeGetRequest) Execute() (*MeResponse, *http.Response, error) {
+	return r.ApiService.MeGetExecute(r)
+}
+
+/*
+MeGet Профиль участника, от имени которого работает бот
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiMeGetRequest
+*/
+func (a *DefaultAPIService) MeGet(ctx context.Context) ApiMeGetRequest {
+	return ApiMeGetRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return MeResponse
+func (a *DefaultAPIService) MeGetExecute(r ApiMeGetRequest) (*MeResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *MeResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.MeGet")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/me"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.xMemberId != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "X-Member-Id", r.xMemberId, "")
+	}
+	if r.ifNoneMatch != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "If-None-Match", r.ifNoneMatch, "")
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiMeMembershipGetRequest struct {
+	ctx        context.Context
+	ApiService *DefaultAPIService
+	xMemberId  *string
+}
+
+func (r ApiMeMembershipGetRequest) XMemberId(xMemberId string) ApiMeMembershipGetRequest {
+	r.xMemberId = &xMemberId
+	return r
+}
+
+func (r ApiMeMembershipGetRequest) Execute() (*MembershipResponse, *http.Response, error) {
+	return r.ApiService.MeMembershipGetExecute(r)
+}
+
+/*
+MeMembershipGet Свое членство и история взносов
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiMeMembershipGetRequest
+*/
+func (a *DefaultAPIService) MeMembershipGet(ctx context.Context) ApiMeMembershipGetRequest {
+	return ApiMeMembershipGetRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return MembershipResponse
+func (a *DefaultAPIService) MeMembershipGetExecute(r ApiMeMembershipGetRequest) (*MembershipResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *MembershipResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.MeMembershipGet")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/me/membership"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.xMemberId != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "X-Member-Id", r.xMemberId, "")
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiMeNotificationsPutRequest struct {
+	ctx                     context.Context
+	ApiService              *DefaultAPIService
+	xMemberId               *string
+	notificationPreferences *NotificationPreferences
+}
+
+func (r ApiMeNotificationsPutRequest) XMemberId(xMemberId string) ApiMeNotificationsPutRequest {
+	r.xMemberId = &xMemberId
+	return r
+}
+
+func (r ApiMeNotificationsPutRequest) NotificationPreferences(notificationPreferences NotificationPreferences) ApiMeNotificationsPutRequest {
+	r.notificationPreferences = &notificationPreferences
+	return r
+}
+
+func (r ApiMeNotificationsPutRequest) Execute() (*http.Response, error) {
+	return r.ApiService.MeNotificationsPutExecute(r)
+}
+
+/*
+MeNotificationsPut Настройки уведомлений
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiMeNotificationsPutRequest
+*/
+func (a *DefaultAPIService) MeNotificationsPut(ctx context.Context) ApiMeNotificationsPutRequest {
+	return ApiMeNotificationsPutRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+func (a *DefaultAPIService) MeNotificationsPutExecute(r ApiMeNotificationsPutRequest) (*http.Response, error) {
+	var (
+		localVarHTTPMethod = http.MethodPut
+		localVarPostBody   interface{}
+		formFiles          []formFile
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.MeNotificationsPut")
+	if err != nil {
+		return nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/me/notifications"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.notificationPreferences == nil {
+		return nil, reportError("notificationPreferences is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/problem+json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.xMemberId != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "X-Member-Id", r.xMemberId, "")
+	}
+	// body params
+	localVarPostBody = r.notificationPreferences
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 422 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarHTTPResponse, newErr
+	}
+
+	return localVarHTTPResponse, nil
+}
+
+type ApiMePatchRequest struct {
+	ctx                context.Context
+	ApiService         *DefaultAPIService
+	xMemberId          *string
+	ifMatch            *string
+	memberProfilePatch *MemberProfilePatch
+}
+
+func (r ApiMePatchRequest) XMemberId(xMemberId string) ApiMePatchRequest {
+	r.xMemberId = &xMemberId
+	return r
+}
+
+func (r ApiMePatchRequest) IfMatch(ifMatch string) ApiMePatchRequest {
+	r.ifMatch = &ifMatch
+	return r
+}
+
+func (r ApiMePatchRequest) MemberProfilePatch(memberProfilePatch MemberProfilePatch) ApiMePatchRequest {
+	r.memberProfilePatch = &memberProfilePatch
+	return r
+}
+
+func (r ApiMePatchRequest) Execute() (string, *http.Response, error) {
+	return r.ApiService.MePatchExecute(r)
+}
+
+/*
+MePatch Частичное обновление своего профиля (JSON Merge Patch, RFC 7396)
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiMePatchRequest
+*/
+func (a *DefaultAPIService) MePatch(ctx context.Context) ApiMePatchRequest {
+	return ApiMePatchRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return string
+func (a *DefaultAPIService) MePatchExecute(r ApiMePatchRequest) (string, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPatch
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue string
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.MePatch")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/me"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.memberProfilePatch == nil {
+		return localVarReturnValue, nil, reportError("memberProfilePatch is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/merge-patch+json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.xMemberId != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "X-Member-Id", r.xMemberId, "")
+	}
+	if r.ifMatch != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "If-Match", r.ifMatch, "")
+	}
+	// body params
+	localVarPostBody = r.memberProfilePatch
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 409 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 412 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 415 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 422 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiMeRegistrationsGetRequest struct {
+	ctx        context.Context
+	ApiService *DefaultAPIService
+	xMemberId  *string
+}
+
+func (r ApiMeRegistrationsGetRequest) XMemberId(xMemberId string) ApiMeRegistrationsGetRequest {
+	r.xMemberId = &xMemberId
+	return r
+}
+
+func (r ApiMeRegistrationsGetRequest) Execute() ([]MemberRegistrationResponse, *http.Response, error) {
+	return r.ApiService.MeRegistrationsGetExecute(r)
+}
+
+/*
+MeRegistrationsGet Все свои регистрации, включая отмененные
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiMeRegistrationsGetRequest
+*/
+func (a *DefaultAPIService) MeRegistrationsGet(ctx context.Context) ApiMeRegistrationsGetRequest {
+	return ApiMeRegistrationsGetRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return []MemberRegistrationResponse
+func (a *DefaultAPIService) MeRegistrationsGetExecute(r ApiMeRegistrationsGetRequest) ([]MemberRegistrationResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue []MemberRegistrationResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.MeRegistrationsGet")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/me/registrations"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.xMemberId != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "X-Member-Id", r.xMemberId, "")
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiMembersGetRequest struct {
+	ctx           context.Context
+	ApiService    *DefaultAPIService
+	status        *string
+	emailVerified *bool
+	phoneVerified *bool
+}
+
+// Фильтр по статусу (pending, approved, declined)
+func (r ApiMembersGetRequest) Status(status string) ApiMembersGetRequest {
+	r.status = &status
+	return r
+}
+
+// Фильтр по подтвержденной почте
+func (r ApiMembersGetRequest) EmailVerified(emailVerified bool) ApiMembersGetRequest {
+	r.emailVerified = &emailVerified
+	return r
+}
+
+// Фильтр по подтвержденному телефону
+func (r ApiMembersGetRequest) PhoneVerified(phoneVerified bool) ApiMembersGetRequest {
+	r.phoneVerified = &phoneVerified
+	return r
+}
+
+func (r ApiMembersGetRequest) Execute() ([]MemberResponse, *http.Response, error) {
+	return r.ApiService.MembersGetExecute(r)
+}
+
+/*
+MembersGet Получение списка участников
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiMembersGetRequest
+*/
+func (a *DefaultAPIService) MembersGet(ctx context.Context) ApiMembersGetRequest {
+	return ApiMembersGetRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return []MemberResponse
+func (a *DefaultAPIService) MembersGetExecute(r ApiMembersGetRequest) ([]MemberResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue []MemberResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.MembersGet")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/members"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.status != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "status", r.status, "")
+	}
+	if r.emailVerified != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "email_verified", r.emailVerified, "")
+	}
+	if r.phoneVerified != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "phone_verified", r.phoneVerified, "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiMembersImportPostRequest struct {
+	ctx         context.Context
+	ApiService  *DefaultAPIService
+	dryRun      *bool
+	skipInvalid *bool
+	body        *string
+}
+
+// Только проверить файл, ничего не сохраняя
+func (r ApiMembersImportPostRequest) DryRun(dryRun bool) ApiMembersImportPostRequest {
+	r.dryRun = &dryRun
+	return r
+}
+
+// Сохранить корректные строки, пропустив ошибочные
+func (r ApiMembersImportPostRequest) SkipInvalid(skipInvalid bool) ApiMembersImportPostRequest {
+	r.skipInvalid = &skipInvalid
+	return r
+}
+
+func (r ApiMembersImportPostRequest) Body(body string) ApiMembersImportPostRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiMembersImportPostRequest) Execute() (*MemberImportReport, *http.Response, error) {
+	return r.ApiService.MembersImportPostExecute(r)
+}
+
+/*
+MembersImportPost Массовый импорт участников из CSV
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiMembersImportPostRequest
+*/
+func (a *DefaultAPIService) MembersImportPost(ctx context.Context) ApiMembersImportPostRequest {
+	return ApiMembersImportPostRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return MemberImportReport
+func (a *DefaultAPIService) MembersImportPostExecute(r ApiMembersImportPostRequest) (*MemberImportReport, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *MemberImportReport
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.MembersImportPost")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/members/import"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	if r.dryRun != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "dry_run", r.dryRun, "")
+	}
+	if r.skipInvalid != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "skip_invalid", r.skipInvalid, "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"text/csv"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 422 {
+			var v MemberImportReport
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiMembersMemberIdDeleteRequest struct {
+	ctx        context.Context
+	ApiService *DefaultAPIService
+	memberId   string
+	ifMatch    *string
+}
+
+func (r ApiMembersMemberIdDeleteRequest) IfMatch(ifMatch string) ApiMembersMemberIdDeleteRequest {
+	r.ifMatch = &ifMatch
+	return r
+}
+
+func (r ApiMembersMemberIdDeleteRequest) Execute() (*http.Response, error) {
+	return r.ApiService.MembersMemberIdDeleteExecute(r)
+}
+
+/*
+MembersMemberIdDelete Удаление участника клуба
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param memberId UUID участника
+	@return ApiMembersMemberIdDeleteRequest
+*/
+func (a *DefaultAPIService) MembersMemberIdDelete(ctx context.Context, memberId string) ApiMembersMemberIdDeleteRequest {
+	return ApiMembersMemberIdDeleteRequest{
+		ApiService: a,
+		ctx:        ctx,
+		memberId:   memberId,
+	}
+}
+
+// Execute executes the request
+func (a *DefaultAPIService) MembersMemberIdDeleteExecute(r ApiMembersMemberIdDeleteRequest) (*http.Response, error) {
+	var (
+		localVarHTTPMethod = http.MethodDelete
+		localVarPostBody   interface{}
+		formFiles          []formFile
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.MembersMemberIdDelete")
+	if err != nil {
+		return nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/members/{memberId}"
+	localVarPath = strings.Replace(localVarPath, "{"+"memberId"+"}", url.PathEscape(parameterValueToString(r.memberId, "memberId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ifMatch != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "If-Match", r.ifMatch, "")
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 412 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarHTTPResponse, newErr
+	}
+
+	return localVarHTTPResponse, nil
+}
+
+type ApiMembersMemberIdGetRequest struct {
+	ctx         context.Context
+	ApiService  *DefaultAPIService
+	memberId    string
+	ifNoneMatch *string
+}
+
+func (r ApiMembersMemberIdGetRequest) IfNoneMatch(ifNoneMatch string) ApiMembersMemberIdGetRequest {
+	r.ifNoneMatch = &ifNoneMatch
+	return r
+}
+
+func (r ApiMembersMemberIdGetRequest) Execute() (*MemberResponse, *http.Response, error) {
+	return r.ApiService.MembersMemberIdGetExecute(r)
+}
+
+/*
+MembersMemberIdGet Получение данных участника по ID
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param memberId
+	@return ApiMembersMemberIdGetRequest
+*/
+func (a *DefaultAPIService) MembersMemberIdGet(ctx context.Context, memberId string) ApiMembersMemberIdGetRequest {
+	return ApiMembersMemberIdGetRequest{
+		ApiService: a,
+		ctx:        ctx,
+		memberId:   memberId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return MemberResponse
+func (a *DefaultAPIService) MembersMemberIdGetExecute(r ApiMembersMemberIdGetRequest) (*MemberResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *MemberResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.MembersMemberIdGet")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/members/{memberId}"
+	localVarPath = strings.Replace(localVarPath, "{"+"memberId"+"}", url.PathEscape(parameterValueToString(r.memberId, "memberId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ifNoneMatch != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "If-None-Match", r.ifNoneMatch, "")
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -1376,45 +6259,49 @@ func (a *DefaultAPIService) EventsRegisteredGetExecute(r ApiEventsRegisteredGetR
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiEventsUpcomingGetRequest struct {
+type ApiMembersMemberIdMembershipGetRequest struct {
 	ctx        context.Context
 	ApiService *DefaultAPIService
+	memberId   string
 }
 
-func (r ApiEventsUpcomingGetRequest) Execute() ([]EventResponse, *http.Response, error) {
-	return r.ApiService.EventsUpcomingGetExecute(r)
+func (r ApiMembersMemberIdMembershipGetRequest) Execute() (*MembershipResponse, *http.Response, error) {
+	return r.ApiService.MembersMemberIdMembershipGetExecute(r)
 }
 
 /*
-EventsUpcomingGet Ближайшие события
+MembersMemberIdMembershipGet Членство участника и история взносов
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiEventsUpcomingGetRequest
+	@param memberId UUID участника
+	@return ApiMembersMemberIdMembershipGetRequest
 */
-func (a *DefaultAPIService) EventsUpcomingGet(ctx context.Context) ApiEventsUpcomingGetRequest {
-	return ApiEventsUpcomingGetRequest{
+func (a *DefaultAPIService) MembersMemberIdMembershipGet(ctx context.Context, memberId string) ApiMembersMemberIdMembershipGetRequest {
+	return ApiMembersMemberIdMembershipGetRequest{
 		ApiService: a,
 		ctx:        ctx,
+		memberId:   memberId,
 	}
 }
 
 // Execute executes the request
 //
-//	@return []EventResponse
-func (a *DefaultAPIService) EventsUpcomingGetExecute(r ApiEventsUpcomingGetRequest) ([]EventResponse, *http.Response, error) {
+//	@return MembershipResponse
+func (a *DefaultAPIService) MembersMemberIdMembershipGetExecute(r ApiMembersMemberIdMembershipGetRequest) (*MembershipResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue []EventResponse
+		localVarReturnValue *MembershipResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.EventsUpcomingGet")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.MembersMemberIdMembershipGet")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/events/upcoming"
+	localVarPath := localBasePath + "/members/{memberId}/membership"
+	localVarPath = strings.Replace(localVarPath, "{"+"memberId"+"}", url.PathEscape(parameterValueToString(r.memberId, "memberId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
@@ -1459,8 +6346,30 @@ func (a *DefaultAPIService) EventsUpcomingGetExecute(r ApiEventsUpcomingGetReque
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
 		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
 		if localVarHTTPResponse.StatusCode == 500 {
-			var v ErrorResponse
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -1484,56 +6393,65 @@ func (a *DefaultAPIService) EventsUpcomingGetExecute(r ApiEventsUpcomingGetReque
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiInfoKeyGetRequest struct {
-	ctx        context.Context
-	ApiService *DefaultAPIService
-	key        string
+type ApiMembersMemberIdMembershipPaymentsPostRequest struct {
+	ctx                         context.Context
+	ApiService                  *DefaultAPIService
+	memberId                    string
+	newMembershipPaymentRequest *NewMembershipPaymentRequest
 }
 
-func (r ApiInfoKeyGetRequest) Execute() (*OrchestraInfoResponse, *http.Response, error) {
-	return r.ApiService.InfoKeyGetExecute(r)
+func (r ApiMembersMemberIdMembershipPaymentsPostRequest) NewMembershipPaymentRequest(newMembershipPaymentRequest NewMembershipPaymentRequest) ApiMembersMemberIdMembershipPaymentsPostRequest {
+	r.newMembershipPaymentRequest = &newMembershipPaymentRequest
+	return r
+}
+
+func (r ApiMembersMemberIdMembershipPaymentsPostRequest) Execute() (*MembershipPaymentResponse, *http.Response, error) {
+	return r.ApiService.MembersMemberIdMembershipPaymentsPostExecute(r)
 }
 
 /*
-InfoKeyGet Получение информации об оркестре по ключу
+MembersMemberIdMembershipPaymentsPost Учет ежегодного взноса
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@param key
-	@return ApiInfoKeyGetRequest
+	@param memberId UUID участника
+	@return ApiMembersMemberIdMembershipPaymentsPostRequest
 */
-func (a *DefaultAPIService) InfoKeyGet(ctx context.Context, key string) ApiInfoKeyGetRequest {
-	return ApiInfoKeyGetRequest{
+func (a *DefaultAPIService) MembersMemberIdMembershipPaymentsPost(ctx context.Context, memberId string) ApiMembersMemberIdMembershipPaymentsPostRequest {
+	return ApiMembersMemberIdMembershipPaymentsPostRequest{
 		ApiService: a,
 		ctx:        ctx,
-		key:        key,
+		memberId:   memberId,
 	}
 }
 
 // Execute executes the request
 //
-//	@return OrchestraInfoResponse
-func (a *DefaultAPIService) InfoKeyGetExecute(r ApiInfoKeyGetRequest) (*OrchestraInfoResponse, *http.Response, error) {
+//	@return MembershipPaymentResponse
+func (a *DefaultAPIService) MembersMemberIdMembershipPaymentsPostExecute(r ApiMembersMemberIdMembershipPaymentsPostRequest) (*MembershipPaymentResponse, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodGet
+		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *OrchestraInfoResponse
+		localVarReturnValue *MembershipPaymentResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.InfoKeyGet")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.MembersMemberIdMembershipPaymentsPost")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/info/{key}"
-	localVarPath = strings.Replace(localVarPath, "{"+"key"+"}", url.PathEscape(parameterValueToString(r.key, "key")), -1)
+	localVarPath := localBasePath + "/members/{memberId}/membership/payments"
+	localVarPath = strings.Replace(localVarPath, "{"+"memberId"+"}", url.PathEscape(parameterValueToString(r.memberId, "memberId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.newMembershipPaymentRequest == nil {
+		return localVarReturnValue, nil, reportError("newMembershipPaymentRequest is required and must be specified")
+	}
 
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{}
+	localVarHTTPContentTypes := []string{"application/json"}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -1549,6 +6467,8 @@ func (a *DefaultAPIService) InfoKeyGetExecute(r ApiInfoKeyGetRequest) (*Orchestr
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	// body params
+	localVarPostBody = r.newMembershipPaymentRequest
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -1571,8 +6491,41 @@ func (a *DefaultAPIService) InfoKeyGetExecute(r ApiInfoKeyGetRequest) (*Orchestr
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
 		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
 		if localVarHTTPResponse.StatusCode == 404 {
-			var v ErrorResponse
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 409 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 422 {
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -1583,7 +6536,7 @@ func (a *DefaultAPIService) InfoKeyGetExecute(r ApiInfoKeyGetRequest) (*Orchestr
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
-			var v ErrorResponse
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -1607,52 +6560,71 @@ func (a *DefaultAPIService) InfoKeyGetExecute(r ApiInfoKeyGetRequest) (*Orchestr
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiLocationsGetRequest struct {
-	ctx        context.Context
-	ApiService *DefaultAPIService
+type ApiMembersMemberIdPatchRequest struct {
+	ctx                       context.Context
+	ApiService                *DefaultAPIService
+	memberId                  string
+	ifMatch                   *string
+	updateMemberStatusRequest *UpdateMemberStatusRequest
 }
 
-func (r ApiLocationsGetRequest) Execute() ([]LocationResponse, *http.Response, error) {
-	return r.ApiService.LocationsGetExecute(r)
+func (r ApiMembersMemberIdPatchRequest) IfMatch(ifMatch string) ApiMembersMemberIdPatchRequest {
+	r.ifMatch = &ifMatch
+	return r
+}
+
+func (r ApiMembersMemberIdPatchRequest) UpdateMemberStatusRequest(updateMemberStatusRequest UpdateMemberStatusRequest) ApiMembersMemberIdPatchRequest {
+	r.updateMemberStatusRequest = &updateMemberStatusRequest
+	return r
+}
+
+func (r ApiMembersMemberIdPatchRequest) Execute() (*MembersPost201Response, *http.Response, error) {
+	return r.ApiService.MembersMemberIdPatchExecute(r)
 }
 
 /*
-LocationsGet Получение списка локаций
+MembersMemberIdPatch Обновление статуса участника
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiLocationsGetRequest
+	@param memberId
+	@return ApiMembersMemberIdPatchRequest
 */
-func (a *DefaultAPIService) LocationsGet(ctx context.Context) ApiLocationsGetRequest {
-	return ApiLocationsGetRequest{
+func (a *DefaultAPIService) MembersMemberIdPatch(ctx context.Context, memberId string) ApiMembersMemberIdPatchRequest {
+	return ApiMembersMemberIdPatchRequest{
 		ApiService: a,
 		ctx:        ctx,
+		memberId:   memberId,
 	}
 }
 
 // Execute executes the request
 //
-//	@return []LocationResponse
-func (a *DefaultAPIService) LocationsGetExecute(r ApiLocationsGetRequest) ([]LocationResponse, *http.Response, error) {
+//	@return MembersPost201Response
+func (a *DefaultAPIService) MembersMemberIdPatchExecute(r ApiMembersMemberIdPatchRequest) (*MembersPost201Response, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodGet
+		localVarHTTPMethod  = http.MethodPatch
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue []LocationResponse
+		localVarReturnValue *MembersPost201Response
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.LocationsGet")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.MembersMemberIdPatch")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/locations"
+	localVarPath := localBasePath + "/members/{memberId}"
+	localVarPath = strings.Replace(localVarPath, "{"+"memberId"+"}", url.PathEscape(parameterValueToString(r.memberId, "memberId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.updateMemberStatusRequest == nil {
+		return localVarReturnValue, nil, reportError("updateMemberStatusRequest is required and must be specified")
+	}
 
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{}
+	localVarHTTPContentTypes := []string{"application/json"}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -1668,6 +6640,11 @@ func (a *DefaultAPIService) LocationsGetExecute(r ApiLocationsGetRequest) ([]Loc
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	if r.ifMatch != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "If-Match", r.ifMatch, "")
+	}
+	// body params
+	localVarPostBody = r.updateMemberStatusRequest
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -1690,8 +6667,41 @@ func (a *DefaultAPIService) LocationsGetExecute(r ApiLocationsGetRequest) ([]Loc
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
 		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 412 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
 		if localVarHTTPResponse.StatusCode == 500 {
-			var v ErrorResponse
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -1715,61 +6725,71 @@ func (a *DefaultAPIService) LocationsGetExecute(r ApiLocationsGetRequest) ([]Loc
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiLocationsPostRequest struct {
+type ApiMembersMemberIdProfilePatchRequest struct {
 	ctx                context.Context
 	ApiService         *DefaultAPIService
-	newLocationRequest *NewLocationRequest
+	memberId           string
+	ifMatch            *string
+	memberProfilePatch *MemberProfilePatch
 }
 
-func (r ApiLocationsPostRequest) NewLocationRequest(newLocationRequest NewLocationRequest) ApiLocationsPostRequest {
-	r.newLocationRequest = &newLocationRequest
+func (r ApiMembersMemberIdProfilePatchRequest) IfMatch(ifMatch string) ApiMembersMemberIdProfilePatchRequest {
+	r.ifMatch = &ifMatch
 	return r
 }
 
-func (r ApiLocationsPostRequest) Execute() (*EventsPost201Response, *http.Response, error) {
-	return r.ApiService.LocationsPostExecute(r)
+func (r ApiMembersMemberIdProfilePatchRequest) MemberProfilePatch(memberProfilePatch MemberProfilePatch) ApiMembersMemberIdProfilePatchRequest {
+	r.memberProfilePatch = &memberProfilePatch
+	return r
+}
+
+func (r ApiMembersMemberIdProfilePatchRequest) Execute() (string, *http.Response, error) {
+	return r.ApiService.MembersMemberIdProfilePatchExecute(r)
 }
 
 /*
-LocationsPost Создание новой локации
+MembersMemberIdProfilePatch Частичное обновление данных участника (JSON Merge Patch, RFC 7396)
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiLocationsPostRequest
+	@param memberId
+	@return ApiMembersMemberIdProfilePatchRequest
 */
-func (a *DefaultAPIService) LocationsPost(ctx context.Context) ApiLocationsPostRequest {
-	return ApiLocationsPostRequest{
+func (a *DefaultAPIService) MembersMemberIdProfilePatch(ctx context.Context, memberId string) ApiMembersMemberIdProfilePatchRequest {
+	return ApiMembersMemberIdProfilePatchRequest{
 		ApiService: a,
 		ctx:        ctx,
+		memberId:   memberId,
 	}
 }
 
 // Execute executes the request
 //
-//	@return EventsPost201Response
-func (a *DefaultAPIService) LocationsPostExecute(r ApiLocationsPostRequest) (*EventsPost201Response, *http.Response, error) {
+//	@return string
+func (a *DefaultAPIService) MembersMemberIdProfilePatchExecute(r ApiMembersMemberIdProfilePatchRequest) (string, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodPost
+		localVarHTTPMethod  = http.MethodPatch
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *EventsPost201Response
+		localVarReturnValue string
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.LocationsPost")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.MembersMemberIdProfilePatch")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/locations"
+	localVarPath := localBasePath + "/members/{memberId}/profile"
+	localVarPath = strings.Replace(localVarPath, "{"+"memberId"+"}", url.PathEscape(parameterValueToString(r.memberId, "memberId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-	if r.newLocationRequest == nil {
-		return localVarReturnValue, nil, reportError("newLocationRequest is required and must be specified")
+	if r.memberProfilePatch == nil {
+		return localVarReturnValue, nil, reportError("memberProfilePatch is required and must be specified")
 	}
 
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{"application/json"}
+	localVarHTTPContentTypes := []string{"application/merge-patch+json"}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -1785,8 +6805,11 @@ func (a *DefaultAPIService) LocationsPostExecute(r ApiLocationsPostRequest) (*Ev
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	if r.ifMatch != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "If-Match", r.ifMatch, "")
+	}
 	// body params
-	localVarPostBody = r.newLocationRequest
+	localVarPostBody = r.memberProfilePatch
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -1810,7 +6833,51 @@ func (a *DefaultAPIService) LocationsPostExecute(r ApiLocationsPostRequest) (*Ev
 			error: localVarHTTPResponse.Status,
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
-			var v ErrorResponse
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 412 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 415 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 422 {
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -1821,7 +6888,7 @@ func (a *DefaultAPIService) LocationsPostExecute(r ApiLocationsPostRequest) (*Ev
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
-			var v ErrorResponse
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -1845,62 +6912,71 @@ func (a *DefaultAPIService) LocationsPostExecute(r ApiLocationsPostRequest) (*Ev
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiMembersGetRequest struct {
-	ctx        context.Context
-	ApiService *DefaultAPIService
-	status     *string
+type ApiMembersMemberIdPutRequest struct {
+	ctx                        context.Context
+	ApiService                 *DefaultAPIService
+	memberId                   string
+	ifMatch                    *string
+	updateMemberProfileRequest *UpdateMemberProfileRequest
 }
 
-// Фильтр по статусу (pending, approved, declined)
-func (r ApiMembersGetRequest) Status(status string) ApiMembersGetRequest {
-	r.status = &status
+func (r ApiMembersMemberIdPutRequest) IfMatch(ifMatch string) ApiMembersMemberIdPutRequest {
+	r.ifMatch = &ifMatch
 	return r
 }
 
-func (r ApiMembersGetRequest) Execute() ([]MemberResponse, *http.Response, error) {
-	return r.ApiService.MembersGetExecute(r)
+func (r ApiMembersMemberIdPutRequest) UpdateMemberProfileRequest(updateMemberProfileRequest UpdateMemberProfileRequest) ApiMembersMemberIdPutRequest {
+	r.updateMemberProfileRequest = &updateMemberProfileRequest
+	return r
+}
+
+func (r ApiMembersMemberIdPutRequest) Execute() (*MembersPost201Response, *http.Response, error) {
+	return r.ApiService.MembersMemberIdPutExecute(r)
 }
 
 /*
-MembersGet Получение списка участников
+MembersMemberIdPut Обновление данных участника (имя, email и т.д.)
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiMembersGetRequest
+	@param memberId
+	@return ApiMembersMemberIdPutRequest
 */
-func (a *DefaultAPIService) MembersGet(ctx context.Context) ApiMembersGetRequest {
-	return ApiMembersGetRequest{
+func (a *DefaultAPIService) MembersMemberIdPut(ctx context.Context, memberId string) ApiMembersMemberIdPutRequest {
+	return ApiMembersMemberIdPutRequest{
 		ApiService: a,
 		ctx:        ctx,
+		memberId:   memberId,
 	}
 }
 
 // Execute executes the request
 //
-//	@return []MemberResponse
-func (a *DefaultAPIService) MembersGetExecute(r ApiMembersGetRequest) ([]MemberResponse, *http.Response, error) {
+//	@return MembersPost201Response
+func (a *DefaultAPIService) MembersMemberIdPutExecute(r ApiMembersMemberIdPutRequest) (*MembersPost201Response, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodGet
+		localVarHTTPMethod  = http.MethodPut
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue []MemberResponse
+		localVarReturnValue *MembersPost201Response
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.MembersGet")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.MembersMemberIdPut")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/members"
+	localVarPath := localBasePath + "/members/{memberId}"
+	localVarPath = strings.Replace(localVarPath, "{"+"memberId"+"}", url.PathEscape(parameterValueToString(r.memberId, "memberId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-
-	if r.status != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "status", r.status, "")
+	if r.updateMemberProfileRequest == nil {
+		return localVarReturnValue, nil, reportError("updateMemberProfileRequest is required and must be specified")
 	}
+
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{}
+	localVarHTTPContentTypes := []string{"application/json"}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -1916,6 +6992,11 @@ func (a *DefaultAPIService) MembersGetExecute(r ApiMembersGetRequest) ([]MemberR
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	if r.ifMatch != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "If-Match", r.ifMatch, "")
+	}
+	// body params
+	localVarPostBody = r.updateMemberProfileRequest
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -1939,7 +7020,29 @@ func (a *DefaultAPIService) MembersGetExecute(r ApiMembersGetRequest) ([]MemberR
 			error: localVarHTTPResponse.Status,
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
-			var v ErrorResponse
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 412 {
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -1950,7 +7053,7 @@ func (a *DefaultAPIService) MembersGetExecute(r ApiMembersGetRequest) ([]MemberR
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
-			var v ErrorResponse
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -1974,53 +7077,65 @@ func (a *DefaultAPIService) MembersGetExecute(r ApiMembersGetRequest) ([]MemberR
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiMembersMemberIdDeleteRequest struct {
-	ctx        context.Context
-	ApiService *DefaultAPIService
-	memberId   string
+type ApiMembersMemberIdVerificationChannelConfirmPostRequest struct {
+	ctx                        context.Context
+	ApiService                 *DefaultAPIService
+	memberId                   string
+	channel                    string
+	verificationConfirmRequest *VerificationConfirmRequest
 }
 
-func (r ApiMembersMemberIdDeleteRequest) Execute() (*http.Response, error) {
-	return r.ApiService.MembersMemberIdDeleteExecute(r)
+func (r ApiMembersMemberIdVerificationChannelConfirmPostRequest) VerificationConfirmRequest(verificationConfirmRequest VerificationConfirmRequest) ApiMembersMemberIdVerificationChannelConfirmPostRequest {
+	r.verificationConfirmRequest = &verificationConfirmRequest
+	return r
+}
+
+func (r ApiMembersMemberIdVerificationChannelConfirmPostRequest) Execute() (*http.Response, error) {
+	return r.ApiService.MembersMemberIdVerificationChannelConfirmPostExecute(r)
 }
 
 /*
-MembersMemberIdDelete Удаление участника клуба
+MembersMemberIdVerificationChannelConfirmPost Подтверждение контакта одноразовым кодом
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@param memberId UUID участника
-	@return ApiMembersMemberIdDeleteRequest
+	@param memberId ID участника
+	@param channel Канал подтверждения (email, phone)
+	@return ApiMembersMemberIdVerificationChannelConfirmPostRequest
 */
-func (a *DefaultAPIService) MembersMemberIdDelete(ctx context.Context, memberId string) ApiMembersMemberIdDeleteRequest {
-	return ApiMembersMemberIdDeleteRequest{
+func (a *DefaultAPIService) MembersMemberIdVerificationChannelConfirmPost(ctx context.Context, memberId string, channel string) ApiMembersMemberIdVerificationChannelConfirmPostRequest {
+	return ApiMembersMemberIdVerificationChannelConfirmPostRequest{
 		ApiService: a,
 		ctx:        ctx,
 		memberId:   memberId,
+		channel:    channel,
 	}
 }
 
 // Execute executes the request
-func (a *DefaultAPIService) MembersMemberIdDeleteExecute(r ApiMembersMemberIdDeleteRequest) (*http.Response, error) {
+func (a *DefaultAPIService) MembersMemberIdVerificationChannelConfirmPostExecute(r ApiMembersMemberIdVerificationChannelConfirmPostRequest) (*http.Response, error) {
 	var (
-		localVarHTTPMethod = http.MethodDelete
+		localVarHTTPMethod = http.MethodPost
 		localVarPostBody   interface{}
 		formFiles          []formFile
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.MembersMemberIdDelete")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.MembersMemberIdVerificationChannelConfirmPost")
 	if err != nil {
 		return nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/members/{memberId}"
+	localVarPath := localBasePath + "/members/{memberId}/verification/{channel}/confirm"
 	localVarPath = strings.Replace(localVarPath, "{"+"memberId"+"}", url.PathEscape(parameterValueToString(r.memberId, "memberId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"channel"+"}", url.PathEscape(parameterValueToString(r.channel, "channel")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-
+	if r.verificationConfirmRequest == nil {
+		return nil, reportError("verificationConfirmRequest is required and must be specified")
+	}
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{}
+	localVarHTTPContentTypes := []string{"application/json"}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -2036,6 +7151,8 @@ func (a *DefaultAPIService) MembersMemberIdDeleteExecute(r ApiMembersMemberIdDel
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	// body params
+	localVarPostBody = r.verificationConfirmRequest
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return nil, err
@@ -2058,8 +7175,41 @@ func (a *DefaultAPIService) MembersMemberIdDeleteExecute(r ApiMembersMemberIdDel
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
 		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
 		if localVarHTTPResponse.StatusCode == 404 {
-			var v ErrorResponse
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 410 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 429 {
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -2070,7 +7220,7 @@ func (a *DefaultAPIService) MembersMemberIdDeleteExecute(r ApiMembersMemberIdDel
 			return localVarHTTPResponse, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
-			var v ErrorResponse
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -2085,54 +7235,57 @@ func (a *DefaultAPIService) MembersMemberIdDeleteExecute(r ApiMembersMemberIdDel
 	return localVarHTTPResponse, nil
 }
 
-type ApiMembersMemberIdGetRequest struct {
+type ApiMembersMemberIdVerificationChannelPostRequest struct {
 	ctx        context.Context
 	ApiService *DefaultAPIService
 	memberId   string
+	channel    string
 }
 
-func (r ApiMembersMemberIdGetRequest) Execute() (*MemberResponse, *http.Response, error) {
-	return r.ApiService.MembersMemberIdGetExecute(r)
+func (r ApiMembersMemberIdVerificationChannelPostRequest) Execute() (*VerificationSentResponse, *http.Response, error) {
+	return r.ApiService.MembersMemberIdVerificationChannelPostExecute(r)
 }
 
 /*
-MembersMemberIdGet Получение данных участника по ID
+MembersMemberIdVerificationChannelPost Отправка одноразового кода подтверждения
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@param memberId
-	@return ApiMembersMemberIdGetRequest
+	@param memberId ID участника
+	@param channel Канал подтверждения (email, phone)
+	@return ApiMembersMemberIdVerificationChannelPostRequest
 */
-func (a *DefaultAPIService) MembersMemberIdGet(ctx context.Context, memberId string) ApiMembersMemberIdGetRequest {
-	return ApiMembersMemberIdGetRequest{
+func (a *DefaultAPIService) MembersMemberIdVerificationChannelPost(ctx context.Context, memberId string, channel string) ApiMembersMemberIdVerificationChannelPostRequest {
+	return ApiMembersMemberIdVerificationChannelPostRequest{
 		ApiService: a,
 		ctx:        ctx,
 		memberId:   memberId,
+		channel:    channel,
 	}
 }
 
 // Execute executes the request
 //
-//	@return MemberResponse
-func (a *DefaultAPIService) MembersMemberIdGetExecute(r ApiMembersMemberIdGetRequest) (*MemberResponse, *http.Response, error) {
+//	@return VerificationSentResponse
+func (a *DefaultAPIService) MembersMemberIdVerificationChannelPostExecute(r ApiMembersMemberIdVerificationChannelPostRequest) (*VerificationSentResponse, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodGet
+		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *MemberResponse
+		localVarReturnValue *VerificationSentResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.MembersMemberIdGet")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.MembersMemberIdVerificationChannelPost")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/members/{memberId}"
+	localVarPath := localBasePath + "/members/{memberId}/verification/{channel}"
 	localVarPath = strings.Replace(localVarPath, "{"+"memberId"+"}", url.PathEscape(parameterValueToString(r.memberId, "memberId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"channel"+"}", url.PathEscape(parameterValueToString(r.channel, "channel")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -2172,8 +7325,52 @@ func (a *DefaultAPIService) MembersMemberIdGetExecute(r ApiMembersMemberIdGetReq
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
 		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
 		if localVarHTTPResponse.StatusCode == 404 {
-			var v ErrorResponse
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 409 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 429 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 502 {
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -2184,7 +7381,7 @@ func (a *DefaultAPIService) MembersMemberIdGetExecute(r ApiMembersMemberIdGetReq
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
-			var v ErrorResponse
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -2205,64 +7402,60 @@ func (a *DefaultAPIService) MembersMemberIdGetExecute(r ApiMembersMemberIdGetReq
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
 
-	return localVarReturnValue, localVarHTTPResponse, nil
-}
-
-type ApiMembersMemberIdPatchRequest struct {
-	ctx                       context.Context
-	ApiService                *DefaultAPIService
-	memberId                  string
-	updateMemberStatusRequest *UpdateMemberStatusRequest
+	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-func (r ApiMembersMemberIdPatchRequest) UpdateMemberStatusRequest(updateMemberStatusRequest UpdateMemberStatusRequest) ApiMembersMemberIdPatchRequest {
-	r.updateMemberStatusRequest = &updateMemberStatusRequest
+type ApiMembersPostRequest struct {
+	ctx              context.Context
+	ApiService       *DefaultAPIService
+	newMemberRequest *NewMemberRequest
+}
+
+func (r ApiMembersPostRequest) NewMemberRequest(newMemberRequest NewMemberRequest) ApiMembersPostRequest {
+	r.newMemberRequest = &newMemberRequest
 	return r
 }
 
-func (r ApiMembersMemberIdPatchRequest) Execute() (*MembersPost201Response, *http.Response, error) {
-	return r.ApiService.MembersMemberIdPatchExecute(r)
+func (r ApiMembersPostRequest) Execute() (*MembersPost201Response, *http.Response, error) {
+	return r.ApiService.MembersPostExecute(r)
 }
 
 /*
-MembersMemberIdPatch Обновление статуса участника
+MembersPost Регистрация нового участника клуба
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@param memberId
-	@return ApiMembersMemberIdPatchRequest
+	@return ApiMembersPostRequest
 */
-func (a *DefaultAPIService) MembersMemberIdPatch(ctx context.Context, memberId string) ApiMembersMemberIdPatchRequest {
-	return ApiMembersMemberIdPatchRequest{
+func (a *DefaultAPIService) MembersPost(ctx context.Context) ApiMembersPostRequest {
+	return ApiMembersPostRequest{
 		ApiService: a,
 		ctx:        ctx,
-		memberId:   memberId,
 	}
 }
 
 // Execute executes the request
 //
 //	@return MembersPost201Response
-func (a *DefaultAPIService) MembersMemberIdPatchExecute(r ApiMembersMemberIdPatchRequest) (*MembersPost201Response, *http.Response, error) {
+func (a *DefaultAPIService) MembersPostExecute(r ApiMembersPostRequest) (*MembersPost201Response, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodPatch
+		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
 		localVarReturnValue *MembersPost201Response
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.MembersMemberIdPatch")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.MembersPost")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/members/{memberId}"
-	localVarPath = strings.Replace(localVarPath, "{"+"memberId"+"}", url.PathEscape(parameterValueToString(r.memberId, "memberId")), -1)
+	localVarPath := localBasePath + "/members"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-	if r.updateMemberStatusRequest == nil {
-		return localVarReturnValue, nil, reportError("updateMemberStatusRequest is required and must be specified")
+	if r.newMemberRequest == nil {
+		return localVarReturnValue, nil, reportError("newMemberRequest is required and must be specified")
 	}
 
 	// to determine the Content-Type header
@@ -2283,7 +7476,7 @@ func (a *DefaultAPIService) MembersMemberIdPatchExecute(r ApiMembersMemberIdPatc
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
 	// body params
-	localVarPostBody = r.updateMemberStatusRequest
+	localVarPostBody = r.newMemberRequest
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -2307,18 +7500,7 @@ func (a *DefaultAPIService) MembersMemberIdPatchExecute(r ApiMembersMemberIdPatc
 			error: localVarHTTPResponse.Status,
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
-			var v ErrorResponse
-			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHTTPResponse, newErr
-			}
-			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
-			newErr.model = v
-			return localVarReturnValue, localVarHTTPResponse, newErr
-		}
-		if localVarHTTPResponse.StatusCode == 404 {
-			var v ErrorResponse
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -2329,7 +7511,7 @@ func (a *DefaultAPIService) MembersMemberIdPatchExecute(r ApiMembersMemberIdPatc
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
-			var v ErrorResponse
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -2353,65 +7535,81 @@ func (a *DefaultAPIService) MembersMemberIdPatchExecute(r ApiMembersMemberIdPatc
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiMembersMemberIdPutRequest struct {
-	ctx                        context.Context
-	ApiService                 *DefaultAPIService
-	memberId                   string
-	updateMemberProfileRequest *UpdateMemberProfileRequest
+type ApiMembershipsPaymentsImportPostRequest struct {
+	ctx         context.Context
+	ApiService  *DefaultAPIService
+	dryRun      *bool
+	skipInvalid *bool
+	body        *string
 }
 
-func (r ApiMembersMemberIdPutRequest) UpdateMemberProfileRequest(updateMemberProfileRequest UpdateMemberProfileRequest) ApiMembersMemberIdPutRequest {
-	r.updateMemberProfileRequest = &updateMemberProfileRequest
+// Только проверить файл, ничего не сохраняя
+func (r ApiMembershipsPaymentsImportPostRequest) DryRun(dryRun bool) ApiMembershipsPaymentsImportPostRequest {
+	r.dryRun = &dryRun
 	return r
 }
 
-func (r ApiMembersMemberIdPutRequest) Execute() (*MembersPost201Response, *http.Response, error) {
-	return r.ApiService.MembersMemberIdPutExecute(r)
+// Сохранить корректные строки, пропустив ошибочные
+func (r ApiMembershipsPaymentsImportPostRequest) SkipInvalid(skipInvalid bool) ApiMembershipsPaymentsImportPostRequest {
+	r.skipInvalid = &skipInvalid
+	return r
+}
+
+func (r ApiMembershipsPaymentsImportPostRequest) Body(body string) ApiMembershipsPaymentsImportPostRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiMembershipsPaymentsImportPostRequest) Execute() (*PaymentImportReport, *http.Response, error) {
+	return r.ApiService.MembershipsPaymentsImportPostExecute(r)
 }
 
 /*
-MembersMemberIdPut Обновление данных участника (имя, email и т.д.)
+MembershipsPaymentsImportPost Массовый импорт взносов из CSV
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@param memberId
-	@return ApiMembersMemberIdPutRequest
+	@return ApiMembershipsPaymentsImportPostRequest
 */
-func (a *DefaultAPIService) MembersMemberIdPut(ctx context.Context, memberId string) ApiMembersMemberIdPutRequest {
-	return ApiMembersMemberIdPutRequest{
+func (a *DefaultAPIService) MembershipsPaymentsImportPost(ctx context.Context) ApiMembershipsPaymentsImportPostRequest {
+	return ApiMembershipsPaymentsImportPostRequest{
 		ApiService: a,
 		ctx:        ctx,
-		memberId:   memberId,
 	}
 }
 
 // Execute executes the request
 //
-//	@return MembersPost201Response
-func (a *DefaultAPIService) MembersMemberIdPutExecute(r ApiMembersMemberIdPutRequest) (*MembersPost201Response, *http.Response, error) {
+//	@return PaymentImportReport
+func (a *DefaultAPIService) MembershipsPaymentsImportPostExecute(r ApiMembershipsPaymentsImportPostRequest) (*PaymentImportReport, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodPut
+		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *MembersPost201Response
+		localVarReturnValue *PaymentImportReport
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.MembersMemberIdPut")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.MembershipsPaymentsImportPost")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/members/{memberId}"
-	localVarPath = strings.Replace(localVarPath, "{"+"memberId"+"}", url.PathEscape(parameterValueToString(r.memberId, "memberId")), -1)
+	localVarPath := localBasePath + "/memberships/payments/import"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-	if r.updateMemberProfileRequest == nil {
-		return localVarReturnValue, nil, reportError("updateMemberProfileRequest is required and must be specified")
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
 	}
 
+	if r.dryRun != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "dry_run", r.dryRun, "")
+	}
+	if r.skipInvalid != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "skip_invalid", r.skipInvalid, "")
+	}
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{"application/json"}
+	localVarHTTPContentTypes := []string{"text/csv"}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -2428,7 +7626,7 @@ func (a *DefaultAPIService) MembersMemberIdPutExecute(r ApiMembersMemberIdPutReq
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
 	// body params
-	localVarPostBody = r.updateMemberProfileRequest
+	localVarPostBody = r.body
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -2452,7 +7650,7 @@ func (a *DefaultAPIService) MembersMemberIdPutExecute(r ApiMembersMemberIdPutReq
 			error: localVarHTTPResponse.Status,
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
-			var v ErrorResponse
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -2462,8 +7660,19 @@ func (a *DefaultAPIService) MembersMemberIdPutExecute(r ApiMembersMemberIdPutReq
 			newErr.model = v
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
-		if localVarHTTPResponse.StatusCode == 404 {
-			var v ErrorResponse
+		if localVarHTTPResponse.StatusCode == 409 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 422 {
+			var v PaymentImportReport
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -2474,7 +7683,7 @@ func (a *DefaultAPIService) MembersMemberIdPutExecute(r ApiMembersMemberIdPutReq
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
-			var v ErrorResponse
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -2498,29 +7707,30 @@ func (a *DefaultAPIService) MembersMemberIdPutExecute(r ApiMembersMemberIdPutReq
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiMembersPostRequest struct {
-	ctx              context.Context
-	ApiService       *DefaultAPIService
-	newMemberRequest *NewMemberRequest
+type ApiMembershipsRenewalsGetRequest struct {
+	ctx        context.Context
+	ApiService *DefaultAPIService
+	withinDays *int32
 }
 
-func (r ApiMembersPostRequest) NewMemberRequest(newMemberRequest NewMemberRequest) ApiMembersPostRequest {
-	r.newMemberRequest = &newMemberRequest
+// Сколько дней вперед смотреть; по умолчанию membership.renewal_window из конфигурации. Истекшие членства входят в отчет всегда.
+func (r ApiMembershipsRenewalsGetRequest) WithinDays(withinDays int32) ApiMembershipsRenewalsGetRequest {
+	r.withinDays = &withinDays
 	return r
 }
 
-func (r ApiMembersPostRequest) Execute() (*MembersPost201Response, *http.Response, error) {
-	return r.ApiService.MembersPostExecute(r)
+func (r ApiMembershipsRenewalsGetRequest) Execute() ([]RenewalResponse, *http.Response, error) {
+	return r.ApiService.MembershipsRenewalsGetExecute(r)
 }
 
 /*
-MembersPost Регистрация нового участника клуба
+MembershipsRenewalsGet Участники, которым пора продлить членство
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiMembersPostRequest
+	@return ApiMembershipsRenewalsGetRequest
 */
-func (a *DefaultAPIService) MembersPost(ctx context.Context) ApiMembersPostRequest {
-	return ApiMembersPostRequest{
+func (a *DefaultAPIService) MembershipsRenewalsGet(ctx context.Context) ApiMembershipsRenewalsGetRequest {
+	return ApiMembershipsRenewalsGetRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -2528,31 +7738,31 @@ func (a *DefaultAPIService) MembersPost(ctx context.Context) ApiMembersPostReque
 
 // Execute executes the request
 //
-//	@return MembersPost201Response
-func (a *DefaultAPIService) MembersPostExecute(r ApiMembersPostRequest) (*MembersPost201Response, *http.Response, error) {
+//	@return []RenewalResponse
+func (a *DefaultAPIService) MembershipsRenewalsGetExecute(r ApiMembershipsRenewalsGetRequest) ([]RenewalResponse, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodPost
+		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *MembersPost201Response
+		localVarReturnValue []RenewalResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.MembersPost")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.MembershipsRenewalsGet")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/members"
+	localVarPath := localBasePath + "/memberships/renewals"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-	if r.newMemberRequest == nil {
-		return localVarReturnValue, nil, reportError("newMemberRequest is required and must be specified")
-	}
 
+	if r.withinDays != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "within_days", r.withinDays, "")
+	}
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{"application/json"}
+	localVarHTTPContentTypes := []string{}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -2568,8 +7778,6 @@ func (a *DefaultAPIService) MembersPostExecute(r ApiMembersPostRequest) (*Member
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	// body params
-	localVarPostBody = r.newMemberRequest
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -2593,7 +7801,7 @@ func (a *DefaultAPIService) MembersPostExecute(r ApiMembersPostRequest) (*Member
 			error: localVarHTTPResponse.Status,
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
-			var v ErrorResponse
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -2604,7 +7812,7 @@ func (a *DefaultAPIService) MembersPostExecute(r ApiMembersPostRequest) (*Member
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
-			var v ErrorResponse
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -2629,8 +7837,20 @@ func (a *DefaultAPIService) MembersPostExecute(r ApiMembersPostRequest) (*Member
 }
 
 type ApiTypesGetRequest struct {
-	ctx        context.Context
-	ApiService *DefaultAPIService
+	ctx            context.Context
+	ApiService     *DefaultAPIService
+	ifNoneMatch    *string
+	acceptLanguage *string
+}
+
+func (r ApiTypesGetRequest) IfNoneMatch(ifNoneMatch string) ApiTypesGetRequest {
+	r.ifNoneMatch = &ifNoneMatch
+	return r
+}
+
+func (r ApiTypesGetRequest) AcceptLanguage(acceptLanguage string) ApiTypesGetRequest {
+	r.acceptLanguage = &acceptLanguage
+	return r
 }
 
 func (r ApiTypesGetRequest) Execute() ([]EventTypeResponse, *http.Response, error) {
@@ -2689,6 +7909,12 @@ func (a *DefaultAPIService) TypesGetExecute(r ApiTypesGetRequest) ([]EventTypeRe
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	if r.ifNoneMatch != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "If-None-Match", r.ifNoneMatch, "")
+	}
+	if r.acceptLanguage != nil {
+		parameterAddToHeaderOrQuery(localVarHeaderParams, "Accept-Language", r.acceptLanguage, "")
+	}
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -2712,7 +7938,7 @@ func (a *DefaultAPIService) TypesGetExecute(r ApiTypesGetRequest) ([]EventTypeRe
 			error: localVarHTTPResponse.Status,
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
-			var v ErrorResponse
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -2831,7 +8057,7 @@ func (a *DefaultAPIService) TypesPostExecute(r ApiTypesPostRequest) (*EventsPost
 			error: localVarHTTPResponse.Status,
 		}
 		if localVarHTTPResponse.StatusCode == 400 {
-			var v ErrorResponse
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -2842,7 +8068,7 @@ func (a *DefaultAPIService) TypesPostExecute(r ApiTypesPostRequest) (*EventsPost
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
 		if localVarHTTPResponse.StatusCode == 500 {
-			var v ErrorResponse
+			var v Problem
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -2865,3 +8091,151 @@ func (a *DefaultAPIService) TypesPostExecute(r ApiTypesPostRequest) (*EventsPost
 
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
+
+type ApiTypesTypeIdTranslationsLangPutRequest struct {
+	ctx                 context.Context
+	ApiService          *DefaultAPIService
+	typeId              int32
+	lang                string
+	newEventTypeRequest *NewEventTypeRequest
+}
+
+func (r ApiTypesTypeIdTranslationsLangPutRequest) NewEventTypeRequest(newEventTypeRequest NewEventTypeRequest) ApiTypesTypeIdTranslationsLangPutRequest {
+	r.newEventTypeRequest = &newEventTypeRequest
+	return r
+}
+
+func (r ApiTypesTypeIdTranslationsLangPutRequest) Execute() (*http.Response, error) {
+	return r.ApiService.TypesTypeIdTranslationsLangPutExecute(r)
+}
+
+/*
+TypesTypeIdTranslationsLangPut Перевод типа события
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param typeId
+	@param lang Язык перевода (ru, en)
+	@return ApiTypesTypeIdTranslationsLangPutRequest
+*/
+func (a *DefaultAPIService) TypesTypeIdTranslationsLangPut(ctx context.Context, typeId int32, lang string) ApiTypesTypeIdTranslationsLangPutRequest {
+	return ApiTypesTypeIdTranslationsLangPutRequest{
+		ApiService: a,
+		ctx:        ctx,
+		typeId:     typeId,
+		lang:       lang,
+	}
+}
+
+// Execute executes the request
+func (a *DefaultAPIService) TypesTypeIdTranslationsLangPutExecute(r ApiTypesTypeIdTranslationsLangPutRequest) (*http.Response, error) {
+	var (
+		localVarHTTPMethod = http.MethodPut
+		localVarPostBody   interface{}
+		formFiles          []formFile
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "DefaultAPIService.TypesTypeIdTranslationsLangPut")
+	if err != nil {
+		return nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/types/{typeId}/translations/{lang}"
+	localVarPath = strings.Replace(localVarPath, "{"+"typeId"+"}", url.PathEscape(parameterValueToString(r.typeId, "typeId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"lang"+"}", url.PathEscape(parameterValueToString(r.lang, "lang")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.newEventTypeRequest == nil {
+		return nil, reportError("newEventTypeRequest is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.newEventTypeRequest
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 422 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Problem
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarHTTPResponse, newErr
+	}
+
+	return localVarHTTPResponse, nil
+}
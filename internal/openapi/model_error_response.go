@@ -1,160 +0,0 @@
-/*
-Orchestra API
-
-Микросервис API для \"Клуба друзей оркестра\". **Все пользователи считаются равными**, а доступ из внешнего мира осуществляется через Telegram-бот.
-
-API version: 1.0.0
-*/
-
-// Code generated by OpenAPI Generator (https://openapi-generator.tech); DO NOT EDIT.
-
-package openapi
-
-import (
-	"encoding/json"
-)
-
-// checks if the ErrorResponse type satisfies the MappedNullable interface at compile time
-var _ MappedNullable = &ErrorResponse{}
-
-// ErrorResponse struct for ErrorResponse
-type ErrorResponse struct {
-	Code    *int32  `json:"code,omitempty"`
-	Message *string `json:"message,omitempty"`
-}
-
-// NewErrorResponse instantiates a new ErrorResponse object
-// This constructor will assign default values to properties that have it defined,
-// and makes sure properties required by API are set, but the set of arguments
-// will change when the set of required properties is changed
-func NewErrorResponse() *ErrorResponse {
-	this := ErrorResponse{}
-	return &this
-}
-
-// NewErrorResponseWithDefaults instantiates a new ErrorResponse object
-// This constructor will only assign default values to properties that have it defined,
-// but it doesn't guarantee that properties required by API are set
-func NewErrorResponseWithDefaults() *ErrorResponse {
-	this := ErrorResponse{}
-	return &this
-}
-
-// GetCode returns the Code field value if set, zero value otherwise.
-func (o *ErrorResponse) GetCode() int32 {
-	if o == nil || IsNil(o.Code) {
-		var ret int32
-		return ret
-	}
-	return *o.Code
-}
-
-// GetCodeOk returns a tuple with the Code field value if set, nil otherwise
-// and a boolean to check if the value has been set.
-func (o *ErrorResponse) GetCodeOk() (*int32, bool) {
-	if o == nil || IsNil(o.Code) {
-		return nil, false
-	}
-	return o.Code, true
-}
-
-// HasCode returns a boolean if a field has been set.
-func (o *ErrorResponse) HasCode() bool {
-	if o != nil && !IsNil(o.Code) {
-		return true
-	}
-
-	return false
-}
-
-// SetCode gets a reference to the given int32 and assigns it to the Code field.
-func (o *ErrorResponse) SetCode(v int32) {
-	o.Code = &v
-}
-
-// GetMessage returns the Message field value if set, zero value otherwise.
-func (o *ErrorResponse) GetMessage() string {
-	if o == nil || IsNil(o.Message) {
-		var ret string
-		return ret
-	}
-	return *o.Message
-}
-
-// GetMessageOk returns a tuple with the Message field value if set, nil otherwise
-// and a boolean to check if the value has been set.
-func (o *ErrorResponse) GetMessageOk() (*string, bool) {
-	if o == nil || IsNil(o.Message) {
-		return nil, false
-	}
-	return o.Message, true
-}
-
-// HasMessage returns a boolean if a field has been set.
-func (o *ErrorResponse) HasMessage() bool {
-	if o != nil && !IsNil(o.Message) {
-		return true
-	}
-
-	return false
-}
-
-// SetMessage gets a reference to the given string and assigns it to the Message field.
-func (o *ErrorResponse) SetMessage(v string) {
-	o.Message = &v
-}
-
-func (o ErrorResponse) MarshalJSON() ([]byte, error) {
-	toSerialize, err := o.ToMap()
-	if err != nil {
-		return []byte{}, err
-	}
-	return json.Marshal(toSerialize)
-}
-
-func (o ErrorResponse) ToMap() (map[string]interface{}, error) {
-	toSerialize := map[string]interface{}{}
-	if !IsNil(o.Code) {
-		toSerialize["code"] = o.Code
-	}
-	if !IsNil(o.Message) {
-		toSerialize["message"] = o.Message
-	}
-	return toSerialize, nil
-}
-
-type NullableErrorResponse struct {
-	value *ErrorResponse
-	isSet bool
-}
-
-func (v NullableErrorResponse) Get() *ErrorResponse {
-	return v.value
-}
-
-func (v *NullableErrorResponse) Set(val *ErrorResponse) {
-	v.value = val
-	v.isSet = true
-}
-
-func (v NullableErrorResponse) IsSet() bool {
-	return v.isSet
-}
-
-func (v *NullableErrorResponse) Unset() {
-	v.value = nil
-	v.isSet = false
-}
-
-func NewNullableErrorResponse(val *ErrorResponse) *NullableErrorResponse {
-	return &NullableErrorResponse{value: val, isSet: true}
-}
-
-func (v NullableErrorResponse) MarshalJSON() ([]byte, error) {
-	return json.Marshal(v.value)
-}
-
-func (v *NullableErrorResponse) UnmarshalJSON(src []byte) error {
-	v.isSet = true
-	return json.Unmarshal(src, &v.value)
-}
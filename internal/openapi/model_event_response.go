@@ -20,15 +20,17 @@ var _ MappedNullable = &EventResponse{}
 
 // EventResponse struct for EventResponse
 type EventResponse struct {
-	Id          *int32     `json:"id,omitempty"`
-	Title       *string    `json:"title,omitempty"`
-	Description *string    `json:"description,omitempty"`
-	EventType   *int32     `json:"event_type,omitempty"`
-	EventDate   *time.Time `json:"event_date,omitempty"`
-	Location    *int32     `json:"location,omitempty"`
-	Capacity    *int32     `json:"capacity,omitempty"`
-	CreatedAt   *time.Time `json:"created_at,omitempty"`
-	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	Id          *int32       `json:"id,omitempty"`
+	Title       *string      `json:"title,omitempty"`
+	Description *string      `json:"description,omitempty"`
+	EventType   *int32       `json:"event_type,omitempty"`
+	EventDate   *time.Time   `json:"event_date,omitempty"`
+	Location    *int32       `json:"location,omitempty"`
+	Capacity    *int32       `json:"capacity,omitempty"`
+	Price       *int64       `json:"price,omitempty"`
+	Access      *EventAccess `json:"access,omitempty"`
+	CreatedAt   *time.Time   `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time   `json:"updated_at,omitempty"`
 }
 
 // NewEventResponse instantiates a new EventResponse object
@@ -272,6 +274,70 @@ func (o *EventResponse) SetCapacity(v int32) {
 	o.Capacity = &v
 }
 
+// GetPrice returns the Price field value if set, zero value otherwise.
+func (o *EventResponse) GetPrice() int64 {
+	if o == nil || IsNil(o.Price) {
+		var ret int64
+		return ret
+	}
+	return *o.Price
+}
+
+// GetPriceOk returns a tuple with the Price field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *EventResponse) GetPriceOk() (*int64, bool) {
+	if o == nil || IsNil(o.Price) {
+		return nil, false
+	}
+	return o.Price, true
+}
+
+// HasPrice returns a boolean if a field has been set.
+func (o *EventResponse) HasPrice() bool {
+	if o != nil && !IsNil(o.Price) {
+		return true
+	}
+
+	return false
+}
+
+// SetPrice gets a reference to the given int64 and assigns it to the Price field.
+func (o *EventResponse) SetPrice(v int64) {
+	o.Price = &v
+}
+
+// GetAccess returns the Access field value if set, zero value otherwise.
+func (o *EventResponse) GetAccess() EventAccess {
+	if o == nil || IsNil(o.Access) {
+		var ret EventAccess
+		return ret
+	}
+	return *o.Access
+}
+
+// GetAccessOk returns a tuple with the Access field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *EventResponse) GetAccessOk() (*EventAccess, bool) {
+	if o == nil || IsNil(o.Access) {
+		return nil, false
+	}
+	return o.Access, true
+}
+
+// HasAccess returns a boolean if a field has been set.
+func (o *EventResponse) HasAccess() bool {
+	if o != nil && !IsNil(o.Access) {
+		return true
+	}
+
+	return false
+}
+
+// SetAccess gets a reference to the given EventAccess and assigns it to the Access field.
+func (o *EventResponse) SetAccess(v EventAccess) {
+	o.Access = &v
+}
+
 // GetCreatedAt returns the CreatedAt field value if set, zero value otherwise.
 func (o *EventResponse) GetCreatedAt() time.Time {
 	if o == nil || IsNil(o.CreatedAt) {
@@ -367,6 +433,12 @@ func (o EventResponse) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Capacity) {
 		toSerialize["capacity"] = o.Capacity
 	}
+	if !IsNil(o.Price) {
+		toSerialize["price"] = o.Price
+	}
+	if !IsNil(o.Access) {
+		toSerialize["access"] = o.Access
+	}
 	if !IsNil(o.CreatedAt) {
 		toSerialize["created_at"] = o.CreatedAt
 	}
@@ -22,13 +22,16 @@ var _ MappedNullable = &MemberResponse{}
 
 // MemberResponse struct for MemberResponse
 type MemberResponse struct {
-	FullName  string     `json:"full_name"`
-	Email     string     `json:"email"`
-	Phone     string     `json:"phone" validate:"regexp=^7\\\\\\\\d{10}$"`
-	Id        *string    `json:"id,omitempty"`
-	Status    *string    `json:"status,omitempty"`
-	CreatedAt *time.Time `json:"created_at,omitempty"`
-	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	FullName        string     `json:"full_name"`
+	Email           string     `json:"email"`
+	Phone           string     `json:"phone"`
+	Id              *string    `json:"id,omitempty"`
+	Status          *string    `json:"status,omitempty"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+	PhoneVerifiedAt *time.Time `json:"phone_verified_at,omitempty"`
+	Language        *string    `json:"language,omitempty"`
+	CreatedAt       *time.Time `json:"created_at,omitempty"`
+	UpdatedAt       *time.Time `json:"updated_at,omitempty"`
 }
 
 type _MemberResponse MemberResponse
@@ -189,6 +192,102 @@ func (o *MemberResponse) SetStatus(v string) {
 	o.Status = &v
 }
 
+// GetEmailVerifiedAt returns the EmailVerifiedAt field value if set, zero value otherwise.
+func (o *MemberResponse) GetEmailVerifiedAt() time.Time {
+	if o == nil || IsNil(o.EmailVerifiedAt) {
+		var ret time.Time
+		return ret
+	}
+	return *o.EmailVerifiedAt
+}
+
+// GetEmailVerifiedAtOk returns a tuple with the EmailVerifiedAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *MemberResponse) GetEmailVerifiedAtOk() (*time.Time, bool) {
+	if o == nil || IsNil(o.EmailVerifiedAt) {
+		return nil, false
+	}
+	return o.EmailVerifiedAt, true
+}
+
+// HasEmailVerifiedAt returns a boolean if a field has been set.
+func (o *MemberResponse) HasEmailVerifiedAt() bool {
+	if o != nil && !IsNil(o.EmailVerifiedAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetEmailVerifiedAt gets a reference to the given time.Time and assigns it to the EmailVerifiedAt field.
+func (o *MemberResponse) SetEmailVerifiedAt(v time.Time) {
+	o.EmailVerifiedAt = &v
+}
+
+// GetPhoneVerifiedAt returns the PhoneVerifiedAt field value if set, zero value otherwise.
+func (o *MemberResponse) GetPhoneVerifiedAt() time.Time {
+	if o == nil || IsNil(o.PhoneVerifiedAt) {
+		var ret time.Time
+		return ret
+	}
+	return *o.PhoneVerifiedAt
+}
+
+// GetPhoneVerifiedAtOk returns a tuple with the PhoneVerifiedAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *MemberResponse) GetPhoneVerifiedAtOk() (*time.Time, bool) {
+	if o == nil || IsNil(o.PhoneVerifiedAt) {
+		return nil, false
+	}
+	return o.PhoneVerifiedAt, true
+}
+
+// HasPhoneVerifiedAt returns a boolean if a field has been set.
+func (o *MemberResponse) HasPhoneVerifiedAt() bool {
+	if o != nil && !IsNil(o.PhoneVerifiedAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetPhoneVerifiedAt gets a reference to the given time.Time and assigns it to the PhoneVerifiedAt field.
+func (o *MemberResponse) SetPhoneVerifiedAt(v time.Time) {
+	o.PhoneVerifiedAt = &v
+}
+
+// GetLanguage returns the Language field value if set, zero value otherwise.
+func (o *MemberResponse) GetLanguage() string {
+	if o == nil || IsNil(o.Language) {
+		var ret string
+		return ret
+	}
+	return *o.Language
+}
+
+// GetLanguageOk returns a tuple with the Language field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *MemberResponse) GetLanguageOk() (*string, bool) {
+	if o == nil || IsNil(o.Language) {
+		return nil, false
+	}
+	return o.Language, true
+}
+
+// HasLanguage returns a boolean if a field has been set.
+func (o *MemberResponse) HasLanguage() bool {
+	if o != nil && !IsNil(o.Language) {
+		return true
+	}
+
+	return false
+}
+
+// SetLanguage gets a reference to the given string and assigns it to the Language field.
+func (o *MemberResponse) SetLanguage(v string) {
+	o.Language = &v
+}
+
 // GetCreatedAt returns the CreatedAt field value if set, zero value otherwise.
 func (o *MemberResponse) GetCreatedAt() time.Time {
 	if o == nil || IsNil(o.CreatedAt) {
@@ -272,6 +371,15 @@ func (o MemberResponse) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Status) {
 		toSerialize["status"] = o.Status
 	}
+	if !IsNil(o.EmailVerifiedAt) {
+		toSerialize["email_verified_at"] = o.EmailVerifiedAt
+	}
+	if !IsNil(o.PhoneVerifiedAt) {
+		toSerialize["phone_verified_at"] = o.PhoneVerifiedAt
+	}
+	if !IsNil(o.Language) {
+		toSerialize["language"] = o.Language
+	}
 	if !IsNil(o.CreatedAt) {
 		toSerialize["created_at"] = o.CreatedAt
 	}
@@ -23,7 +23,7 @@ var _ MappedNullable = &NewMemberRequest{}
 type NewMemberRequest struct {
 	FullName string `json:"full_name"`
 	Email    string `json:"email"`
-	Phone    string `json:"phone" validate:"regexp=^7\\\\\\\\d{10}$"`
+	Phone    string `json:"phone"`
 }
 
 type _NewMemberRequest NewMemberRequest
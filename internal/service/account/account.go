@@ -0,0 +1,316 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/config"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/i18n"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/storage"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/tracing"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"github.com/Ilya-Repin/orchestra_api/internal/service"
+	"github.com/google/uuid"
+	"log/slog"
+	"time"
+)
+
+// Service is the member's self-service: what we store about them, their notification
+// preferences, a copy of their data and the erasure of it.
+type Service struct {
+	log     *slog.Logger
+	storage AccountStorage
+	mailer  Mailer
+	settler PaymentSettler
+	catalog *i18n.Catalog
+	cfg     config.VerificationConfig
+}
+
+type AccountStorage interface {
+	GetMemberFromPrimary(ctx context.Context, id uuid.UUID) (model.Member, error)
+	GetMemberRegistrations(ctx context.Context, memberID uuid.UUID) ([]model.MemberRegistration, error)
+	SetNotificationPreferences(ctx context.Context, memberID uuid.UUID, prefs model.NotificationPreferences) error
+	SaveAccountCode(ctx context.Context, code model.AccountCode, replaceBefore time.Time) error
+	UseAccountCode(ctx context.Context, memberID uuid.UUID, purpose model.CodePurpose) (model.AccountCode, error)
+	DeleteAccountCode(ctx context.Context, memberID uuid.UUID, purpose model.CodePurpose) error
+	EraseMember(ctx context.Context, id uuid.UUID, version int) ([]model.EventPayment, error)
+}
+
+type Mailer interface {
+	SendEmail(ctx context.Context, to, subject, body string) error
+}
+
+// PaymentSettler tells the payment provider about the payments of registrations an erasure
+// cancelled.
+type PaymentSettler interface {
+	SettlePayments(ctx context.Context, payments []model.EventPayment)
+}
+
+// New creates the service. Erasure and export codes follow the lifetime, attempt and resend
+// limits of verification codes.
+func New(log *slog.Logger, storage AccountStorage, mailer Mailer, settler PaymentSettler, catalog *i18n.Catalog, cfg config.VerificationConfig) *Service {
+	return &Service{
+		log:     log.With("component", "service"),
+		storage: storage,
+		mailer:  mailer,
+		settler: settler,
+		catalog: catalog,
+		cfg:     cfg,
+	}
+}
+
+func (s *Service) GetProfile(ctx context.Context, memberID uuid.UUID) (model.Member, error) {
+	const op = "account.Service.GetProfile"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.String("member_id", memberID.String()))
+
+	member, err := s.storage.GetMemberFromPrimary(ctx, memberID)
+	if err != nil {
+		if errors.Is(err, storage.ErrMemberNotFound) {
+			log.Warn("member not found", "error", err)
+			return model.Member{}, fmt.Errorf("%s: %w", op, service.ErrMemberNotFound)
+		}
+
+		log.Error("failed to get member", "error", err)
+		return model.Member{}, fmt.Errorf("%s: %w", op, service.ErrFailedToGetMember)
+	}
+
+	return member, nil
+}
+
+func (s *Service) GetRegistrations(ctx context.Context, memberID uuid.UUID) ([]model.MemberRegistration, error) {
+	const op = "account.Service.GetRegistrations"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.String("member_id", memberID.String()))
+
+	if _, err := s.GetProfile(ctx, memberID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	registrations, err := s.storage.GetMemberRegistrations(ctx, memberID)
+	if err != nil {
+		log.Error("failed to get registrations", "error", err)
+		return nil, fmt.Errorf("%s: %w", op, service.ErrFailedToGetMemberRegs)
+	}
+
+	return registrations, nil
+}
+
+func (s *Service) SetNotificationPreferences(ctx context.Context, memberID uuid.UUID, prefs model.NotificationPreferences) error {
+	const op = "account.Service.SetNotificationPreferences"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.String("member_id", memberID.String()))
+	log.Info("saving notification preferences", "email", prefs.Email, "sms", prefs.SMS)
+
+	if err := s.storage.SetNotificationPreferences(ctx, memberID, prefs); err != nil {
+		if errors.Is(err, storage.ErrMemberNotFound) {
+			log.Warn("member not found", "error", err)
+			return fmt.Errorf("%s: %w", op, service.ErrMemberNotFound)
+		}
+
+		if cerr := service.ConstraintError(err); cerr != nil {
+			log.Warn("rejected by a constraint", "error", err)
+			return fmt.Errorf("%s: %w", op, cerr)
+		}
+		log.Error("failed to save notification preferences", "error", err)
+		return fmt.Errorf("%s: %w", op, service.ErrFailedToSavePreferences)
+	}
+
+	return nil
+}
+
+// RequestExport starts an export of the member's data: a one-time code is sent to their
+// email and the data is handed over once it is confirmed. It returns the moment the code
+// expires.
+func (s *Service) RequestExport(ctx context.Context, memberID uuid.UUID) (time.Time, error) {
+	const op = "account.Service.RequestExport"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.String("member_id", memberID.String()))
+	log.Info("requesting data export")
+
+	expiresAt, err := s.sendCode(ctx, log, memberID, model.CodeExport, "Personal data export",
+		"Hello, %s!\n\nYou asked for a copy of your personal data stored by the orchestra friends club. To get it, use the code: %s\nThe code is valid for %s.\n\nIf you did not ask for this, just ignore this email.\n")
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("export code sent", "expires_at", expiresAt)
+	return expiresAt, nil
+}
+
+// ExportData checks code against the pending export request and, on success, collects
+// everything stored about the member. The code serves a single export.
+func (s *Service) ExportData(ctx context.Context, memberID uuid.UUID, code string) (model.MemberData, error) {
+	const op = "account.Service.ExportData"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.String("member_id", memberID.String()))
+	log.Info("exporting member data")
+
+	if err := s.checkCode(ctx, log, memberID, model.CodeExport, code, service.ErrFailedToExportData); err != nil {
+		return model.MemberData{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	member, err := s.GetProfile(ctx, memberID)
+	if err != nil {
+		return model.MemberData{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	registrations, err := s.storage.GetMemberRegistrations(ctx, memberID)
+	if err != nil {
+		log.Error("failed to get registrations", "error", err)
+		return model.MemberData{}, fmt.Errorf("%s: %w", op, service.ErrFailedToExportData)
+	}
+
+	if err := s.storage.DeleteAccountCode(ctx, memberID, model.CodeExport); err != nil {
+		// the code expires anyway, the member still gets the data
+		log.Error("failed to delete export code", "error", err)
+	}
+
+	return model.MemberData{
+		Member:        member,
+		Registrations: registrations,
+		ExportedAt:    time.Now(),
+	}, nil
+}
+
+// RequestErasure starts the erasure of the member's personal data: a one-time code is sent
+// to their email and the erasure happens once it is confirmed. It returns the moment the
+// code expires.
+func (s *Service) RequestErasure(ctx context.Context, memberID uuid.UUID) (time.Time, error) {
+	const op = "account.Service.RequestErasure"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.String("member_id", memberID.String()))
+	log.Info("requesting erasure")
+
+	expiresAt, err := s.sendCode(ctx, log, memberID, model.CodeErasure, "Personal data erasure",
+		"Hello, %s!\n\nYou asked to erase your personal data from the orchestra friends club. To confirm, use the code: %s\nThe code is valid for %s.\n\nYour name, email and phone will be erased irreversibly. If you did not ask for this, just ignore this email.\n")
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("erasure code sent", "expires_at", expiresAt)
+	return expiresAt, nil
+}
+
+// ConfirmErasure checks code against the pending erasure request and, on success, erases
+// the member's personal data. Every call counts as an attempt.
+func (s *Service) ConfirmErasure(ctx context.Context, memberID uuid.UUID, code string) error {
+	const op = "account.Service.ConfirmErasure"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.String("member_id", memberID.String()))
+	log.Info("confirming erasure")
+
+	if err := s.checkCode(ctx, log, memberID, model.CodeErasure, code, service.ErrFailedToErase); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	payments, err := s.storage.EraseMember(ctx, memberID, 0)
+	if err != nil {
+		if errors.Is(err, storage.ErrMemberNotFound) {
+			return fmt.Errorf("%s: %w", op, service.ErrMemberNotFound)
+		}
+
+		log.Error("failed to erase member", "error", err)
+		return fmt.Errorf("%s: %w", op, service.ErrFailedToErase)
+	}
+	s.settler.SettlePayments(ctx, payments)
+
+	log.Info("member data erased")
+	return nil
+}
+
+// sendCode stores a new code for the purpose and emails it to the member in their language;
+// subject and body are catalog keys, body taking the name, the code and its lifetime. It
+// returns the moment the code expires.
+func (s *Service) sendCode(ctx context.Context, log *slog.Logger, memberID uuid.UUID, purpose model.CodePurpose, subject, body string) (time.Time, error) {
+	member, err := s.GetProfile(ctx, memberID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	code, err := service.GenerateCode()
+	if err != nil {
+		log.Error("failed to generate code", "error", err)
+		return time.Time{}, service.ErrFailedToSendCode
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(s.cfg.CodeTTL)
+
+	err = s.storage.SaveAccountCode(ctx, model.AccountCode{
+		MemberID:  memberID,
+		Purpose:   purpose,
+		CodeHash:  service.HashCode(s.cfg.Secret, memberID, string(purpose), code),
+		ExpiresAt: expiresAt,
+	}, now.Add(-s.cfg.ResendAfter))
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrCodeRecentlySent):
+			log.Warn("code was sent recently")
+			return time.Time{}, service.ErrCodeRecentlySent
+		case errors.Is(err, storage.ErrMemberNotFound):
+			return time.Time{}, service.ErrMemberNotFound
+		}
+
+		log.Error("failed to save code", "error", err)
+		return time.Time{}, service.ErrFailedToSendCode
+	}
+
+	lang, ok := i18n.Parse(member.Language)
+	if !ok {
+		lang = i18n.Default
+	}
+	loc := s.catalog.Localizer(lang)
+
+	err = s.mailer.SendEmail(ctx, member.Email, loc.T(subject),
+		loc.T(body, member.FullName, code, loc.N("%d minutes", int(s.cfg.CodeTTL.Minutes()))))
+	if err != nil {
+		log.Error("failed to deliver code", "error", err)
+		return time.Time{}, service.ErrFailedToSendCode
+	}
+
+	return expiresAt, nil
+}
+
+// checkCode counts an attempt against the member's code for the purpose and checks code
+// against it. failed is returned when the code can not be read.
+func (s *Service) checkCode(ctx context.Context, log *slog.Logger, memberID uuid.UUID, purpose model.CodePurpose, code string, failed error) error {
+	req, err := s.storage.UseAccountCode(ctx, memberID, purpose)
+	if err != nil {
+		if errors.Is(err, storage.ErrCodeNotFound) {
+			log.Warn("no code requested")
+			return service.ErrCodeNotFound
+		}
+
+		log.Error("failed to get code", "error", err)
+		return failed
+	}
+
+	switch {
+	case req.Attempts > s.cfg.MaxAttempts:
+		log.Warn("too many attempts", "attempts", req.Attempts)
+		return service.ErrTooManyAttempts
+	case time.Now().After(req.ExpiresAt):
+		log.Warn("code expired", "expires_at", req.ExpiresAt)
+		return service.ErrCodeExpired
+	case !service.CodeMatches(req.CodeHash, s.cfg.Secret, memberID, string(purpose), code):
+		log.Warn("invalid code", "attempts", req.Attempts)
+		return service.ErrInvalidCode
+	}
+
+	return nil
+}
@@ -0,0 +1,284 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/i18n"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/metrics"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/tracing"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"github.com/Ilya-Repin/orchestra_api/internal/service"
+	"log/slog"
+	"time"
+)
+
+const (
+	defaultTopLimit = 10
+	maxTopLimit     = 100
+
+	// maxPeriods caps the length of a growth series.
+	maxPeriods = 1000
+)
+
+// Service computes the event and club analytics. Registration figures are read from a
+// snapshot that RunRefreshJob keeps up to date.
+type Service struct {
+	log     *slog.Logger
+	storage AnalyticsStorage
+	metrics *metrics.Metrics
+}
+
+type AnalyticsStorage interface {
+	RefreshEventStats(ctx context.Context) error
+	GetEventStats(ctx context.Context, r model.DateRange, eventType, location *int) ([]model.EventStats, error)
+	GetEventTypeStats(ctx context.Context, r model.DateRange, lang string) ([]model.GroupStats, error)
+	GetLocationStats(ctx context.Context, r model.DateRange, lang string) ([]model.GroupStats, error)
+	GetMemberGrowth(ctx context.Context, r model.DateRange, interval model.Interval) ([]model.GrowthPoint, error)
+	GetMemberFunnel(ctx context.Context, r model.DateRange) (model.Funnel, error)
+	GetActiveMembers(ctx context.Context, r model.DateRange, limit int) ([]model.ActiveMember, error)
+	GetClubSummary(ctx context.Context, r model.DateRange) (model.ClubSummary, error)
+	GetClubGauges(ctx context.Context, now time.Time) (model.ClubGauges, error)
+}
+
+func New(log *slog.Logger, storage AnalyticsStorage, metrics *metrics.Metrics) *Service {
+	return &Service{log: log.With("component", "service"), storage: storage, metrics: metrics}
+}
+
+// eventRange is the date range of event figures: a missing bound defaults to a year before
+// or after now, so upcoming events are covered too.
+func eventRange(from, to *time.Time) (model.DateRange, error) {
+	now := time.Now()
+	return dateRange(from, to, now.AddDate(-1, 0, 0), now.AddDate(1, 0, 0))
+}
+
+// memberRange is the date range of membership figures: the last year unless given.
+func memberRange(from, to *time.Time) (model.DateRange, error) {
+	now := time.Now()
+	return dateRange(from, to, now.AddDate(-1, 0, 0), now)
+}
+
+func dateRange(from, to *time.Time, defaultFrom, defaultTo time.Time) (model.DateRange, error) {
+	r := model.DateRange{From: defaultFrom, To: defaultTo}
+	if from != nil {
+		r.From = *from
+	}
+	if to != nil {
+		r.To = *to
+	}
+	if !r.From.Before(r.To) {
+		return model.DateRange{}, service.InvalidField("date_from", service.ErrInvalidDateRange)
+	}
+
+	return r, nil
+}
+
+// GetEventStats returns the figures of every event held in the date range, optionally of
+// one type or at one location.
+func (s *Service) GetEventStats(ctx context.Context, from, to *time.Time, eventType, location *int) ([]model.EventStats, error) {
+	const op = "analytics.Service.GetEventStats"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op))
+
+	r, err := eventRange(from, to)
+	if err != nil {
+		log.Warn("invalid date range", "error", err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	stats, err := s.storage.GetEventStats(ctx, r, eventType, location)
+	if err != nil {
+		log.Error("failed to get event stats", "error", err)
+		return nil, fmt.Errorf("%s: %w", op, service.ErrFailedToGetAnalytics)
+	}
+
+	return stats, nil
+}
+
+// GetEventTypeStats sums up the events held in the date range by event type.
+func (s *Service) GetEventTypeStats(ctx context.Context, from, to *time.Time, lang i18n.Lang) ([]model.GroupStats, error) {
+	const op = "analytics.Service.GetEventTypeStats"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op))
+
+	r, err := eventRange(from, to)
+	if err != nil {
+		log.Warn("invalid date range", "error", err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	stats, err := s.storage.GetEventTypeStats(ctx, r, string(lang))
+	if err != nil {
+		log.Error("failed to get event type stats", "error", err)
+		return nil, fmt.Errorf("%s: %w", op, service.ErrFailedToGetAnalytics)
+	}
+
+	return stats, nil
+}
+
+// GetLocationStats sums up the events held in the date range by location.
+func (s *Service) GetLocationStats(ctx context.Context, from, to *time.Time, lang i18n.Lang) ([]model.GroupStats, error) {
+	const op = "analytics.Service.GetLocationStats"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op))
+
+	r, err := eventRange(from, to)
+	if err != nil {
+		log.Warn("invalid date range", "error", err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	stats, err := s.storage.GetLocationStats(ctx, r, string(lang))
+	if err != nil {
+		log.Error("failed to get location stats", "error", err)
+		return nil, fmt.Errorf("%s: %w", op, service.ErrFailedToGetAnalytics)
+	}
+
+	return stats, nil
+}
+
+// GetMemberGrowth returns the applications and decisions of every period of the interval
+// in the date range. The range may span at most maxPeriods periods.
+func (s *Service) GetMemberGrowth(ctx context.Context, from, to *time.Time, interval model.Interval) ([]model.GrowthPoint, error) {
+	const op = "analytics.Service.GetMemberGrowth"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.String("interval", string(interval)))
+
+	if interval == "" {
+		interval = model.IntervalMonth
+	}
+	if !interval.Valid() {
+		log.Warn("unknown interval")
+		return nil, fmt.Errorf("%s: %w", op, service.ErrUnknownInterval)
+	}
+
+	r, err := memberRange(from, to)
+	if err != nil {
+		log.Warn("invalid date range", "error", err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	periods := 0
+	for t := r.From; t.Before(r.To); t = interval.Next(t) {
+		if periods++; periods > maxPeriods {
+			log.Warn("too many periods")
+			return nil, fmt.Errorf("%s: %w", op, service.InvalidField("interval", service.ErrTooManyPeriods))
+		}
+	}
+
+	points, err := s.storage.GetMemberGrowth(ctx, r, interval)
+	if err != nil {
+		log.Error("failed to get member growth", "error", err)
+		return nil, fmt.Errorf("%s: %w", op, service.ErrFailedToGetAnalytics)
+	}
+
+	return points, nil
+}
+
+// GetMemberFunnel follows the members who applied in the date range.
+func (s *Service) GetMemberFunnel(ctx context.Context, from, to *time.Time) (model.Funnel, error) {
+	const op = "analytics.Service.GetMemberFunnel"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op))
+
+	r, err := memberRange(from, to)
+	if err != nil {
+		log.Warn("invalid date range", "error", err)
+		return model.Funnel{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	funnel, err := s.storage.GetMemberFunnel(ctx, r)
+	if err != nil {
+		log.Error("failed to get member funnel", "error", err)
+		return model.Funnel{}, fmt.Errorf("%s: %w", op, service.ErrFailedToGetAnalytics)
+	}
+
+	return funnel, nil
+}
+
+// GetActiveMembers returns the members with the most registrations for events held in the
+// date range. Without a limit defaultTopLimit members are returned, never more than maxTopLimit.
+func (s *Service) GetActiveMembers(ctx context.Context, from, to *time.Time, limit int) ([]model.ActiveMember, error) {
+	const op = "analytics.Service.GetActiveMembers"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op))
+
+	r, err := eventRange(from, to)
+	if err != nil {
+		log.Warn("invalid date range", "error", err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if limit <= 0 {
+		limit = defaultTopLimit
+	}
+	limit = min(limit, maxTopLimit)
+
+	members, err := s.storage.GetActiveMembers(ctx, r, limit)
+	if err != nil {
+		log.Error("failed to get active members", "error", err)
+		return nil, fmt.Errorf("%s: %w", op, service.ErrFailedToGetAnalytics)
+	}
+
+	return members, nil
+}
+
+// GetClubSummary returns the membership by status and the totals of the events held in the
+// date range.
+func (s *Service) GetClubSummary(ctx context.Context, from, to *time.Time) (model.ClubSummary, error) {
+	const op = "analytics.Service.GetClubSummary"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op))
+
+	r, err := eventRange(from, to)
+	if err != nil {
+		log.Warn("invalid date range", "error", err)
+		return model.ClubSummary{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	summary, err := s.storage.GetClubSummary(ctx, r)
+	if err != nil {
+		log.Error("failed to get club summary", "error", err)
+		return model.ClubSummary{}, fmt.Errorf("%s: %w", op, service.ErrFailedToGetAnalytics)
+	}
+
+	return summary, nil
+}
+
+// RunRefreshJob recomputes the registration figures. It is run by the job runner on the
+// configured interval.
+func (s *Service) RunRefreshJob(ctx context.Context) error {
+	const op = "analytics.Service.RunRefreshJob"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	if err := s.storage.RefreshEventStats(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RunGaugesJob updates the club gauges exported as metrics. It is run by the job runner on
+// the configured interval.
+func (s *Service) RunGaugesJob(ctx context.Context) error {
+	const op = "analytics.Service.RunGaugesJob"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	gauges, err := s.storage.GetClubGauges(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.metrics.MembersPending.Set(float64(gauges.PendingMembers))
+	s.metrics.UpcomingEvents.Set(float64(gauges.UpcomingEvents))
+	s.metrics.UpcomingSeatsLeft.Set(float64(gauges.SeatsLeft))
+
+	return nil
+}
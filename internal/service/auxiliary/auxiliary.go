@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/i18n"
 	"github.com/Ilya-Repin/orchestra_api/internal/infra/storage"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/tracing"
 	"github.com/Ilya-Repin/orchestra_api/internal/model"
 	"github.com/Ilya-Repin/orchestra_api/internal/service"
 	"log/slog"
+	"strings"
 )
 
 type Service struct {
@@ -16,25 +19,30 @@ type Service struct {
 }
 
 type AuxStorage interface {
-	GetEventTypes(ctx context.Context) ([]model.EventType, error)
-	GetLocations(ctx context.Context) ([]model.Location, error)
+	GetEventTypes(ctx context.Context, lang string) ([]model.EventType, error)
+	GetLocations(ctx context.Context, lang string) ([]model.Location, error)
 	GetLocation(ctx context.Context, id int) (model.Location, error)
 	GetEventType(ctx context.Context, id int) (model.EventType, error)
 	GetOrchestraInfo(ctx context.Context, key string) (model.OrchestraInfo, error)
 	AddEventType(ctx context.Context, name, description string) (int, error)
 	AddLocation(ctx context.Context, name, route, features string) (int, error)
 	AddOrchestraInfo(ctx context.Context, key, value string) error
+	SetEventTypeTranslation(ctx context.Context, id int, lang, name, description string) error
+	SetLocationTranslation(ctx context.Context, id int, lang, name, route, features string) error
 }
 
 func New(log *slog.Logger, storage AuxStorage) *Service {
 	return &Service{log: log.With("component", "service"), auxStorage: storage}
 }
 
-func (s *Service) GetEventTypes(ctx context.Context) ([]model.EventType, error) {
+// GetEventTypes lists event types described in lang where a translation exists.
+func (s *Service) GetEventTypes(ctx context.Context, lang i18n.Lang) ([]model.EventType, error) {
 	const op = "auxiliary.Service.GetEventTypes"
-	log := s.log.With(slog.String("op", op))
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op))
 
-	types, err := s.auxStorage.GetEventTypes(ctx)
+	types, err := s.auxStorage.GetEventTypes(ctx, string(lang))
 	if err != nil {
 		log.Error("failed to get event types", "error", err)
 		return nil, fmt.Errorf("%s: %w", op, err)
@@ -43,11 +51,14 @@ func (s *Service) GetEventTypes(ctx context.Context) ([]model.EventType, error)
 	return types, nil
 }
 
-func (s *Service) GetLocations(ctx context.Context) ([]model.Location, error) {
+// GetLocations lists locations described in lang where a translation exists.
+func (s *Service) GetLocations(ctx context.Context, lang i18n.Lang) ([]model.Location, error) {
 	const op = "auxiliary.Service.GetLocations"
-	log := s.log.With(slog.String("op", op))
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op))
 
-	locs, err := s.auxStorage.GetLocations(ctx)
+	locs, err := s.auxStorage.GetLocations(ctx, string(lang))
 	if err != nil {
 		log.Error("failed to get locations", "error", err)
 		return nil, fmt.Errorf("%s: %w", op, err)
@@ -58,7 +69,9 @@ func (s *Service) GetLocations(ctx context.Context) ([]model.Location, error) {
 
 func (s *Service) GetLocation(ctx context.Context, id int) (model.Location, error) {
 	const op = "auxiliary.Service.GetLocation"
-	log := s.log.With(slog.String("op", op), slog.Int("id", id))
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.Int("id", id))
 
 	loc, err := s.auxStorage.GetLocation(ctx, id)
 	if err != nil {
@@ -74,7 +87,9 @@ func (s *Service) GetLocation(ctx context.Context, id int) (model.Location, erro
 
 func (s *Service) GetEventType(ctx context.Context, id int) (model.EventType, error) {
 	const op = "auxiliary.Service.GetEventType"
-	log := s.log.With(slog.String("op", op), slog.Int("id", id))
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.Int("id", id))
 
 	typeData, err := s.auxStorage.GetEventType(ctx, id)
 	if err != nil {
@@ -90,7 +105,9 @@ func (s *Service) GetEventType(ctx context.Context, id int) (model.EventType, er
 
 func (s *Service) GetOrchestraInfo(ctx context.Context, key string) (model.OrchestraInfo, error) {
 	const op = "auxiliary.Service.GetOrchestraInfo"
-	log := s.log.With(slog.String("op", op), slog.String("key", key))
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.String("key", key))
 
 	info, err := s.auxStorage.GetOrchestraInfo(ctx, key)
 	if err != nil {
@@ -107,10 +124,20 @@ func (s *Service) GetOrchestraInfo(ctx context.Context, key string) (model.Orche
 
 func (s *Service) AddEventType(ctx context.Context, name, description string) (int, error) {
 	const op = "auxiliary.Service.AddEventType"
-	log := s.log.With(slog.String("op", op))
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op))
 
 	id, err := s.auxStorage.AddEventType(ctx, name, description)
 	if err != nil {
+		if errors.Is(err, storage.ErrEventTypeExists) {
+			log.Warn("event type name taken", "error", err)
+			return 0, fmt.Errorf("%s: %w", op, service.ErrEventTypeExists)
+		}
+		if cerr := service.ConstraintError(err); cerr != nil {
+			log.Warn("rejected by a constraint", "error", err)
+			return 0, fmt.Errorf("%s: %w", op, cerr)
+		}
 		log.Error("failed to add event type", "error", err)
 		return 0, fmt.Errorf("%s: %w", op, service.ErrFailedToSaveMeta)
 	}
@@ -120,10 +147,20 @@ func (s *Service) AddEventType(ctx context.Context, name, description string) (i
 
 func (s *Service) AddLocation(ctx context.Context, name, route, features string) (int, error) {
 	const op = "auxiliary.Service.AddLocation"
-	log := s.log.With(slog.String("op", op))
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op))
 
 	id, err := s.auxStorage.AddLocation(ctx, name, route, features)
 	if err != nil {
+		if errors.Is(err, storage.ErrLocationExists) {
+			log.Warn("location name taken", "error", err)
+			return 0, fmt.Errorf("%s: %w", op, service.ErrLocationExists)
+		}
+		if cerr := service.ConstraintError(err); cerr != nil {
+			log.Warn("rejected by a constraint", "error", err)
+			return 0, fmt.Errorf("%s: %w", op, cerr)
+		}
 		log.Error("failed to add location", "error", err)
 		return 0, fmt.Errorf("%s: %w", op, service.ErrFailedToSaveMeta)
 	}
@@ -133,13 +170,102 @@ func (s *Service) AddLocation(ctx context.Context, name, route, features string)
 
 func (s *Service) AddOrchestraInfo(ctx context.Context, key, value string) error {
 	const op = "auxiliary.Service.AddOrchestraInfo"
-	log := s.log.With(slog.String("op", op), slog.String("key", key))
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.String("key", key))
 
 	err := s.auxStorage.AddOrchestraInfo(ctx, key, value)
 	if err != nil {
+		if cerr := service.ConstraintError(err); cerr != nil {
+			log.Warn("rejected by a constraint", "error", err)
+			return fmt.Errorf("%s: %w", op, cerr)
+		}
 		log.Error("failed to add orchestra info", "error", err)
 		return fmt.Errorf("%s: %w", op, service.ErrFailedToSaveMeta)
 	}
 
 	return nil
 }
+
+// SetEventTypeTranslation stores the name and description of an event type in another language.
+func (s *Service) SetEventTypeTranslation(ctx context.Context, id int, lang, name, description string) error {
+	const op = "auxiliary.Service.SetEventTypeTranslation"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.Int("id", id), slog.String("lang", lang))
+
+	parsed, ok := i18n.Parse(lang)
+	if !ok {
+		return fmt.Errorf("%s: %w", op, service.ErrUnknownLanguage)
+	}
+
+	verr := &service.ValidationError{}
+	if strings.TrimSpace(name) == "" {
+		verr.Add("name", service.ErrMissingValue)
+	}
+	if strings.TrimSpace(description) == "" {
+		verr.Add("description", service.ErrMissingValue)
+	}
+	if err := verr.OrNil(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err := s.auxStorage.SetEventTypeTranslation(ctx, id, string(parsed), name, description)
+	if err != nil {
+		if errors.Is(err, storage.ErrEventTypeNotFound) {
+			log.Warn("event type not found", "error", err)
+			return fmt.Errorf("%s: %w", op, service.ErrEventTypeNotFound)
+		}
+		if cerr := service.ConstraintError(err); cerr != nil {
+			log.Warn("rejected by a constraint", "error", err)
+			return fmt.Errorf("%s: %w", op, cerr)
+		}
+		log.Error("failed to save event type translation", "error", err)
+		return fmt.Errorf("%s: %w", op, service.ErrFailedToSaveTranslation)
+	}
+
+	return nil
+}
+
+// SetLocationTranslation stores the name, route and features of a location in another language.
+func (s *Service) SetLocationTranslation(ctx context.Context, id int, lang, name, route, features string) error {
+	const op = "auxiliary.Service.SetLocationTranslation"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.Int("id", id), slog.String("lang", lang))
+
+	parsed, ok := i18n.Parse(lang)
+	if !ok {
+		return fmt.Errorf("%s: %w", op, service.ErrUnknownLanguage)
+	}
+
+	verr := &service.ValidationError{}
+	if strings.TrimSpace(name) == "" {
+		verr.Add("name", service.ErrMissingValue)
+	}
+	if strings.TrimSpace(route) == "" {
+		verr.Add("route", service.ErrMissingValue)
+	}
+	if strings.TrimSpace(features) == "" {
+		verr.Add("features", service.ErrMissingValue)
+	}
+	if err := verr.OrNil(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err := s.auxStorage.SetLocationTranslation(ctx, id, string(parsed), name, route, features)
+	if err != nil {
+		if errors.Is(err, storage.ErrLocationNotFound) {
+			log.Warn("location not found", "error", err)
+			return fmt.Errorf("%s: %w", op, service.ErrLocationNotFound)
+		}
+		if cerr := service.ConstraintError(err); cerr != nil {
+			log.Warn("rejected by a constraint", "error", err)
+			return fmt.Errorf("%s: %w", op, cerr)
+		}
+		log.Error("failed to save location translation", "error", err)
+		return fmt.Errorf("%s: %w", op, service.ErrFailedToSaveTranslation)
+	}
+
+	return nil
+}
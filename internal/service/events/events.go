@@ -5,17 +5,31 @@ import (
 	"errors"
 	"fmt"
 	"github.com/Ilya-Repin/orchestra_api/internal/infra/storage"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/tracing"
 	"github.com/Ilya-Repin/orchestra_api/internal/model"
 	"github.com/Ilya-Repin/orchestra_api/internal/service"
 	"github.com/google/uuid"
 	"log/slog"
+	"strings"
 	"time"
 )
 
+// Kinds of the jobs the service announces when a capacity change takes seats away or frees
+// them. The payloads are model.SeatsBumped and model.SeatsFreed.
+const (
+	JobSeatsBumped = "events.seats_bumped"
+	JobSeatsFreed  = "events.seats_freed"
+)
+
 type Service struct {
 	log           *slog.Logger
 	eventStorage  EventStorage
 	memberStorage MemberStorage
+	jobs          JobMaker
+}
+
+type JobMaker interface {
+	Job(kind string, payload any) (model.Job, error)
 }
 
 type MemberStorage interface {
@@ -24,27 +38,52 @@ type MemberStorage interface {
 
 type EventStorage interface {
 	GetEvents(ctx context.Context, eventType *int, begin, end *time.Time) ([]model.Event, error)
+	StreamEvents(ctx context.Context, eventType *int, begin, end *time.Time, fn func(model.Event) error) error
 	GetUpcomingEvents(ctx context.Context) ([]model.Event, error)
 	GetAvailableEvents(ctx context.Context, memberID uuid.UUID) ([]model.Event, error)
 	GetRegisteredEvents(ctx context.Context, memberID uuid.UUID) ([]model.Event, error)
 	GetEvent(ctx context.Context, id int) (model.Event, error)
+	GetEventFromPrimary(ctx context.Context, id int) (model.Event, error)
 	AddEvent(ctx context.Context, title, description string, evType int, evDate time.Time, location int, capacity int) (int, error)
-	DeleteEvent(ctx context.Context, id int) error
-	UpdateEvent(ctx context.Context, id int, title, description string, evType int, evDate time.Time, location int, capacity int) error
+	DeleteEvent(ctx context.Context, id int, version int) error
+	UpdateEvent(ctx context.Context, id int, title, description string, evType int, evDate time.Time, location int, capacity int, version int, announce model.SeatsAnnouncer) (model.CapacityChange, error)
+	PatchEvent(ctx context.Context, id int, patch model.EventPatch, version int, announce model.SeatsAnnouncer) (model.CapacityChange, error)
+	SetEventAccess(ctx context.Context, id int, access model.EventAccess, version int) (int, error)
+	SetEventPrice(ctx context.Context, id int, price int64, version int) (int, error)
+	SetEventCapacity(ctx context.Context, id, capacity, version int, force bool, announce model.SeatsAnnouncer) (model.CapacityChange, error)
 }
 
-func New(log *slog.Logger, eventStorage EventStorage, memberStorage MemberStorage) *Service {
-	return &Service{log: log.With("component", "service"), eventStorage: eventStorage, memberStorage: memberStorage}
+func New(log *slog.Logger, eventStorage EventStorage, memberStorage MemberStorage, jobs JobMaker) *Service {
+	return &Service{log: log.With("component", "service"), eventStorage: eventStorage, memberStorage: memberStorage, jobs: jobs}
 }
 
 func (s *Service) AddEvent(ctx context.Context, title, description string, evType int, evDate time.Time, location int, capacity int) (int, error) {
 	const op = "events.Service.AddEvent"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
 
-	log := s.log.With(slog.String("op", op))
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op))
 	log.Info("adding new event")
 
+	event := model.Event{
+		Title:     title,
+		EventType: model.EventType{ID: evType},
+		EventDate: evDate,
+		Location:  model.Location{ID: location},
+		Capacity:  capacity,
+	}
+	if err := validateEvent(event); err != nil {
+		log.Warn("invalid event", "error", err)
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
 	id, err := s.eventStorage.AddEvent(ctx, title, description, evType, evDate, location, capacity)
 	if err != nil {
+		if verr := fieldError(err); verr != nil {
+			log.Warn("event rejected by storage", "error", err)
+			return 0, fmt.Errorf("%s: %w", op, verr)
+		}
+
 		log.Error("failed to add event", "error", err)
 		return 0, fmt.Errorf("%s: %w", op, service.ErrFailedToAdd)
 	}
@@ -55,8 +94,10 @@ func (s *Service) AddEvent(ctx context.Context, title, description string, evTyp
 
 func (s *Service) GetEvent(ctx context.Context, id int) (model.Event, error) {
 	const op = "events.Service.GetEvent"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
 
-	log := s.log.With(slog.String("op", op))
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op))
 	log.Info("getting event", "id", id)
 
 	event, err := s.eventStorage.GetEvent(ctx, id)
@@ -74,8 +115,10 @@ func (s *Service) GetEvent(ctx context.Context, id int) (model.Event, error) {
 
 func (s *Service) GetEvents(ctx context.Context, eventType *int, begin, end *time.Time) ([]model.Event, error) {
 	const op = "events.Service.GetEvents"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
 
-	log := s.log.With(slog.String("op", op))
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op))
 	log.Info("getting events")
 
 	events, err := s.eventStorage.GetEvents(ctx, eventType, begin, end)
@@ -87,10 +130,28 @@ func (s *Service) GetEvents(ctx context.Context, eventType *int, begin, end *tim
 	return events, nil
 }
 
+func (s *Service) ExportEvents(ctx context.Context, eventType *int, begin, end *time.Time, fn func(model.Event) error) error {
+	const op = "events.Service.ExportEvents"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op))
+	log.Info("exporting events")
+
+	if err := s.eventStorage.StreamEvents(ctx, eventType, begin, end, fn); err != nil {
+		log.Error("failed to export events", "error", err)
+		return fmt.Errorf("%s: %w", op, service.ErrFailedToExport)
+	}
+
+	return nil
+}
+
 func (s *Service) GetUpcomingEvents(ctx context.Context) ([]model.Event, error) {
 	const op = "events.Service.GetUpcomingEvents"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
 
-	log := s.log.With(slog.String("op", op))
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op))
 	log.Info("getting upcoming events")
 
 	events, err := s.eventStorage.GetUpcomingEvents(ctx)
@@ -104,7 +165,9 @@ func (s *Service) GetUpcomingEvents(ctx context.Context) ([]model.Event, error)
 
 func (s *Service) GetAvailableEvents(ctx context.Context, memberID uuid.UUID) ([]model.Event, error) {
 	const op = "events.Service.GetAvailableEvents"
-	log := s.log.With(slog.String("op", op), slog.String("member_id", memberID.String()))
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.String("member_id", memberID.String()))
 
 	approved, err := s.memberStorage.CheckIsApproved(ctx, memberID)
 	if err != nil {
@@ -133,7 +196,9 @@ func (s *Service) GetAvailableEvents(ctx context.Context, memberID uuid.UUID) ([
 
 func (s *Service) GetRegisteredEvents(ctx context.Context, memberID uuid.UUID) ([]model.Event, error) {
 	const op = "events.Service.GetRegisteredEvents"
-	log := s.log.With(slog.String("op", op), slog.String("member_id", memberID.String()))
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.String("member_id", memberID.String()))
 
 	approved, err := s.memberStorage.CheckIsApproved(ctx, memberID)
 	if err != nil {
@@ -160,19 +225,31 @@ func (s *Service) GetRegisteredEvents(ctx context.Context, memberID uuid.UUID) (
 	return events, nil
 }
 
-func (s *Service) DeleteEvent(ctx context.Context, id int) error {
+// DeleteEvent removes the event. A non-zero version is the one the caller last saw; the
+// delete fails with ErrVersionMismatch if the event has changed since.
+func (s *Service) DeleteEvent(ctx context.Context, id int, version int) error {
 	const op = "events.Service.DeleteEvent"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
 
-	log := s.log.With(slog.String("op", op))
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op))
 	log.Info("deleting event", "id", id)
 
-	err := s.eventStorage.DeleteEvent(ctx, id)
+	err := s.eventStorage.DeleteEvent(ctx, id, version)
 	if err != nil {
 		if errors.Is(err, storage.ErrEventNotFound) {
 			log.Error("event not found", "error", err)
 			return fmt.Errorf("%s: %w", op, service.ErrEventNotFound)
 		}
+		if errors.Is(err, storage.ErrVersionMismatch) {
+			log.Warn("event version mismatch", "error", err)
+			return fmt.Errorf("%s: %w", op, service.ErrVersionMismatch)
+		}
 
+		if cerr := service.ConstraintError(err); cerr != nil {
+			log.Warn("rejected by a constraint", "error", err)
+			return fmt.Errorf("%s: %w", op, cerr)
+		}
 		log.Error("failed to delete event", "error", err)
 		return fmt.Errorf("%s: %w", op, service.ErrFailedToDelete)
 	}
@@ -180,23 +257,335 @@ func (s *Service) DeleteEvent(ctx context.Context, id int) error {
 	return nil
 }
 
-func (s *Service) UpdateEvent(ctx context.Context, id int, title, description string, evType int, evDate time.Time, location int, capacity int) error {
+// UpdateEvent rewrites the event and returns its new version. A non-zero version is the one
+// the caller last saw; the update fails with ErrVersionMismatch if the event has changed since.
+// Capacity can not be lowered below the active registrations here, see SetEventCapacity.
+func (s *Service) UpdateEvent(ctx context.Context, id int, title, description string, evType int, evDate time.Time, location int, capacity int, version int) (int, error) {
 	const op = "events.Service.UpdateEvent"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
 
-	log := s.log.With(slog.String("op", op))
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op))
 	log.Info("updating event", "id", id)
 
-	err := s.eventStorage.UpdateEvent(ctx, id, title, description, evType, evDate, location, capacity)
+	event := model.Event{
+		Title:     title,
+		EventType: model.EventType{ID: evType},
+		EventDate: evDate,
+		Location:  model.Location{ID: location},
+		Capacity:  capacity,
+	}
+	if err := validateEvent(event); err != nil {
+		log.Warn("invalid event", "error", err)
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	change, err := s.eventStorage.UpdateEvent(ctx, id, title, description, evType, evDate, location, capacity, version, s.announce)
 	if err != nil {
 		if errors.Is(err, storage.ErrEventNotFound) {
 			log.Error("event not found", "error", err)
-			return fmt.Errorf("%s: %w", op, service.ErrEventNotFound)
+			return 0, fmt.Errorf("%s: %w", op, service.ErrEventNotFound)
+		}
+		if errors.Is(err, storage.ErrVersionMismatch) {
+			log.Warn("event version mismatch", "error", err)
+			return 0, fmt.Errorf("%s: %w", op, service.ErrVersionMismatch)
+		}
+		if errors.Is(err, storage.ErrCapacityBelowRegs) {
+			log.Warn("capacity below active registrations", "capacity", capacity)
+			return 0, fmt.Errorf("%s: %w", op, service.ErrCapacityBelowRegs)
+		}
+		if verr := fieldError(err); verr != nil {
+			log.Warn("event rejected by storage", "error", err)
+			return 0, fmt.Errorf("%s: %w", op, verr)
 		}
 
 		log.Error("failed to update event", "error", err)
-		return fmt.Errorf("%s: %w", op, service.ErrFailedToUpdate)
+		return 0, fmt.Errorf("%s: %w", op, service.ErrFailedToUpdate)
 	}
 
 	log.Info("event updated successfully", "id", id)
-	return nil
+	return change.Version, nil
+}
+
+// maxPatchAttempts bounds how often an unconditional patch is re-applied when the event
+// changes between reading it for validation and writing it back.
+const maxPatchAttempts = 3
+
+// PatchEvent applies a partial update and returns the event's new version. The patch is
+// validated against the merged event. A non-zero version is the one the caller last saw; the
+// patch fails with ErrVersionMismatch if the event has changed since. Capacity can not be
+// lowered below the active registrations here, see SetEventCapacity.
+func (s *Service) PatchEvent(ctx context.Context, id int, patch model.EventPatch, version int) (int, error) {
+	const op = "events.Service.PatchEvent"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op))
+	log.Info("patching event", "id", id)
+
+	for attempt := 1; ; attempt++ {
+		current, err := s.eventStorage.GetEventFromPrimary(ctx, id)
+		if err != nil {
+			if errors.Is(err, storage.ErrEventNotFound) {
+				log.Warn("event not found", "error", err)
+				return 0, fmt.Errorf("%s: %w", op, service.ErrEventNotFound)
+			}
+			log.Error("failed to get event", "error", err)
+			return 0, fmt.Errorf("%s: %w", op, service.ErrFailedToUpdate)
+		}
+
+		if version != 0 && current.Version != version {
+			log.Warn("event version mismatch", "expected", version, "actual", current.Version)
+			return 0, fmt.Errorf("%s: %w", op, service.ErrVersionMismatch)
+		}
+
+		if err := validateEvent(patch.Apply(current)); err != nil {
+			log.Warn("invalid event", "error", err)
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if patch.Empty() {
+			return current.Version, nil
+		}
+
+		change, err := s.eventStorage.PatchEvent(ctx, id, patch, current.Version, s.announce)
+		if err != nil {
+			switch {
+			case errors.Is(err, storage.ErrEventNotFound):
+				log.Warn("event not found", "error", err)
+				return 0, fmt.Errorf("%s: %w", op, service.ErrEventNotFound)
+			case errors.Is(err, storage.ErrVersionMismatch):
+				if version == 0 && attempt < maxPatchAttempts {
+					continue
+				}
+				log.Warn("event version mismatch", "error", err)
+				return 0, fmt.Errorf("%s: %w", op, service.ErrVersionMismatch)
+			case errors.Is(err, storage.ErrCapacityBelowRegs):
+				log.Warn("capacity below active registrations", "capacity", *patch.Capacity)
+				return 0, fmt.Errorf("%s: %w", op, service.ErrCapacityBelowRegs)
+			case fieldError(err) != nil:
+				log.Warn("event rejected by storage", "error", err)
+				return 0, fmt.Errorf("%s: %w", op, fieldError(err))
+			}
+
+			log.Error("failed to patch event", "error", err)
+			return 0, fmt.Errorf("%s: %w", op, service.ErrFailedToUpdate)
+		}
+
+		log.Info("event patched", "id", id, "version", change.Version)
+		return change.Version, nil
+	}
+}
+
+// SetEventAccess replaces the membership limits on registration for the event and returns
+// the event's new version. A non-zero version makes the change conditional on it.
+func (s *Service) SetEventAccess(ctx context.Context, id int, access model.EventAccess, version int) (int, error) {
+	const op = "events.Service.SetEventAccess"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op))
+	log.Info("setting event access", "id", id)
+
+	if err := validateAccess(access); err != nil {
+		log.Warn("invalid event access", "error", err)
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	newVersion, err := s.eventStorage.SetEventAccess(ctx, id, access, version)
+	if err != nil {
+		if errors.Is(err, storage.ErrEventNotFound) {
+			log.Warn("event not found", "error", err)
+			return 0, fmt.Errorf("%s: %w", op, service.ErrEventNotFound)
+		}
+		if errors.Is(err, storage.ErrVersionMismatch) {
+			log.Warn("event version mismatch", "error", err)
+			return 0, fmt.Errorf("%s: %w", op, service.ErrVersionMismatch)
+		}
+
+		if cerr := service.ConstraintError(err); cerr != nil {
+			log.Warn("rejected by a constraint", "error", err)
+			return 0, fmt.Errorf("%s: %w", op, cerr)
+		}
+		log.Error("failed to set event access", "error", err)
+		return 0, fmt.Errorf("%s: %w", op, service.ErrFailedToSetAccess)
+	}
+
+	log.Info("event access set", "id", id, "version", newVersion)
+	return newVersion, nil
+}
+
+// SetEventPrice sets the fee for the event in kopecks, zero making it free, and returns the
+// event's new version. Seats already held or paid for keep their amount.
+func (s *Service) SetEventPrice(ctx context.Context, id int, price int64, version int) (int, error) {
+	const op = "events.Service.SetEventPrice"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op))
+	log.Info("setting event price", "id", id, "price", price)
+
+	if price < 0 {
+		verr := &service.ValidationError{}
+		verr.Add("price", service.ErrInvalidPrice)
+		return 0, fmt.Errorf("%s: %w", op, verr)
+	}
+
+	newVersion, err := s.eventStorage.SetEventPrice(ctx, id, price, version)
+	if err != nil {
+		if errors.Is(err, storage.ErrEventNotFound) {
+			log.Warn("event not found", "error", err)
+			return 0, fmt.Errorf("%s: %w", op, service.ErrEventNotFound)
+		}
+		if errors.Is(err, storage.ErrVersionMismatch) {
+			log.Warn("event version mismatch", "error", err)
+			return 0, fmt.Errorf("%s: %w", op, service.ErrVersionMismatch)
+		}
+		if errors.Is(err, storage.ErrInvalidPrice) {
+			log.Warn("invalid event price", "error", err)
+			return 0, fmt.Errorf("%s: %w", op, service.InvalidField("price", service.ErrInvalidPrice))
+		}
+
+		if cerr := service.ConstraintError(err); cerr != nil {
+			log.Warn("rejected by a constraint", "error", err)
+			return 0, fmt.Errorf("%s: %w", op, cerr)
+		}
+		log.Error("failed to set event price", "error", err)
+		return 0, fmt.Errorf("%s: %w", op, service.ErrFailedToSetPrice)
+	}
+
+	log.Info("event price set", "id", id, "version", newVersion)
+	return newVersion, nil
+}
+
+// SetEventCapacity sets the number of seats of the event. Lowering it below the active
+// registrations fails with ErrCapacityBelowRegs unless force is set; then the latest
+// registrations are cancelled until the rest fit and their members are notified. Seats freed
+// by a raise are announced to the members bumped from the event before.
+func (s *Service) SetEventCapacity(ctx context.Context, id, capacity, version int, force bool) (model.CapacityChange, error) {
+	const op = "events.Service.SetEventCapacity"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op))
+	log.Info("setting event capacity", "id", id, "capacity", capacity, "force", force)
+
+	if capacity <= 0 {
+		return model.CapacityChange{}, fmt.Errorf("%s: %w", op, service.InvalidField("capacity", service.ErrInvalidCapacity))
+	}
+
+	change, err := s.eventStorage.SetEventCapacity(ctx, id, capacity, version, force, s.announce)
+	if err != nil {
+		if errors.Is(err, storage.ErrEventNotFound) {
+			log.Warn("event not found", "error", err)
+			return model.CapacityChange{}, fmt.Errorf("%s: %w", op, service.ErrEventNotFound)
+		}
+		if errors.Is(err, storage.ErrVersionMismatch) {
+			log.Warn("event version mismatch", "error", err)
+			return model.CapacityChange{}, fmt.Errorf("%s: %w", op, service.ErrVersionMismatch)
+		}
+		if errors.Is(err, storage.ErrCapacityBelowRegs) {
+			log.Warn("capacity below active registrations", "capacity", capacity)
+			return model.CapacityChange{}, fmt.Errorf("%s: %w", op, service.ErrCapacityBelowRegs)
+		}
+		if verr := fieldError(err); verr != nil {
+			log.Warn("event rejected by storage", "error", err)
+			return model.CapacityChange{}, fmt.Errorf("%s: %w", op, verr)
+		}
+
+		if cerr := service.ConstraintError(err); cerr != nil {
+			log.Warn("rejected by a constraint", "error", err)
+			return model.CapacityChange{}, fmt.Errorf("%s: %w", op, cerr)
+		}
+		log.Error("failed to set event capacity", "error", err)
+		return model.CapacityChange{}, fmt.Errorf("%s: %w", op, service.ErrFailedToSetCapacity)
+	}
+
+	log.Info("event capacity set", "id", id, "version", change.Version, "bumped", len(change.Bumped), "freed", change.FreedSeats())
+	return change, nil
+}
+
+// announce makes the jobs that tell what a capacity change did to the seats of the event.
+// The storage enqueues them with the change, so a committed change is always announced.
+func (s *Service) announce(change model.CapacityChange) ([]model.Job, error) {
+	var jobs []model.Job
+
+	if len(change.Bumped) > 0 {
+		job, err := s.jobs.Job(JobSeatsBumped, model.SeatsBumped{
+			EventID:  change.EventID,
+			Members:  change.Bumped,
+			Payments: change.Payments,
+		})
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+
+	if seats := change.FreedSeats(); seats > 0 {
+		job, err := s.jobs.Job(JobSeatsFreed, model.SeatsFreed{EventID: change.EventID, Seats: seats})
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+
+	return jobs, nil
+}
+
+// validateAccess checks that the tiers are known and that a priority window has both its
+// tier and its end.
+func validateAccess(a model.EventAccess) error {
+	verr := &service.ValidationError{}
+	if a.MinTier != "" && !a.MinTier.Valid() {
+		verr.Add("min_tier", service.ErrUnknownTier)
+	}
+	if a.PriorityTier != "" && !a.PriorityTier.Valid() {
+		verr.Add("priority_tier", service.ErrUnknownTier)
+	}
+	if a.PriorityTier != "" && a.PriorityUntil == nil {
+		verr.Add("priority_until", service.ErrMissingValue)
+	}
+	if a.PriorityTier == "" && a.PriorityUntil != nil {
+		verr.Add("priority_tier", service.ErrMissingValue)
+	}
+
+	return verr.OrNil()
+}
+
+// validateEvent checks the invariants a stored event must keep and reports every violated one.
+func validateEvent(e model.Event) error {
+	verr := &service.ValidationError{}
+	if strings.TrimSpace(e.Title) == "" {
+		verr.Add("title", service.ErrInvalidTitle)
+	}
+	if !e.EventDate.After(time.Now()) {
+		verr.Add("event_date", service.ErrInvalidEventDate)
+	}
+	if e.Capacity <= 0 {
+		verr.Add("capacity", service.ErrInvalidCapacity)
+	}
+	if e.EventType.ID <= 0 {
+		verr.Add("event_type", service.ErrEventTypeNotFound)
+	}
+	if e.Location.ID <= 0 {
+		verr.Add("location", service.ErrLocationNotFound)
+	}
+
+	return verr.OrNil()
+}
+
+// fieldError reports a storage error about a missing event type or location, or a date in
+// the past, as a validation error of the field at fault, and returns nil for any other error.
+func fieldError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrEventTypeNotFound):
+		return service.InvalidField("event_type", service.ErrEventTypeNotFound)
+	case errors.Is(err, storage.ErrLocationNotFound):
+		return service.InvalidField("location", service.ErrLocationNotFound)
+	case errors.Is(err, storage.ErrEventInPast):
+		return service.InvalidField("event_date", service.ErrInvalidEventDate)
+	}
+
+	return service.ConstraintError(err)
 }
@@ -0,0 +1,155 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/config"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/tracing"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"log/slog"
+	"runtime/debug"
+	"sync/atomic"
+	"time"
+)
+
+// Service reports whether the replica can serve traffic and how it is doing. Once Drain is
+// called on shutdown the replica reports itself as not ready, so the balancer moves away.
+type Service struct {
+	log           *slog.Logger
+	storage       HealthStorage
+	cfg           config.HealthConfig
+	config        map[string]any
+	build         model.BuildInfo
+	schemaVersion int64
+	startedAt     time.Time
+	draining      atomic.Bool
+}
+
+type HealthStorage interface {
+	Ping(ctx context.Context) error
+	GetSchemaVersion(ctx context.Context) (int64, error)
+	PoolStats() model.PoolStats
+}
+
+// New returns the service of a binary built as version that expects the database schema to be
+// at least at schemaVersion.
+func New(log *slog.Logger, storage HealthStorage, cfg *config.Config, version string, schemaVersion int64) *Service {
+	return &Service{
+		log:           log.With("component", "service"),
+		storage:       storage,
+		cfg:           cfg.HealthConfig,
+		config:        cfg.Redacted(),
+		build:         readBuildInfo(version),
+		schemaVersion: schemaVersion,
+		startedAt:     time.Now(),
+	}
+}
+
+// Drain makes the replica report itself as not ready from now on.
+func (s *Service) Drain() {
+	s.draining.Store(true)
+}
+
+// Ready runs the readiness checks: the replica is not draining, the database answers, its schema
+// is at least the expected version and the connection pool is not saturated. A schema newer than
+// expected passes, so replicas of the previous release stay ready while a rollout migrates.
+func (s *Service) Ready(ctx context.Context) model.Readiness {
+	const op = "health.Service.Ready"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op))
+
+	if s.draining.Load() {
+		return model.Readiness{Checks: []model.Check{{Name: "shutdown", Detail: "shutting down"}}}
+	}
+
+	checks := []model.Check{
+		s.check(ctx, "database", func(ctx context.Context) (bool, string, error) {
+			return true, "", s.storage.Ping(ctx)
+		}),
+		s.check(ctx, "schema", func(ctx context.Context) (bool, string, error) {
+			version, err := s.storage.GetSchemaVersion(ctx)
+			if err != nil {
+				return false, "", err
+			}
+			return version >= s.schemaVersion, fmt.Sprintf("version %d, expected %d", version, s.schemaVersion), nil
+		}),
+		s.poolCheck(),
+	}
+
+	readiness := model.Readiness{Ready: true, Checks: checks}
+	for _, c := range checks {
+		if !c.OK {
+			readiness.Ready = false
+			log.Warn("readiness check failed", "check", c.Name, "detail", c.Detail)
+		}
+	}
+
+	return readiness
+}
+
+// check runs fn with the configured timeout and times it. fn reports whether the check passed
+// and a detail; an error fails the check with the error as the detail.
+func (s *Service) check(ctx context.Context, name string, fn func(ctx context.Context) (bool, string, error)) model.Check {
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.CheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	ok, detail, err := fn(ctx)
+	c := model.Check{Name: name, OK: ok && err == nil, Latency: time.Since(start), Detail: detail}
+	if err != nil {
+		c.Detail = err.Error()
+	}
+
+	return c
+}
+
+// poolCheck fails when the share of connections in use reaches the configured maximum. A pool
+// without a limit never fails it.
+func (s *Service) poolCheck() model.Check {
+	stats := s.storage.PoolStats()
+	c := model.Check{Name: "pool", OK: true, Detail: fmt.Sprintf("%d of %d connections in use", stats.InUse, stats.MaxOpen)}
+	if stats.MaxOpen > 0 && float64(stats.InUse) >= s.cfg.MaxPoolUsage*float64(stats.MaxOpen) {
+		c.OK = false
+	}
+
+	return c
+}
+
+// Status returns the diagnostic report: the build, uptime, config with secrets redacted, the
+// readiness checks with their latencies and the state of the connection pool.
+func (s *Service) Status(ctx context.Context) model.Status {
+	return model.Status{
+		Build:     s.build,
+		StartedAt: s.startedAt,
+		Uptime:    time.Since(s.startedAt),
+		Draining:  s.draining.Load(),
+		Config:    s.config,
+		Readiness: s.Ready(ctx),
+		Pool:      s.storage.PoolStats(),
+	}
+}
+
+// readBuildInfo describes the running binary. The revision and build time come from the version
+// control information Go embeds when building a package inside a repository.
+func readBuildInfo(version string) model.BuildInfo {
+	build := model.BuildInfo{Version: version}
+
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return build
+	}
+
+	build.GoVersion = info.GoVersion
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			build.Revision = setting.Value
+		case "vcs.time":
+			build.BuildTime = setting.Value
+		}
+	}
+
+	return build
+}
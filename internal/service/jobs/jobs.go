@@ -0,0 +1,128 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/config"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/storage"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/tracing"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"github.com/Ilya-Repin/orchestra_api/internal/service"
+	"log/slog"
+	"time"
+)
+
+const (
+	defaultListLimit = 100
+
+	// bucketIdleFor is how long a rate limit bucket stays untouched before it is removed.
+	bucketIdleFor = 24 * time.Hour
+)
+
+// Service lets an administrator look into the background job queue and retry dead jobs. It
+// also runs the cleanup job that keeps the housekeeping tables small.
+type Service struct {
+	log     *slog.Logger
+	storage JobStorage
+	cfg     config.JobsConfig
+}
+
+type JobStorage interface {
+	GetJobs(ctx context.Context, filter model.JobFilter) ([]model.Job, error)
+	RetryJob(ctx context.Context, id int64) (model.Job, error)
+	DeleteFinishedJobs(ctx context.Context, before time.Time) (int, error)
+	DeleteExpiredIdempotencyKeys(ctx context.Context) (int, error)
+	DeleteIdleRateLimitBuckets(ctx context.Context, before time.Time) (int, error)
+}
+
+func New(log *slog.Logger, storage JobStorage, cfg config.JobsConfig) *Service {
+	return &Service{log: log.With("component", "service"), storage: storage, cfg: cfg}
+}
+
+// GetJobs lists jobs matching the filter, most recently changed first. Without a limit at
+// most defaultListLimit jobs are returned.
+func (s *Service) GetJobs(ctx context.Context, filter model.JobFilter) ([]model.Job, error) {
+	const op = "jobs.Service.GetJobs"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.String("status", string(filter.Status)), slog.String("kind", filter.Kind))
+	log.Info("getting jobs")
+
+	if filter.Status != "" && !filter.Status.Valid() {
+		log.Warn("unknown job status")
+		return nil, fmt.Errorf("%s: %w", op, service.ErrUnknownStatus)
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultListLimit
+	}
+
+	jobs, err := s.storage.GetJobs(ctx, filter)
+	if err != nil {
+		log.Error("failed to get jobs", "error", err)
+		return nil, fmt.Errorf("%s: %w", op, service.ErrFailedToGetJobs)
+	}
+
+	return jobs, nil
+}
+
+// RetryJob puts a dead job back to the queue with a fresh set of attempts.
+func (s *Service) RetryJob(ctx context.Context, id int64) (model.Job, error) {
+	const op = "jobs.Service.RetryJob"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.Int64("job_id", id))
+	log.Info("retrying job")
+
+	job, err := s.storage.RetryJob(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrJobNotFound):
+			log.Warn("job not found")
+			return model.Job{}, fmt.Errorf("%s: %w", op, service.ErrJobNotFound)
+		case errors.Is(err, storage.ErrJobNotDead):
+			log.Warn("job is not dead")
+			return model.Job{}, fmt.Errorf("%s: %w", op, service.ErrJobNotDead)
+		}
+
+		log.Error("failed to retry job", "error", err)
+		return model.Job{}, fmt.Errorf("%s: %w", op, service.ErrFailedToRetryJob)
+	}
+
+	log.Info("job queued again", "kind", job.Kind)
+	return job, nil
+}
+
+// RunCleanupJob removes succeeded jobs older than the retention, expired idempotency keys
+// and idle rate limit buckets. It is run by the job runner.
+func (s *Service) RunCleanupJob(ctx context.Context) error {
+	const op = "jobs.Service.RunCleanupJob"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	now := time.Now()
+
+	jobs, err := s.storage.DeleteFinishedJobs(ctx, now.Add(-s.cfg.Retention))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	keys, err := s.storage.DeleteExpiredIdempotencyKeys(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	buckets, err := s.storage.DeleteIdleRateLimitBuckets(ctx, now.Add(-bucketIdleFor))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if jobs > 0 || keys > 0 || buckets > 0 {
+		s.log.Info("cleanup done", slog.String("op", op),
+			slog.Int("jobs", jobs), slog.Int("idempotency_keys", keys), slog.Int("rate_limit_buckets", buckets))
+	}
+
+	return nil
+}
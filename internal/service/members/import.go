@@ -0,0 +1,140 @@
+package members
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/storage"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/tracing"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"github.com/Ilya-Repin/orchestra_api/internal/service"
+	"log/slog"
+	"strings"
+)
+
+// ImportMembers validates and normalizes rows, checks them for duplicates within
+// the batch and against existing members and, unless dryRun is set, saves them
+// in one transaction. With skipInvalid unset a single bad row rejects the whole
+// batch with service.ErrImportRejected; the report is returned in either case.
+func (s *Service) ImportMembers(ctx context.Context, rows []model.ImportRow, dryRun, skipInvalid bool) (model.ImportReport, error) {
+	const op = "members.Service.ImportMembers"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.Int("rows", len(rows)), slog.Bool("dry_run", dryRun))
+	log.Info("importing members")
+
+	report := model.ImportReport{DryRun: dryRun, Results: make([]model.ImportResult, 0, len(rows))}
+
+	seenEmails, seenPhones := make(map[string]bool), make(map[string]bool)
+	var emails, phones []string
+
+	for _, row := range rows {
+		res := model.ImportResult{Line: row.Line, Outcome: model.ImportOK}
+		res.Member = model.Member{
+			FullName: strings.TrimSpace(row.FullName),
+			Email:    strings.TrimSpace(row.Email),
+			Phone:    strings.TrimSpace(row.Phone),
+			Status:   row.Status,
+		}
+		if res.Member.Status == "" {
+			res.Member.Status = model.StatusPending
+		}
+
+		email, emailOK := model.NormalizeEmail(res.Member.Email)
+		phone, phoneOK := model.NormalizePhone(res.Member.Phone)
+		if emailOK {
+			res.Member.Email = email
+		}
+		if phoneOK {
+			res.Member.Phone = phone
+		}
+
+		switch m := res.Member; {
+		case m.FullName == "" || m.Email == "" || m.Phone == "":
+			res.Outcome = model.ImportMissingFields
+		case !emailOK:
+			res.Outcome = model.ImportInvalidEmail
+		case !phoneOK:
+			res.Outcome = model.ImportInvalidPhone
+		case m.Status != model.StatusPending && m.Status != model.StatusApproved && m.Status != model.StatusDeclined:
+			res.Outcome = model.ImportUnknownStatus
+		case seenEmails[m.Email]:
+			res.Outcome = model.ImportDuplicateEmail
+		case seenPhones[m.Phone]:
+			res.Outcome = model.ImportDuplicatePhone
+		default:
+			seenEmails[m.Email], seenPhones[m.Phone] = true, true
+			emails, phones = append(emails, m.Email), append(phones, m.Phone)
+		}
+
+		report.Results = append(report.Results, res)
+	}
+
+	if len(emails) > 0 {
+		takenEmails, takenPhones, err := s.memberStorage.FindExistingContacts(ctx, emails, phones)
+		if err != nil {
+			log.Error("failed to check existing members", "error", err)
+			return model.ImportReport{}, fmt.Errorf("%s: %w", op, service.ErrFailedToImport)
+		}
+
+		for i, res := range report.Results {
+			if res.Outcome != model.ImportOK {
+				continue
+			}
+			switch {
+			case takenEmails[res.Member.Email]:
+				report.Results[i].Outcome = model.ImportDuplicateEmail
+			case takenPhones[res.Member.Phone]:
+				report.Results[i].Outcome = model.ImportDuplicatePhone
+			}
+		}
+	}
+
+	failed := report.Failed()
+	if dryRun {
+		log.Info("import checked", "failed", failed)
+		return report, nil
+	}
+
+	if failed > 0 && !skipInvalid {
+		log.Warn("import rejected", "failed", failed)
+		return report, fmt.Errorf("%s: %w", op, service.ErrImportRejected)
+	}
+
+	var valid []model.Member
+	for _, res := range report.Results {
+		if res.Outcome == model.ImportOK {
+			valid = append(valid, res.Member)
+		}
+	}
+
+	if len(valid) > 0 {
+		ids, err := s.memberStorage.AddMembers(ctx, valid)
+		if err != nil {
+			log.Error("failed to save members", "error", err)
+
+			switch {
+			case errors.Is(err, storage.ErrEmailDuplicate):
+				return report, fmt.Errorf("%s: %w", op, service.ErrEmailDuplicate)
+			case errors.Is(err, storage.ErrPhoneDuplicate):
+				return report, fmt.Errorf("%s: %w", op, service.ErrPhoneDuplicate)
+			default:
+				return model.ImportReport{}, fmt.Errorf("%s: %w", op, service.ErrFailedToImport)
+			}
+		}
+
+		next := 0
+		for i, res := range report.Results {
+			if res.Outcome == model.ImportOK {
+				report.Results[i].Member.ID = ids[next]
+				next++
+			}
+		}
+	}
+
+	report.Committed = true
+
+	log.Info("members imported", "imported", len(valid), "skipped", failed)
+	return report, nil
+}
@@ -4,38 +4,61 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/i18n"
 	"github.com/Ilya-Repin/orchestra_api/internal/infra/storage"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/tracing"
 	"github.com/Ilya-Repin/orchestra_api/internal/model"
 	"github.com/Ilya-Repin/orchestra_api/internal/service"
 	"github.com/google/uuid"
 	"log/slog"
+	"strings"
 )
 
 type Service struct {
 	log           *slog.Logger
 	memberStorage MemberStorage
+	settler       PaymentSettler
 }
 
 type MemberStorage interface {
 	AddMember(ctx context.Context, fullName, email, phone string) (uuid.UUID, error)
+	AddMembers(ctx context.Context, members []model.Member) ([]uuid.UUID, error)
+	FindExistingContacts(ctx context.Context, emails, phones []string) (map[string]bool, map[string]bool, error)
 	GetMember(ctx context.Context, id uuid.UUID) (model.Member, error)
-	GetMembers(ctx context.Context) ([]model.Member, error)
-	GetMembersWithStatus(ctx context.Context, status model.MemberStatus) ([]model.Member, error)
-	DeleteMember(ctx context.Context, id uuid.UUID) error
-	UpdateMember(ctx context.Context, id uuid.UUID, fullName, email, phone string) error
-	UpdateMemberStatus(ctx context.Context, id uuid.UUID, status model.MemberStatus) error
+	GetMemberFromPrimary(ctx context.Context, id uuid.UUID) (model.Member, error)
+	GetMemberLanguage(ctx context.Context, id uuid.UUID) (string, error)
+	GetMembers(ctx context.Context, filter model.MemberFilter) ([]model.Member, error)
+	StreamMembers(ctx context.Context, status model.MemberStatus, fn func(model.Member) error) error
+	EraseMember(ctx context.Context, id uuid.UUID, version int) ([]model.EventPayment, error)
+	UpdateMember(ctx context.Context, id uuid.UUID, fullName, email, phone string, version int) (int, error)
+	UpdateMemberStatus(ctx context.Context, id uuid.UUID, status model.MemberStatus, version int) (int, error)
+	PatchMember(ctx context.Context, id uuid.UUID, patch model.MemberProfilePatch, version int) (int, error)
 }
 
-func New(log *slog.Logger, storage MemberStorage) *Service {
-	return &Service{log: log.With("component", "service"), memberStorage: storage}
+// PaymentSettler tells the payment provider about the payments of registrations an erasure
+// cancelled.
+type PaymentSettler interface {
+	SettlePayments(ctx context.Context, payments []model.EventPayment)
+}
+
+func New(log *slog.Logger, storage MemberStorage, settler PaymentSettler) *Service {
+	return &Service{log: log.With("component", "service"), memberStorage: storage, settler: settler}
 }
 
 func (s *Service) AddMember(ctx context.Context, fullName, email, phone string) (uuid.UUID, error) {
 	const op = "members.Service.AddMember"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
 
-	log := s.log.With(slog.String("op", op))
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op))
 	log.Info("adding new member")
 
+	email, phone, err := normalizeProfile(fullName, email, phone)
+	if err != nil {
+		log.Warn("invalid profile", "error", err)
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+
 	id, err := s.memberStorage.AddMember(ctx, fullName, email, phone)
 	if err != nil {
 		log.Error("failed to save member", "error", err)
@@ -46,9 +69,11 @@ func (s *Service) AddMember(ctx context.Context, fullName, email, phone string)
 		case errors.Is(err, storage.ErrPhoneDuplicate):
 			return uuid.UUID{}, fmt.Errorf("%s: %w", op, service.ErrPhoneDuplicate)
 		case errors.Is(err, storage.ErrInvalidEmail):
-			return uuid.UUID{}, fmt.Errorf("%s: %w", op, service.ErrInvalidEmail)
+			return uuid.UUID{}, fmt.Errorf("%s: %w", op, service.InvalidField("email", service.ErrInvalidEmail))
 		case errors.Is(err, storage.ErrInvalidPhone):
-			return uuid.UUID{}, fmt.Errorf("%s: %w", op, service.ErrInvalidPhone)
+			return uuid.UUID{}, fmt.Errorf("%s: %w", op, service.InvalidField("phone", service.ErrInvalidPhone))
+		case service.ConstraintError(err) != nil:
+			return uuid.UUID{}, fmt.Errorf("%s: %w", op, service.ConstraintError(err))
 		default:
 			return uuid.UUID{}, fmt.Errorf("%s: %w", op, service.ErrFailedToAddMember)
 		}
@@ -61,8 +86,10 @@ func (s *Service) AddMember(ctx context.Context, fullName, email, phone string)
 
 func (s *Service) GetMember(ctx context.Context, id uuid.UUID) (model.Member, error) {
 	const op = "members.Service.GetMember"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
 
-	log := s.log.With(slog.String("op", op), slog.String("id", id.String()))
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.String("id", id.String()))
 	log.Info("getting member")
 
 	member, err := s.memberStorage.GetMember(ctx, id)
@@ -80,29 +107,38 @@ func (s *Service) GetMember(ctx context.Context, id uuid.UUID) (model.Member, er
 	return member, nil
 }
 
-func (s *Service) GetMembers(ctx context.Context, status model.MemberStatus) (members []model.Member, err error) {
+// GetLanguage returns the language the member prefers, empty if none. It reads the primary,
+// so a preference just changed applies at once.
+func (s *Service) GetLanguage(ctx context.Context, id uuid.UUID) (string, error) {
+	const op = "members.Service.GetLanguage"
+
+	lang, err := s.memberStorage.GetMemberLanguage(ctx, id)
+	if err != nil {
+		if errors.Is(err, storage.ErrMemberNotFound) {
+			return "", fmt.Errorf("%s: %w", op, service.ErrMemberNotFound)
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return lang, nil
+}
+
+func (s *Service) GetMembers(ctx context.Context, filter model.MemberFilter) ([]model.Member, error) {
 	const op = "members.Service.GetAllMembers"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
 
-	log := s.log.With(slog.String("op", op))
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op))
 	log.Info("getting all members")
 
-	if len(status) == 0 {
-		members, err = s.memberStorage.GetMembers(ctx)
-		if err != nil {
-			log.Error("failed to get members", "error", err)
-			return nil, fmt.Errorf("%s: %w", op, service.ErrFailedToGetMembers)
-		}
-	} else {
-
-		if status != model.StatusDeclined && status != model.StatusApproved && status != model.StatusPending {
-			return nil, service.ErrUnknownStatus
-		}
+	if status := filter.Status; len(status) != 0 && status != model.StatusDeclined && status != model.StatusApproved && status != model.StatusPending {
+		return nil, service.ErrUnknownStatus
+	}
 
-		members, err = s.memberStorage.GetMembersWithStatus(ctx, model.MemberStatus(status))
-		if err != nil {
-			log.Error("failed to get members", "error", err)
-			return nil, fmt.Errorf("%s: %w", op, service.ErrFailedToGetMembers)
-		}
+	members, err := s.memberStorage.GetMembers(ctx, filter)
+	if err != nil {
+		log.Error("failed to get members", "error", err)
+		return nil, fmt.Errorf("%s: %w", op, service.ErrFailedToGetMembers)
 	}
 
 	log.Info("members retrieved", "len", len(members))
@@ -110,69 +146,251 @@ func (s *Service) GetMembers(ctx context.Context, status model.MemberStatus) (me
 	return members, nil
 }
 
-func (s *Service) DeleteMember(ctx context.Context, id uuid.UUID) error {
+func (s *Service) ExportMembers(ctx context.Context, status model.MemberStatus, fn func(model.Member) error) error {
+	const op = "members.Service.ExportMembers"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.String("status", string(status)))
+	log.Info("exporting members")
+
+	if len(status) != 0 && status != model.StatusDeclined && status != model.StatusApproved && status != model.StatusPending {
+		return service.ErrUnknownStatus
+	}
+
+	if err := s.memberStorage.StreamMembers(ctx, status, fn); err != nil {
+		log.Error("failed to export members", "error", err)
+		return fmt.Errorf("%s: %w", op, service.ErrFailedToExport)
+	}
+
+	log.Info("members exported")
+	return nil
+}
+
+// DeleteMember erases the member's personal data. Their registrations are kept anonymized,
+// so attendance statistics stay intact. A non-zero version is the one the caller last saw;
+// the delete fails with ErrVersionMismatch if the member has changed since.
+func (s *Service) DeleteMember(ctx context.Context, id uuid.UUID, version int) error {
 	const op = "members.Service.DeleteMember"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
 
-	log := s.log.With(slog.String("op", op), slog.String("id", id.String()))
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.String("id", id.String()))
 	log.Info("deleting member")
 
-	err := s.memberStorage.DeleteMember(ctx, id)
+	payments, err := s.memberStorage.EraseMember(ctx, id, version)
 	if err != nil {
 		if errors.Is(err, storage.ErrMemberNotFound) {
 			log.Warn("member not found", "error", err)
 			return fmt.Errorf("%s: %w", op, service.ErrMemberNotFound)
 		}
+		if errors.Is(err, storage.ErrVersionMismatch) {
+			log.Warn("member version mismatch", "error", err)
+			return fmt.Errorf("%s: %w", op, service.ErrVersionMismatch)
+		}
 
+		if cerr := service.ConstraintError(err); cerr != nil {
+			log.Warn("rejected by a constraint", "error", err)
+			return fmt.Errorf("%s: %w", op, cerr)
+		}
 		log.Error("failed to delete member", "error", err)
 		return fmt.Errorf("%s: %w", op, service.ErrFailedToDeleteMember)
 	}
+	s.settler.SettlePayments(ctx, payments)
 
 	log.Info("member deleted")
 	return nil
 }
 
-func (s *Service) UpdateMember(ctx context.Context, id uuid.UUID, fullName, email, phone string) error {
+// UpdateMember rewrites the member profile and returns its new version. A non-zero version is
+// the one the caller last saw; the update fails with ErrVersionMismatch if the member has changed since.
+func (s *Service) UpdateMember(ctx context.Context, id uuid.UUID, fullName, email, phone string, version int) (int, error) {
 	const op = "members.Service.UpdateMember"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
 
-	log := s.log.With(slog.String("op", op), slog.String("id", id.String()))
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.String("id", id.String()))
 	log.Info("updating member")
 
-	err := s.memberStorage.UpdateMember(ctx, id, fullName, email, phone)
+	email, phone, err := normalizeProfile(fullName, email, phone)
 	if err != nil {
-		if errors.Is(err, storage.ErrMemberNotFound) {
+		log.Warn("invalid profile", "error", err)
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	newVersion, err := s.memberStorage.UpdateMember(ctx, id, fullName, email, phone, version)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrMemberNotFound):
 			log.Warn("member not found", "error", err)
-			return fmt.Errorf("%s: %w", op, service.ErrMemberNotFound)
+			return 0, fmt.Errorf("%s: %w", op, service.ErrMemberNotFound)
+		case errors.Is(err, storage.ErrVersionMismatch):
+			log.Warn("member version mismatch", "error", err)
+			return 0, fmt.Errorf("%s: %w", op, service.ErrVersionMismatch)
+		case errors.Is(err, storage.ErrEmailDuplicate):
+			return 0, fmt.Errorf("%s: %w", op, service.ErrEmailDuplicate)
+		case errors.Is(err, storage.ErrPhoneDuplicate):
+			return 0, fmt.Errorf("%s: %w", op, service.ErrPhoneDuplicate)
 		}
 
+		if cerr := service.ConstraintError(err); cerr != nil {
+			log.Warn("rejected by a constraint", "error", err)
+			return 0, fmt.Errorf("%s: %w", op, cerr)
+		}
 		log.Error("failed to update member", "error", err)
-		return fmt.Errorf("%s: %w", op, service.ErrFailedToUpdateMember)
+		return 0, fmt.Errorf("%s: %w", op, service.ErrFailedToUpdateMember)
 	}
 
 	log.Info("member updated")
-	return nil
+	return newVersion, nil
+}
+
+// maxPatchAttempts bounds how often an unconditional patch is re-applied when the member
+// changes between reading it for validation and writing it back.
+const maxPatchAttempts = 3
+
+// PatchMember applies a partial profile update and returns the member's new version. Provided
+// contacts are normalized and the merged profile is validated before anything is written. A
+// non-zero version is the one the caller last saw; the patch fails with ErrVersionMismatch if
+// the member has changed since. Without one the patch is written against the version it was
+// validated on and re-applied if the member changes in between.
+func (s *Service) PatchMember(ctx context.Context, id uuid.UUID, patch model.MemberProfilePatch, version int) (int, error) {
+	const op = "members.Service.PatchMember"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.String("id", id.String()))
+	log.Info("patching member")
+
+	if patch.Language != nil && *patch.Language != "" {
+		lang, ok := i18n.Parse(*patch.Language)
+		if !ok {
+			log.Warn("unknown language", "language", *patch.Language)
+			return 0, fmt.Errorf("%s: %w", op, service.InvalidField("language", service.ErrUnknownLanguage))
+		}
+		language := string(lang)
+		patch.Language = &language
+	}
+
+	for attempt := 1; ; attempt++ {
+		current, err := s.memberStorage.GetMemberFromPrimary(ctx, id)
+		if err != nil {
+			if errors.Is(err, storage.ErrMemberNotFound) {
+				log.Warn("member not found", "error", err)
+				return 0, fmt.Errorf("%s: %w", op, service.ErrMemberNotFound)
+			}
+			log.Error("failed to get member", "error", err)
+			return 0, fmt.Errorf("%s: %w", op, service.ErrFailedToUpdateMember)
+		}
+
+		if version != 0 && current.Version != version {
+			log.Warn("member version mismatch", "expected", version, "actual", current.Version)
+			return 0, fmt.Errorf("%s: %w", op, service.ErrVersionMismatch)
+		}
+
+		merged := patch.Apply(current)
+		email, phone, err := normalizeProfile(merged.FullName, merged.Email, merged.Phone)
+		if err != nil {
+			log.Warn("invalid profile", "error", err)
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+		if patch.Email != nil {
+			patch.Email = &email
+		}
+		if patch.Phone != nil {
+			patch.Phone = &phone
+		}
+
+		if patch.Empty() {
+			return current.Version, nil
+		}
+
+		newVersion, err := s.memberStorage.PatchMember(ctx, id, patch, current.Version)
+		if err != nil {
+			switch {
+			case errors.Is(err, storage.ErrMemberNotFound):
+				log.Warn("member not found", "error", err)
+				return 0, fmt.Errorf("%s: %w", op, service.ErrMemberNotFound)
+			case errors.Is(err, storage.ErrVersionMismatch):
+				if version == 0 && attempt < maxPatchAttempts {
+					continue
+				}
+				log.Warn("member version mismatch", "error", err)
+				return 0, fmt.Errorf("%s: %w", op, service.ErrVersionMismatch)
+			case errors.Is(err, storage.ErrEmailDuplicate):
+				return 0, fmt.Errorf("%s: %w", op, service.ErrEmailDuplicate)
+			case errors.Is(err, storage.ErrPhoneDuplicate):
+				return 0, fmt.Errorf("%s: %w", op, service.ErrPhoneDuplicate)
+			}
+
+			if cerr := service.ConstraintError(err); cerr != nil {
+				log.Warn("rejected by a constraint", "error", err)
+				return 0, fmt.Errorf("%s: %w", op, cerr)
+			}
+			log.Error("failed to patch member", "error", err)
+			return 0, fmt.Errorf("%s: %w", op, service.ErrFailedToUpdateMember)
+		}
+
+		log.Info("member patched", "version", newVersion)
+		return newVersion, nil
+	}
 }
 
-func (s *Service) UpdateMemberStatus(ctx context.Context, id uuid.UUID, status model.MemberStatus) error {
+// UpdateMemberStatus sets the member status and returns the member's new version. A non-zero
+// version is the one the caller last saw; the update fails with ErrVersionMismatch if the
+// member has changed since.
+func (s *Service) UpdateMemberStatus(ctx context.Context, id uuid.UUID, status model.MemberStatus, version int) (int, error) {
 	const op = "members.Service.UpdateMemberStatus"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
 
-	log := s.log.With(slog.String("op", op), slog.String("id", id.String()), slog.String("status", string(status)))
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.String("id", id.String()), slog.String("status", string(status)))
 	log.Info("updating member status")
 
 	if status != model.StatusDeclined && status != model.StatusApproved && status != model.StatusPending {
-		return service.ErrUnknownStatus
+		return 0, service.ErrUnknownStatus
 	}
 
-	err := s.memberStorage.UpdateMemberStatus(ctx, id, status)
+	newVersion, err := s.memberStorage.UpdateMemberStatus(ctx, id, status, version)
 	if err != nil {
 		if errors.Is(err, storage.ErrMemberNotFound) {
 			log.Warn("member not found", "error", err)
-			return fmt.Errorf("%s: %w", op, service.ErrMemberNotFound)
+			return 0, fmt.Errorf("%s: %w", op, service.ErrMemberNotFound)
+		}
+		if errors.Is(err, storage.ErrVersionMismatch) {
+			log.Warn("member version mismatch", "error", err)
+			return 0, fmt.Errorf("%s: %w", op, service.ErrVersionMismatch)
 		}
 
+		if cerr := service.ConstraintError(err); cerr != nil {
+			log.Warn("rejected by a constraint", "error", err)
+			return 0, fmt.Errorf("%s: %w", op, cerr)
+		}
 		log.Error("failed to update member status", "error", err)
-		return fmt.Errorf("%s: %w", op, service.ErrFailedToUpdateMemStatus)
+		return 0, fmt.Errorf("%s: %w", op, service.ErrFailedToUpdateMemStatus)
 	}
 
 	log.Info("member status updated")
-	return nil
+	return newVersion, nil
+}
+
+// normalizeProfile checks the profile and brings email and phone to the form they are stored
+// and compared in. Every rejected field is reported in a ValidationError.
+func normalizeProfile(fullName, email, phone string) (string, string, error) {
+	verr := &service.ValidationError{}
+	if strings.TrimSpace(fullName) == "" {
+		verr.Add("full_name", service.ErrInvalidFullName)
+	}
+
+	email, ok := model.NormalizeEmail(email)
+	if !ok {
+		verr.Add("email", service.ErrInvalidEmail)
+	}
+
+	phone, ok = model.NormalizePhone(phone)
+	if !ok {
+		verr.Add("phone", service.ErrInvalidPhone)
+	}
+
+	return email, phone, verr.OrNil()
 }
@@ -0,0 +1,244 @@
+package membership
+
+import (
+	"context"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/tracing"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"github.com/Ilya-Repin/orchestra_api/internal/service"
+	"github.com/google/uuid"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// paidAtLayouts are the date formats accepted in the paid_at column of an import.
+var paidAtLayouts = []string{time.RFC3339, "2006-01-02", "02.01.2006"}
+
+// ImportPayments validates the rows, resolves the members they name, checks references for
+// duplicates and works out the period of every payment against the current memberships.
+// Unless dryRun is set the payments are saved in one transaction. With skipInvalid unset a
+// single bad row rejects the whole batch with service.ErrImportRejected; the report is
+// returned in either case.
+func (s *Service) ImportPayments(ctx context.Context, rows []model.PaymentImportRow, dryRun, skipInvalid bool) (model.PaymentImportReport, error) {
+	const op = "membership.Service.ImportPayments"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.Int("rows", len(rows)), slog.Bool("dry_run", dryRun))
+	log.Info("importing payments")
+
+	report := model.PaymentImportReport{DryRun: dryRun, Results: make([]model.PaymentImportResult, 0, len(rows))}
+
+	seenReferences := make(map[string]bool)
+	var ids []uuid.UUID
+	var emails, references []string
+
+	for _, row := range rows {
+		res := model.PaymentImportResult{Line: row.Line, Outcome: model.ImportOK}
+		res.Payment = model.MembershipPayment{
+			Tier:      model.Tier(strings.ToLower(strings.TrimSpace(row.Tier))),
+			Source:    model.PaymentImport,
+			Reference: strings.TrimSpace(row.Reference),
+			Note:      strings.TrimSpace(row.Note),
+		}
+
+		memberID := strings.TrimSpace(row.MemberID)
+		res.Email = strings.TrimSpace(row.Email)
+		if email, ok := model.NormalizeEmail(res.Email); ok {
+			res.Email = email
+		}
+
+		amount, amountOK := parseRoubles(row.Amount)
+		paidAt, paidAtOK := parsePaidAt(row.PaidAt)
+		res.Payment.Amount, res.Payment.PaidAt = amount, paidAt
+
+		switch {
+		case memberID == "" && res.Email == "",
+			res.Payment.Tier == "", strings.TrimSpace(row.Amount) == "", strings.TrimSpace(row.PaidAt) == "":
+			res.Outcome = model.ImportMissingFields
+		case !res.Payment.Tier.Valid():
+			res.Outcome = model.ImportUnknownTier
+		case !amountOK:
+			res.Outcome = model.ImportInvalidAmount
+		case !paidAtOK:
+			res.Outcome = model.ImportInvalidDate
+		case res.Payment.Reference != "" && seenReferences[res.Payment.Reference]:
+			res.Outcome = model.ImportDuplicateReference
+		}
+
+		if res.Outcome == model.ImportOK && memberID != "" {
+			id, err := uuid.Parse(memberID)
+			if err != nil {
+				res.Outcome = model.ImportUnknownMember
+			}
+			res.Payment.MemberID = id
+		}
+
+		if res.Outcome == model.ImportOK {
+			if res.Payment.MemberID != uuid.Nil {
+				ids = append(ids, res.Payment.MemberID)
+			} else {
+				emails = append(emails, res.Email)
+			}
+			if res.Payment.Reference != "" {
+				seenReferences[res.Payment.Reference] = true
+				references = append(references, res.Payment.Reference)
+			}
+		}
+
+		report.Results = append(report.Results, res)
+	}
+
+	if len(ids) > 0 || len(emails) > 0 {
+		if err := s.resolvePayments(ctx, report.Results, ids, emails, references); err != nil {
+			log.Error("failed to check import rows", "error", err)
+			return model.PaymentImportReport{}, fmt.Errorf("%s: %w", op, service.ErrFailedToImportPayments)
+		}
+	}
+
+	failed := report.Failed()
+	if dryRun {
+		log.Info("import checked", "failed", failed)
+		return report, nil
+	}
+
+	if failed > 0 && !skipInvalid {
+		log.Warn("import rejected", "failed", failed)
+		return report, fmt.Errorf("%s: %w", op, service.ErrImportRejected)
+	}
+
+	var valid []model.MembershipPayment
+	for _, res := range report.Results {
+		if res.Outcome == model.ImportOK {
+			valid = append(valid, res.Payment)
+		}
+	}
+
+	if len(valid) > 0 {
+		saved, err := s.storage.RecordMembershipPayments(ctx, valid)
+		if err != nil {
+			if err := paymentError(err); err != nil {
+				log.Warn("import rejected by storage", "error", err)
+				return report, fmt.Errorf("%s: %w", op, err)
+			}
+			log.Error("failed to save payments", "error", err)
+			return model.PaymentImportReport{}, fmt.Errorf("%s: %w", op, service.ErrFailedToImportPayments)
+		}
+
+		next := 0
+		for i, res := range report.Results {
+			if res.Outcome == model.ImportOK {
+				report.Results[i].Payment = saved[next]
+				next++
+			}
+		}
+	}
+
+	report.Committed = true
+
+	log.Info("payments imported", "imported", len(valid), "skipped", failed)
+	return report, nil
+}
+
+// resolvePayments fills in the members of the rows that are still valid and marks the rows
+// naming unknown members, recorded references or a downgrade. Periods are computed by
+// applying the rows in file order on top of the current memberships.
+func (s *Service) resolvePayments(ctx context.Context, results []model.PaymentImportResult, ids []uuid.UUID, emails, references []string) error {
+	found, err := s.storage.FindMembers(ctx, ids, emails)
+	if err != nil {
+		return err
+	}
+
+	byEmail := make(map[string]uuid.UUID, len(found))
+	memberIDs := make([]uuid.UUID, 0, len(found))
+	for id, email := range found {
+		byEmail[email] = id
+		memberIDs = append(memberIDs, id)
+	}
+
+	taken := make(map[string]bool)
+	if len(references) > 0 {
+		if taken, err = s.storage.FindPaymentReferences(ctx, references); err != nil {
+			return err
+		}
+	}
+
+	memberships, err := s.storage.GetMemberships(ctx, memberIDs)
+	if err != nil {
+		return err
+	}
+
+	for i := range results {
+		res := &results[i]
+		if res.Outcome != model.ImportOK {
+			continue
+		}
+
+		if res.Payment.MemberID == uuid.Nil {
+			res.Payment.MemberID = byEmail[res.Email]
+		}
+		email, ok := found[res.Payment.MemberID]
+		switch {
+		case !ok:
+			res.Outcome = model.ImportUnknownMember
+			continue
+		case taken[res.Payment.Reference]:
+			res.Outcome = model.ImportDuplicateReference
+			continue
+		}
+		res.Email = email
+
+		current, ok := memberships[res.Payment.MemberID]
+		if !ok {
+			current = model.Membership{MemberID: res.Payment.MemberID}
+		}
+
+		renewed, paid, ok := current.Renew(res.Payment)
+		if !ok {
+			res.Outcome = model.ImportTierDowngrade
+			continue
+		}
+		memberships[res.Payment.MemberID] = renewed
+		res.Payment = paid
+	}
+
+	return nil
+}
+
+// parseRoubles reads an amount in roubles such as "1500", "1 500,50" or "1500.5" and returns
+// it in kopecks. Only positive amounts are accepted.
+func parseRoubles(s string) (int64, bool) {
+	s = strings.NewReplacer(" ", "", " ", "", ",", ".").Replace(strings.TrimSpace(s))
+
+	whole, fraction, _ := strings.Cut(s, ".")
+	if whole == "" || len(fraction) > 2 {
+		return 0, false
+	}
+	for len(fraction) < 2 {
+		fraction += "0"
+	}
+
+	roubles, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || roubles < 0 {
+		return 0, false
+	}
+	kopecks, err := strconv.ParseInt(fraction, 10, 64)
+	if err != nil || kopecks < 0 {
+		return 0, false
+	}
+
+	amount := roubles*100 + kopecks
+	return amount, amount > 0
+}
+
+func parsePaidAt(s string) (time.Time, bool) {
+	s = strings.TrimSpace(s)
+	for _, layout := range paidAtLayouts {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,178 @@
+package membership
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/config"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/storage"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/tracing"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"github.com/Ilya-Repin/orchestra_api/internal/service"
+	"github.com/google/uuid"
+	"log/slog"
+	"time"
+)
+
+// Service keeps track of membership tiers and the yearly dues that pay for them.
+type Service struct {
+	log     *slog.Logger
+	storage MembershipStorage
+	cfg     config.MembershipConfig
+}
+
+type MembershipStorage interface {
+	GetMembership(ctx context.Context, memberID uuid.UUID) (model.Membership, error)
+	GetMemberships(ctx context.Context, memberIDs []uuid.UUID) (map[uuid.UUID]model.Membership, error)
+	GetMembershipPayments(ctx context.Context, memberID uuid.UUID) ([]model.MembershipPayment, error)
+	RecordMembershipPayments(ctx context.Context, payments []model.MembershipPayment) ([]model.MembershipPayment, error)
+	FindMembers(ctx context.Context, ids []uuid.UUID, emails []string) (map[uuid.UUID]string, error)
+	FindPaymentReferences(ctx context.Context, references []string) (map[string]bool, error)
+	ExpireMemberships(ctx context.Context) (int, error)
+	GetRenewals(ctx context.Context, until time.Time) ([]model.RenewalEntry, error)
+}
+
+func New(log *slog.Logger, storage MembershipStorage, cfg config.MembershipConfig) *Service {
+	return &Service{log: log.With("component", "service"), storage: storage, cfg: cfg}
+}
+
+// GetMembership returns the member's membership together with the dues paid, latest first.
+func (s *Service) GetMembership(ctx context.Context, memberID uuid.UUID) (model.Membership, []model.MembershipPayment, error) {
+	const op = "membership.Service.GetMembership"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.String("member_id", memberID.String()))
+	log.Info("getting membership")
+
+	membership, err := s.storage.GetMembership(ctx, memberID)
+	if err != nil {
+		if errors.Is(err, storage.ErrMemberNotFound) {
+			log.Warn("member not found", "error", err)
+			return model.Membership{}, nil, fmt.Errorf("%s: %w", op, service.ErrMemberNotFound)
+		}
+		log.Error("failed to get membership", "error", err)
+		return model.Membership{}, nil, fmt.Errorf("%s: %w", op, service.ErrFailedToGetMembership)
+	}
+
+	payments, err := s.storage.GetMembershipPayments(ctx, memberID)
+	if err != nil {
+		log.Error("failed to get payments", "error", err)
+		return model.Membership{}, nil, fmt.Errorf("%s: %w", op, service.ErrFailedToGetMembership)
+	}
+
+	return membership, payments, nil
+}
+
+// RecordPayment records dues paid by hand and renews the membership. A payment without a
+// date is taken as paid now. The returned payment carries the period it pays for.
+func (s *Service) RecordPayment(ctx context.Context, payment model.MembershipPayment) (model.MembershipPayment, error) {
+	const op = "membership.Service.RecordPayment"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.String("member_id", payment.MemberID.String()))
+	log.Info("recording payment", "tier", payment.Tier)
+
+	verr := &service.ValidationError{}
+	if !payment.Tier.Valid() {
+		verr.Add("tier", service.ErrUnknownTier)
+	}
+	if payment.Amount <= 0 {
+		verr.Add("amount", service.ErrInvalidAmount)
+	}
+	if err := verr.OrNil(); err != nil {
+		log.Warn("invalid payment", "error", err)
+		return model.MembershipPayment{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if payment.PaidAt.IsZero() {
+		payment.PaidAt = time.Now()
+	}
+	payment.Source = model.PaymentManual
+
+	saved, err := s.storage.RecordMembershipPayments(ctx, []model.MembershipPayment{payment})
+	if err != nil {
+		if err := paymentError(err); err != nil {
+			log.Warn("payment rejected", "error", err)
+			return model.MembershipPayment{}, fmt.Errorf("%s: %w", op, err)
+		}
+		log.Error("failed to record payment", "error", err)
+		return model.MembershipPayment{}, fmt.Errorf("%s: %w", op, service.ErrFailedToRecordPayment)
+	}
+
+	log.Info("payment recorded", "id", saved[0].ID, "period_end", saved[0].PeriodEnd)
+	return saved[0], nil
+}
+
+// GetRenewals lists the members whose membership has expired or expires within the given
+// period, soonest first. A zero period uses the configured renewal window.
+func (s *Service) GetRenewals(ctx context.Context, within time.Duration) ([]model.RenewalEntry, error) {
+	const op = "membership.Service.GetRenewals"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	if within <= 0 {
+		within = s.cfg.RenewalWindow
+	}
+
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.Duration("within", within))
+	log.Info("getting renewals")
+
+	entries, err := s.storage.GetRenewals(ctx, time.Now().Add(within))
+	if err != nil {
+		log.Error("failed to get renewals", "error", err)
+		return nil, fmt.Errorf("%s: %w", op, service.ErrFailedToGetRenewals)
+	}
+
+	return entries, nil
+}
+
+// ExpireMemberships flags the memberships whose period has ended and returns their number.
+func (s *Service) ExpireMemberships(ctx context.Context) (int, error) {
+	const op = "membership.Service.ExpireMemberships"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	expired, err := s.storage.ExpireMemberships(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return expired, nil
+}
+
+// RunExpiryJob flags expired memberships. It is run by the job runner on the configured
+// expiry interval.
+func (s *Service) RunExpiryJob(ctx context.Context) error {
+	const op = "membership.Service.RunExpiryJob"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	expired, err := s.ExpireMemberships(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if expired > 0 {
+		s.log.Info("memberships expired", slog.String("op", op), slog.Int("count", expired))
+	}
+
+	return nil
+}
+
+// paymentError translates the storage errors a payment can be rejected with and returns nil
+// for any other error.
+func paymentError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrMemberNotFound):
+		return service.ErrMemberNotFound
+	case errors.Is(err, storage.ErrTierDowngrade):
+		return service.ErrTierDowngrade
+	case errors.Is(err, storage.ErrPaymentDuplicate):
+		return service.ErrPaymentDuplicate
+	case errors.Is(err, storage.ErrInvalidAmount):
+		return service.InvalidField("amount", service.ErrInvalidAmount)
+	}
+
+	return service.ConstraintError(err)
+}
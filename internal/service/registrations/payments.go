@@ -0,0 +1,199 @@
+package registrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/payment"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/storage"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/tracing"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"github.com/Ilya-Repin/orchestra_api/internal/service"
+	"github.com/google/uuid"
+	"log/slog"
+	"net/http"
+)
+
+type PaymentStorage interface {
+	CreateEventPayment(ctx context.Context, memberID uuid.UUID, eventID int, provider string) (model.EventPayment, error)
+	SetPaymentConfirmation(ctx context.Context, id int, externalID, confirmationURL string) error
+	CancelEventPayment(ctx context.Context, id int) error
+	ApplyPaymentNotification(ctx context.Context, n model.PaymentNotification) (model.EventPayment, bool, error)
+	ReleaseUnpaidRegistrations(ctx context.Context) ([]model.EventPayment, error)
+	GetRefundsDue(ctx context.Context) ([]model.EventPayment, error)
+	MarkPaymentRefunded(ctx context.Context, id int, refundID string) error
+}
+
+type PaymentProvider interface {
+	Name() string
+	CreatePayment(ctx context.Context, p model.EventPayment) (model.EventPayment, error)
+	CancelPayment(ctx context.Context, p model.EventPayment) error
+	Refund(ctx context.Context, p model.EventPayment) (string, error)
+	ParseNotification(ctx context.Context, header http.Header, body []byte) (model.PaymentNotification, error)
+}
+
+// startPayment creates the payment for a seat just held for the member. If the provider
+// can not take it, the seat is released again.
+func (s *Service) startPayment(ctx context.Context, memberID uuid.UUID, eventID int) (model.EventPayment, error) {
+	p, err := s.paymentStorage.CreateEventPayment(ctx, memberID, eventID, s.payments.Name())
+	if err != nil {
+		return model.EventPayment{}, err
+	}
+
+	created, err := s.payments.CreatePayment(ctx, p)
+	if err == nil {
+		err = s.paymentStorage.SetPaymentConfirmation(ctx, created.ID, created.ExternalID, created.ConfirmationURL)
+		if err != nil {
+			if cancelErr := s.payments.CancelPayment(ctx, created); cancelErr != nil {
+				s.log.Error("failed to cancel payment with provider", "payment_id", created.ID, "error", cancelErr)
+			}
+		}
+	}
+	if err != nil {
+		if releaseErr := s.paymentStorage.CancelEventPayment(ctx, p.ID); releaseErr != nil {
+			s.log.Error("failed to release seat", "payment_id", p.ID, "error", releaseErr)
+		}
+		return model.EventPayment{}, err
+	}
+
+	return created, nil
+}
+
+// settlePayment tells the provider about a payment whose registration is gone: a pending
+// payment is cancelled and one due for refund is refunded. Failures are only logged; unpaid
+// payments expire at the provider and refunds are retried by the payment job.
+func (s *Service) settlePayment(ctx context.Context, p model.EventPayment) {
+	log := tracing.Logger(ctx, s.log).With(slog.Int("payment_id", p.ID), slog.String("status", string(p.Status)))
+
+	switch p.Status {
+	case model.PaymentCanceled:
+		if p.ExternalID == "" {
+			return
+		}
+		if err := s.payments.CancelPayment(ctx, p); err != nil {
+			log.Error("failed to cancel payment with provider", "error", err)
+		}
+	case model.PaymentRefundPending:
+		if err := s.refund(ctx, p); err != nil {
+			log.Error("failed to refund payment, will retry", "error", err)
+			return
+		}
+		log.Info("payment refunded", "amount", p.Amount)
+	}
+}
+
+// SettlePayments tells the provider about the payments of registrations cancelled elsewhere,
+// such as by the erasure of a member, like settlePayment does for a single cancellation.
+func (s *Service) SettlePayments(ctx context.Context, payments []model.EventPayment) {
+	for _, p := range payments {
+		s.settlePayment(ctx, p)
+	}
+}
+
+func (s *Service) refund(ctx context.Context, p model.EventPayment) error {
+	refundID, err := s.payments.Refund(ctx, p)
+	if err != nil {
+		return err
+	}
+
+	return s.paymentStorage.MarkPaymentRefunded(ctx, p.ID, refundID)
+}
+
+// HandlePaymentNotification applies a provider webhook. A notification that was already
+// processed is accepted again without changing anything.
+func (s *Service) HandlePaymentNotification(ctx context.Context, header http.Header, body []byte) error {
+	const op = "registrations.Service.HandlePaymentNotification"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op))
+
+	n, err := s.payments.ParseNotification(ctx, header, body)
+	if err != nil {
+		switch {
+		case errors.Is(err, payment.ErrInvalidSignature):
+			log.Warn("payment notification rejected", "error", err)
+			return fmt.Errorf("%s: %w", op, service.ErrInvalidSignature)
+		case errors.Is(err, payment.ErrMalformedNotification):
+			log.Warn("malformed payment notification", "error", err)
+			return fmt.Errorf("%s: %w", op, service.ErrMalformedNotification)
+		}
+
+		log.Error("failed to verify payment notification", "error", err)
+		return fmt.Errorf("%s: %w", op, service.ErrFailedToProcessWebhook)
+	}
+	if n.Status == "" {
+		log.Info("payment notification ignored", "key", n.Key)
+		return nil
+	}
+
+	log = log.With(slog.String("key", n.Key), slog.String("external_id", n.ExternalID))
+
+	p, applied, err := s.paymentStorage.ApplyPaymentNotification(ctx, n)
+	if err != nil {
+		if errors.Is(err, storage.ErrPaymentNotFound) {
+			log.Warn("payment notification for unknown payment")
+			return fmt.Errorf("%s: %w", op, service.ErrPaymentNotFound)
+		}
+
+		log.Error("failed to apply payment notification", "error", err)
+		return fmt.Errorf("%s: %w", op, service.ErrFailedToProcessWebhook)
+	}
+	if !applied {
+		log.Info("payment notification already processed")
+		return nil
+	}
+
+	log.Info("payment notification applied", "payment_id", p.ID, "status", p.Status, "registration_status", p.RegistrationStatus)
+	if p.Status == model.PaymentRefundPending {
+		s.settlePayment(ctx, p)
+	}
+
+	return nil
+}
+
+// ProcessPayments releases seats whose payment hold has run out and retries refunds the
+// provider has not accepted yet.
+func (s *Service) ProcessPayments(ctx context.Context) (released, refunded int, err error) {
+	const op = "registrations.Service.ProcessPayments"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	expired, err := s.paymentStorage.ReleaseUnpaidRegistrations(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	for _, p := range expired {
+		s.settlePayment(ctx, p)
+	}
+
+	due, err := s.paymentStorage.GetRefundsDue(ctx)
+	if err != nil {
+		return len(expired), 0, fmt.Errorf("%s: %w", op, err)
+	}
+	for _, p := range due {
+		if err := s.refund(ctx, p); err != nil {
+			s.log.Error("failed to refund payment", slog.String("op", op), slog.Int("payment_id", p.ID), "error", err)
+			continue
+		}
+		refunded++
+	}
+
+	return len(expired), refunded, nil
+}
+
+// RunPaymentJob processes payments. It is run by the job runner on the configured interval.
+func (s *Service) RunPaymentJob(ctx context.Context) error {
+	const op = "registrations.Service.RunPaymentJob"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	released, refunded, err := s.ProcessPayments(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if released > 0 || refunded > 0 {
+		s.log.Info("payments processed", slog.String("op", op), slog.Int("released", released), slog.Int("refunded", refunded))
+	}
+
+	return nil
+}
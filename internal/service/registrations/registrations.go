@@ -4,39 +4,61 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/config"
 	"github.com/Ilya-Repin/orchestra_api/internal/infra/storage"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/tracing"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
 	"github.com/Ilya-Repin/orchestra_api/internal/service"
 	"github.com/google/uuid"
 	"log/slog"
+	"time"
 )
 
 type Service struct {
-	log           *slog.Logger
-	regStorage    RegStorage
-	memberStorage MemberStorage
+	log            *slog.Logger
+	regStorage     RegStorage
+	memberStorage  MemberStorage
+	paymentStorage PaymentStorage
+	payments       PaymentProvider
+	notifier       SeatNotifier
+	cfg            config.PaymentsConfig
 }
 
 type MemberStorage interface {
 	CheckIsApproved(ctx context.Context, id uuid.UUID) (bool, error)
+	GetMembershipFromPrimary(ctx context.Context, memberID uuid.UUID) (model.Membership, error)
 }
 
 type RegStorage interface {
-	RegisterForEvent(ctx context.Context, memberID uuid.UUID, eventID int) (string, error)
-	CancelRegistration(ctx context.Context, memberID uuid.UUID, eventID int) (string, error)
+	RegisterForEvent(ctx context.Context, memberID uuid.UUID, eventID int, holdUntil time.Time) (string, error)
+	CancelRegistration(ctx context.Context, memberID uuid.UUID, eventID int, refundAfter time.Time) (string, *model.EventPayment, error)
 	GetRegistrationStatus(ctx context.Context, memberID uuid.UUID, eventID int) (string, error)
+	GetEventRoster(ctx context.Context, eventID int) ([]model.Member, error)
+	StreamEventRoster(ctx context.Context, eventID int, fn func(model.RosterEntry) error) error
+	GetEventAccess(ctx context.Context, eventID int) (model.EventAccess, error)
+	RecordAttendance(ctx context.Context, eventID int, attended []uuid.UUID, now time.Time) (model.AttendanceReport, error)
+	GetSeatNotices(ctx context.Context, eventID int, memberIDs []uuid.UUID) ([]model.SeatNotice, error)
 }
 
-func New(log *slog.Logger, regStorage RegStorage, memberStorage MemberStorage) *Service {
+func New(log *slog.Logger, regStorage RegStorage, memberStorage MemberStorage, paymentStorage PaymentStorage, payments PaymentProvider, notifier SeatNotifier, cfg config.PaymentsConfig) *Service {
 	return &Service{
-		log:           log.With("component", "service"),
-		regStorage:    regStorage,
-		memberStorage: memberStorage,
+		log:            log.With("component", "service"),
+		regStorage:     regStorage,
+		memberStorage:  memberStorage,
+		paymentStorage: paymentStorage,
+		payments:       payments,
+		notifier:       notifier,
+		cfg:            cfg,
 	}
 }
 
-func (s *Service) RegisterForEvent(ctx context.Context, memberID uuid.UUID, eventID int) (string, error) {
+// RegisterForEvent takes a seat at the event for the member. For a paid event the seat is held
+// in status pending_payment and the payment to complete is returned alongside.
+func (s *Service) RegisterForEvent(ctx context.Context, memberID uuid.UUID, eventID int) (string, *model.EventPayment, error) {
 	const op = "registrations.Service.RegisterForEvent"
-	log := s.log.With(slog.String("op", op), slog.String("member_id", memberID.String()), slog.Int("event_id", eventID))
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.String("member_id", memberID.String()), slog.Int("event_id", eventID))
 
 	log.Info("attempting registration")
 
@@ -44,64 +66,137 @@ func (s *Service) RegisterForEvent(ctx context.Context, memberID uuid.UUID, even
 	if err != nil {
 		if errors.Is(err, storage.ErrMemberNotFound) {
 			log.Error("member not found", "error", err)
-			return "", fmt.Errorf("%s: %w", op, service.ErrMemberNotFound)
+			return "", nil, fmt.Errorf("%s: %w", op, service.ErrMemberNotFound)
 		}
 		log.Error("failed to check member approval", "error", err)
-		return "", fmt.Errorf("%s: %w", op, service.ErrRegistrationFailed)
+		return "", nil, fmt.Errorf("%s: %w", op, service.ErrRegistrationFailed)
 	}
 
 	if !approved {
 		log.Error("registration denied: member not approved")
-		return "", fmt.Errorf("%s: %w", op, service.ErrMemberNotApproved)
+		return "", nil, fmt.Errorf("%s: %w", op, service.ErrMemberNotApproved)
 	}
 
-	status, err := s.regStorage.RegisterForEvent(ctx, memberID, eventID)
+	if err := s.checkAccess(ctx, memberID, eventID); err != nil {
+		switch {
+		case errors.Is(err, service.ErrTierRequired), errors.Is(err, service.ErrPriorityWindow):
+			log.Warn("registration denied by membership tier", "error", err)
+		default:
+			log.Error("failed to check event access", "error", err)
+		}
+		return "", nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	status, err := s.regStorage.RegisterForEvent(ctx, memberID, eventID, time.Now().Add(s.cfg.HoldFor))
 	if err != nil {
 		if errors.Is(err, storage.ErrEventFull) {
 			log.Error("event full", "error", err)
-			return "", fmt.Errorf("%s: %w", op, service.ErrEventFull)
+			return "", nil, fmt.Errorf("%s: %w", op, service.ErrEventFull)
 		}
 		if errors.Is(err, storage.ErrEventNotFound) {
 			log.Error("event not found", "error", err)
-			return "", fmt.Errorf("%s: %w", op, service.ErrEventNotFound)
+			return "", nil, fmt.Errorf("%s: %w", op, service.ErrEventNotFound)
 		}
 		if errors.Is(err, storage.ErrRegAlreadyExists) {
 			log.Error("registration already exists", "error", err)
-			return "", fmt.Errorf("%s: %w", op, service.ErrRegAlreadyExists)
+			return "", nil, fmt.Errorf("%s: %w", op, service.ErrRegAlreadyExists)
 		}
 
+		if cerr := service.ConstraintError(err); cerr != nil {
+			log.Warn("rejected by a constraint", "error", err)
+			return "", nil, fmt.Errorf("%s: %w", op, cerr)
+		}
 		log.Error("failed to register", "error", err)
-		return "", fmt.Errorf("%s: %w", op, service.ErrRegistrationFailed)
+		return "", nil, fmt.Errorf("%s: %w", op, service.ErrRegistrationFailed)
 	}
 
-	log.Info("registration successful")
-	return status, nil
+	if status != model.RegistrationPendingPayment {
+		log.Info("registration successful")
+		return status, nil, nil
+	}
+
+	payment, err := s.startPayment(ctx, memberID, eventID)
+	if err != nil {
+		log.Error("failed to start payment, seat released", "error", err)
+		return "", nil, fmt.Errorf("%s: %w", op, service.ErrPaymentUnavailable)
+	}
+
+	log.Info("seat held until payment", "payment_id", payment.ID, "expires_at", payment.ExpiresAt)
+	return status, &payment, nil
 }
 
+// checkAccess tells whether the member's running membership admits them to the event now.
+// Failures to read the limits are reported as ErrRegistrationFailed wrapping the cause.
+func (s *Service) checkAccess(ctx context.Context, memberID uuid.UUID, eventID int) error {
+	access, err := s.regStorage.GetEventAccess(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, storage.ErrEventNotFound) {
+			return service.ErrEventNotFound
+		}
+		return fmt.Errorf("%w: %w", service.ErrRegistrationFailed, err)
+	}
+	if access == (model.EventAccess{}) {
+		return nil
+	}
+
+	membership, err := s.memberStorage.GetMembershipFromPrimary(ctx, memberID)
+	if err != nil {
+		if errors.Is(err, storage.ErrMemberNotFound) {
+			return service.ErrMemberNotFound
+		}
+		return fmt.Errorf("%w: %w", service.ErrRegistrationFailed, err)
+	}
+
+	now := time.Now()
+	switch access.Check(membership.ActiveTier(now), now) {
+	case model.AccessTierRequired:
+		return service.ErrTierRequired
+	case model.AccessPriorityWindow:
+		return service.ErrPriorityWindow
+	}
+
+	return nil
+}
+
+// CancelRegistration cancels the member's registration. A pending payment is cancelled with
+// the provider, and a paid seat is refunded if the event is more than the configured refund
+// period away. A refund the provider does not accept now is retried by the payment job.
 func (s *Service) CancelRegistration(ctx context.Context, memberID uuid.UUID, eventID int) (string, error) {
 	const op = "registrations.Service.CancelRegistration"
-	log := s.log.With(slog.String("op", op), slog.String("member_id", memberID.String()), slog.Int("event_id", eventID))
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.String("member_id", memberID.String()), slog.Int("event_id", eventID))
 
 	log.Info("cancelling registration")
 
-	status, err := s.regStorage.CancelRegistration(ctx, memberID, eventID)
+	status, payment, err := s.regStorage.CancelRegistration(ctx, memberID, eventID, time.Now().Add(s.cfg.RefundBefore))
 	if err != nil {
 		if errors.Is(err, storage.ErrRegNotFound) {
 			log.Error("registration not found", "error", err)
 			return "", fmt.Errorf("%s: %w", op, service.ErrRegNotFound)
 		}
 
+		if cerr := service.ConstraintError(err); cerr != nil {
+			log.Warn("rejected by a constraint", "error", err)
+			return "", fmt.Errorf("%s: %w", op, cerr)
+		}
 		log.Error("failed to cancel", "error", err)
 		return "", fmt.Errorf("%s: %w", op, service.ErrCancellationFailed)
 	}
 
+	if payment != nil {
+		s.settlePayment(ctx, *payment)
+	}
+
 	log.Info("cancellation successful")
 	return status, nil
 }
 
 func (s *Service) GetRegistrationStatus(ctx context.Context, memberID uuid.UUID, eventID int) (string, error) {
 	const op = "registrations.Service.GetRegistrationStatus"
-	log := s.log.With(slog.String("op", op), slog.String("member_id", memberID.String()), slog.Int("event_id", eventID))
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.String("member_id", memberID.String()), slog.Int("event_id", eventID))
 
 	log.Info("fetching registration status")
 
@@ -119,3 +214,75 @@ func (s *Service) GetRegistrationStatus(ctx context.Context, memberID uuid.UUID,
 	log.Info("member is registered on event")
 	return status, nil
 }
+
+func (s *Service) GetEventRoster(ctx context.Context, eventID int) ([]model.Member, error) {
+	const op = "registrations.Service.GetEventRoster"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.Int("event_id", eventID))
+
+	log.Info("fetching event roster")
+
+	members, err := s.regStorage.GetEventRoster(ctx, eventID)
+	if err != nil {
+		log.Error("failed to get event roster", "error", err)
+		return nil, fmt.Errorf("%s: %w", op, service.ErrFailedToGetRoster)
+	}
+
+	log.Info("fetched event roster", "count", len(members))
+	return members, nil
+}
+
+func (s *Service) ExportEventRoster(ctx context.Context, eventID int, fn func(model.RosterEntry) error) error {
+	const op = "registrations.Service.ExportEventRoster"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.Int("event_id", eventID))
+
+	log.Info("exporting event roster")
+
+	if err := s.regStorage.StreamEventRoster(ctx, eventID, fn); err != nil {
+		log.Error("failed to export event roster", "error", err)
+		return fmt.Errorf("%s: %w", op, service.ErrFailedToExport)
+	}
+
+	return nil
+}
+
+// RecordAttendance marks who of the registered members came to the event; everyone else
+// registered is marked as absent. It can be done once the event has started and repeated
+// to correct the marks.
+func (s *Service) RecordAttendance(ctx context.Context, eventID int, attended []uuid.UUID) (model.AttendanceReport, error) {
+	const op = "registrations.Service.RecordAttendance"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.Int("event_id", eventID))
+
+	log.Info("recording attendance")
+
+	report, err := s.regStorage.RecordAttendance(ctx, eventID, attended, time.Now())
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrEventNotFound):
+			log.Warn("event not found")
+			return model.AttendanceReport{}, fmt.Errorf("%s: %w", op, service.ErrEventNotFound)
+		case errors.Is(err, storage.ErrEventNotStarted):
+			log.Warn("event has not started")
+			return model.AttendanceReport{}, fmt.Errorf("%s: %w", op, service.ErrEventNotStarted)
+		}
+
+		if cerr := service.ConstraintError(err); cerr != nil {
+			log.Warn("rejected by a constraint", "error", err)
+			return model.AttendanceReport{}, fmt.Errorf("%s: %w", op, cerr)
+		}
+		log.Error("failed to record attendance", "error", err)
+		return model.AttendanceReport{}, fmt.Errorf("%s: %w", op, service.ErrAttendanceFailed)
+	}
+
+	if len(report.NotRegistered) > 0 {
+		log.Warn("attendance lists members without registration", "count", len(report.NotRegistered))
+	}
+
+	log.Info("attendance recorded", "attended", report.Attended, "no_show", report.NoShow)
+	return report, nil
+}
@@ -0,0 +1,73 @@
+package registrations
+
+import (
+	"context"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/tracing"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"log/slog"
+)
+
+type SeatNotifier interface {
+	SendBumpNotice(ctx context.Context, n model.SeatNotice) error
+	SendSeatsFreedNotice(ctx context.Context, n model.SeatNotice) error
+}
+
+// HandleSeatsBumped settles the payments of the registrations a forced shrink of the event
+// cancelled and tells their members. A member who has registered again in the meantime is
+// not told. It is run by the job runner for events.seats_bumped.
+func (s *Service) HandleSeatsBumped(ctx context.Context, e model.SeatsBumped) error {
+	const op = "registrations.Service.HandleSeatsBumped"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.Int("event_id", e.EventID))
+
+	for _, p := range e.Payments {
+		s.settlePayment(ctx, p)
+	}
+
+	if len(e.Members) == 0 {
+		return nil
+	}
+	notices, err := s.regStorage.GetSeatNotices(ctx, e.EventID, e.Members)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	sent := s.notifySeats(ctx, log, notices, s.notifier.SendBumpNotice)
+	log.Info("bumped members notified", "members", len(e.Members), "notified", sent)
+	return nil
+}
+
+// HandleSeatsFreed tells the members bumped from the event before, who have not registered
+// again, that seats are available. It is run by the job runner for events.seats_freed.
+func (s *Service) HandleSeatsFreed(ctx context.Context, e model.SeatsFreed) error {
+	const op = "registrations.Service.HandleSeatsFreed"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.Int("event_id", e.EventID))
+
+	notices, err := s.regStorage.GetSeatNotices(ctx, e.EventID, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	sent := s.notifySeats(ctx, log, notices, s.notifier.SendSeatsFreedNotice)
+	log.Info("freed seats announced", "seats", e.Seats, "notified", sent)
+	return nil
+}
+
+// notifySeats sends each notice and returns how many were delivered. A failed notice is only
+// logged: the job is not retried, since the rest of the members have been told already.
+func (s *Service) notifySeats(ctx context.Context, log *slog.Logger, notices []model.SeatNotice, send func(context.Context, model.SeatNotice) error) int {
+	var sent int
+	for _, n := range notices {
+		if err := send(ctx, n); err != nil {
+			log.Error("failed to notify member", "member_id", n.MemberID, "error", err)
+			continue
+		}
+		sent++
+	}
+
+	return sent
+}
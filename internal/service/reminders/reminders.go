@@ -0,0 +1,91 @@
+package reminders
+
+import (
+	"context"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/config"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/tracing"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"log/slog"
+	"time"
+)
+
+// Service reminds registered members about upcoming events. Several replicas can run it
+// at once: every reminder is claimed by one of them before it is sent.
+type Service struct {
+	log     *slog.Logger
+	storage ReminderStorage
+	sender  Sender
+	cfg     config.RemindersConfig
+}
+
+type ReminderStorage interface {
+	ClaimDueReminders(ctx context.Context, offsets []time.Duration, retryBefore time.Time, maxAttempts, limit int) ([]model.Reminder, error)
+	MarkReminderSent(ctx context.Context, id int) error
+}
+
+// Sender delivers a reminder to the member.
+type Sender interface {
+	SendReminder(ctx context.Context, r model.Reminder) error
+}
+
+func New(log *slog.Logger, storage ReminderStorage, sender Sender, cfg config.RemindersConfig) *Service {
+	return &Service{log: log.With("component", "service"), storage: storage, sender: sender, cfg: cfg}
+}
+
+// SendDueReminders claims the reminders that are due batch by batch and sends them. A
+// reminder that could not be sent stays claimed and is picked up again after RetryAfter.
+func (s *Service) SendDueReminders(ctx context.Context) (sent, failed int, err error) {
+	const op = "reminders.Service.SendDueReminders"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op))
+
+	for ctx.Err() == nil {
+		batch, err := s.storage.ClaimDueReminders(ctx, s.cfg.Offsets, time.Now().Add(-s.cfg.RetryAfter), s.cfg.MaxAttempts, s.cfg.BatchSize)
+		if err != nil {
+			return sent, failed, fmt.Errorf("%s: %w", op, err)
+		}
+
+		for _, r := range batch {
+			rlog := log.With(slog.Int("reminder_id", r.ID), slog.Int("event_id", r.EventID),
+				slog.String("member_id", r.MemberID.String()), slog.Duration("offset", r.Offset))
+
+			if err := s.sender.SendReminder(ctx, r); err != nil {
+				rlog.Error("failed to send reminder", "attempt", r.Attempts, "error", err)
+				failed++
+				continue
+			}
+			if err := s.storage.MarkReminderSent(ctx, r.ID); err != nil {
+				rlog.Error("failed to mark reminder sent", "error", err)
+			}
+			sent++
+		}
+
+		if len(batch) < s.cfg.BatchSize {
+			break
+		}
+	}
+
+	return sent, failed, nil
+}
+
+// RunReminderJob sends due reminders. It is run by the job runner on the configured
+// interval. Reminders that could not be delivered do not fail the job; they are retried on
+// their own after RetryAfter.
+func (s *Service) RunReminderJob(ctx context.Context) error {
+	const op = "reminders.Service.RunReminderJob"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	sent, failed, err := s.SendDueReminders(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if sent > 0 || failed > 0 {
+		s.log.Info("reminders sent", slog.String("op", op), slog.Int("sent", sent), slog.Int("failed", failed))
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package reminders
+
+import (
+	"context"
+	"errors"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/i18n"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"log/slog"
+	"time"
+)
+
+type Mailer interface {
+	SendEmail(ctx context.Context, to, subject, body string) error
+}
+
+type SMSSender interface {
+	SendSMS(ctx context.Context, phone, text string) error
+}
+
+// NotifySender sends reminders and notices about seats at events by email and sms in the
+// member's language, through each channel the member has not turned off. A message counts as
+// delivered when at least one channel took it.
+type NotifySender struct {
+	log      *slog.Logger
+	mailer   Mailer
+	sms      SMSSender
+	catalog  *i18n.Catalog
+	location *time.Location
+}
+
+func NewNotifySender(log *slog.Logger, mailer Mailer, sms SMSSender, catalog *i18n.Catalog, location *time.Location) *NotifySender {
+	return &NotifySender{
+		log:      log.With("component", "reminders"),
+		mailer:   mailer,
+		sms:      sms,
+		catalog:  catalog,
+		location: location,
+	}
+}
+
+func (n *NotifySender) SendReminder(ctx context.Context, r model.Reminder) error {
+	loc := n.localizer(r.Language)
+	date := r.EventDate.In(n.location).Format(loc.T("2 Jan 2006, 15:04"))
+
+	sent, err := n.deliver(ctx, r.Notifications, r.Email, r.Phone,
+		loc.T("Reminder: %s", r.EventTitle),
+		loc.T("Hello, %s!\n\nWe remind you that you are registered for «%s». It takes place on %s at %s.\n\nIf your plans have changed, please cancel the registration so that someone else can take the seat.\n",
+			r.FullName, r.EventTitle, date, r.Location),
+		loc.T("Reminder: «%s», %s, %s.", r.EventTitle, date, r.Location))
+	if sent && err != nil {
+		n.log.Warn("reminder delivered through some channels only", "reminder_id", r.ID, "error", err)
+		return nil
+	}
+
+	return err
+}
+
+// SendBumpNotice tells the member that the registration was cancelled because the event
+// lost seats.
+func (n *NotifySender) SendBumpNotice(ctx context.Context, s model.SeatNotice) error {
+	loc := n.localizer(s.Language)
+	date := s.EventDate.In(n.location).Format(loc.T("2 Jan 2006, 15:04"))
+
+	sent, err := n.deliver(ctx, s.Notifications, s.Email, s.Phone,
+		loc.T("Registration cancelled: %s", s.EventTitle),
+		loc.T("Hello, %s!\n\nUnfortunately, the number of seats for «%s» on %s at %s has been reduced, and your registration had to be cancelled. If you paid for the seat, the payment will be refunded in full.\n\nWe apologize for the inconvenience.\n",
+			s.FullName, s.EventTitle, date, s.Location),
+		loc.T("Registration for «%s», %s, is cancelled: seats reduced.", s.EventTitle, date))
+	if sent && err != nil {
+		n.log.Warn("bump notice delivered through some channels only", "member_id", s.MemberID, "event_id", s.EventID, "error", err)
+		return nil
+	}
+
+	return err
+}
+
+// SendSeatsFreedNotice tells the member that seats at the event are available again.
+func (n *NotifySender) SendSeatsFreedNotice(ctx context.Context, s model.SeatNotice) error {
+	loc := n.localizer(s.Language)
+	date := s.EventDate.In(n.location).Format(loc.T("2 Jan 2006, 15:04"))
+
+	sent, err := n.deliver(ctx, s.Notifications, s.Email, s.Phone,
+		loc.T("Seats available: %s", s.EventTitle),
+		loc.T("Hello, %s!\n\nSeats for «%s» on %s at %s are available again. If you still want to attend, register once more while seats last.\n",
+			s.FullName, s.EventTitle, date, s.Location),
+		loc.T("Seats for «%s», %s, are available again.", s.EventTitle, date))
+	if sent && err != nil {
+		n.log.Warn("seats notice delivered through some channels only", "member_id", s.MemberID, "event_id", s.EventID, "error", err)
+		return nil
+	}
+
+	return err
+}
+
+func (n *NotifySender) localizer(language string) i18n.Localizer {
+	lang, ok := i18n.Parse(language)
+	if !ok {
+		lang = i18n.Default
+	}
+
+	return n.catalog.Localizer(lang)
+}
+
+// deliver sends the email and the sms through the channels the member has not turned off.
+// sent reports whether at least one channel took the message.
+func (n *NotifySender) deliver(ctx context.Context, prefs model.NotificationPreferences, email, phone, subject, body, text string) (sent bool, err error) {
+	var errs []error
+	if prefs.Email && email != "" {
+		if err := n.mailer.SendEmail(ctx, email, subject, body); err != nil {
+			errs = append(errs, err)
+		} else {
+			sent = true
+		}
+	}
+	if prefs.SMS && phone != "" {
+		if err := n.sms.SendSMS(ctx, phone, text); err != nil {
+			errs = append(errs, err)
+		} else {
+			sent = true
+		}
+	}
+
+	return sent, errors.Join(errs...)
+}
@@ -1,6 +1,9 @@
 package service
 
-import "errors"
+import (
+	"errors"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/storage"
+)
 
 var (
 	ErrEventNotFound           = errors.New("event not found")
@@ -26,6 +29,10 @@ var (
 	ErrRegistrationFailed      = errors.New("failed to register")
 	ErrCancellationFailed      = errors.New("failed to cancel registration")
 	ErrStatusCheckFailed       = errors.New("failed to check registration status")
+	ErrFailedToGetRoster       = errors.New("failed to get event roster")
+	ErrFailedToExport          = errors.New("failed to export")
+	ErrFailedToImport          = errors.New("failed to import members")
+	ErrImportRejected          = errors.New("import contains invalid rows")
 	ErrFailedToSaveMeta        = errors.New("failed to save metadata")
 	ErrMetaNotFound            = errors.New("meta object not found")
 	ErrInfoNotFound            = errors.New("orchestra info not found")
@@ -34,4 +41,78 @@ var (
 	ErrPhoneDuplicate          = errors.New("phone number already exists")
 	ErrInvalidEmail            = errors.New("invalid email format")
 	ErrInvalidPhone            = errors.New("invalid phone number format")
+	ErrUnknownChannel          = errors.New("unknown verification channel")
+	ErrAlreadyVerified         = errors.New("contact already verified")
+	ErrCodeRecentlySent        = errors.New("verification code was sent recently")
+	ErrCodeNotFound            = errors.New("verification code not found")
+	ErrCodeExpired             = errors.New("verification code expired")
+	ErrInvalidCode             = errors.New("invalid verification code")
+	ErrTooManyAttempts         = errors.New("too many verification attempts")
+	ErrFailedToSendCode        = errors.New("failed to send verification code")
+	ErrFailedToVerify          = errors.New("failed to verify contact")
+	ErrVersionMismatch         = errors.New("resource was modified concurrently")
+	ErrInvalidTitle            = errors.New("title is required")
+	ErrInvalidEventDate        = errors.New("event date must be in the future")
+	ErrInvalidCapacity         = errors.New("capacity must be positive")
+	ErrEventTypeNotFound       = errors.New("event type not found")
+	ErrLocationNotFound        = errors.New("location not found")
+	ErrInvalidFullName         = errors.New("full name is required")
+	ErrUnknownLanguage         = errors.New("unknown language")
+	ErrMissingValue            = errors.New("value is required")
+	ErrFailedToSaveTranslation = errors.New("failed to save translation")
+	ErrFailedToGetMemberRegs   = errors.New("failed to get member registrations")
+	ErrFailedToSavePreferences = errors.New("failed to save notification preferences")
+	ErrFailedToExportData      = errors.New("failed to export member data")
+	ErrFailedToErase           = errors.New("failed to erase member data")
+	ErrUnknownTier             = errors.New("unknown membership tier")
+	ErrInvalidAmount           = errors.New("amount must be positive")
+	ErrTierDowngrade           = errors.New("a lower tier can not be paid while a higher one is active")
+	ErrPaymentDuplicate        = errors.New("payment with this reference is already recorded")
+	ErrTierRequired            = errors.New("event is open to a higher membership tier")
+	ErrPriorityWindow          = errors.New("registration is open only to priority tiers yet")
+	ErrFailedToGetMembership   = errors.New("failed to get membership")
+	ErrFailedToRecordPayment   = errors.New("failed to record payment")
+	ErrFailedToImportPayments  = errors.New("failed to import payments")
+	ErrFailedToGetRenewals     = errors.New("failed to get renewals")
+	ErrFailedToSetAccess       = errors.New("failed to set event access")
+	ErrInvalidPrice            = errors.New("price must be positive")
+	ErrFailedToSetPrice        = errors.New("failed to set event price")
+	ErrPaymentUnavailable      = errors.New("payment provider is unavailable")
+	ErrInvalidSignature        = errors.New("invalid webhook signature")
+	ErrMalformedNotification   = errors.New("malformed payment notification")
+	ErrPaymentNotFound         = errors.New("event payment not found")
+	ErrFailedToProcessWebhook  = errors.New("failed to process payment notification")
+	ErrJobNotFound             = errors.New("job not found")
+	ErrJobNotDead              = errors.New("only dead jobs can be retried")
+	ErrFailedToGetJobs         = errors.New("failed to get jobs")
+	ErrFailedToRetryJob        = errors.New("failed to retry job")
+	ErrEventNotStarted         = errors.New("attendance can only be recorded once the event has started")
+	ErrAttendanceFailed        = errors.New("failed to record attendance")
+	ErrInvalidDateRange        = errors.New("date_from must be before date_to")
+	ErrUnknownInterval         = errors.New("unknown interval")
+	ErrTooManyPeriods          = errors.New("date range has too many periods for the interval")
+	ErrFailedToGetAnalytics    = errors.New("failed to get analytics")
+	ErrEventTypeExists         = errors.New("event type with this name already exists")
+	ErrLocationExists          = errors.New("location with this name already exists")
+	ErrCapacityBelowRegs       = errors.New("capacity is below the number of active registrations")
+	ErrFailedToSetCapacity     = errors.New("failed to set event capacity")
+	ErrDuplicate               = errors.New("value already exists")
+	ErrReferenceNotFound       = errors.New("referenced resource not found")
+	ErrCheckViolation          = errors.New("value violates a data constraint")
 )
+
+// ConstraintError translates the generic storage errors of a violated constraint that has no
+// error of its own, so a write the database rejected is reported as the client's fault rather
+// than an internal failure. It returns nil for any other error.
+func ConstraintError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrDuplicate):
+		return ErrDuplicate
+	case errors.Is(err, storage.ErrReferenceNotFound):
+		return ErrReferenceNotFound
+	case errors.Is(err, storage.ErrCheckViolation):
+		return ErrCheckViolation
+	}
+
+	return nil
+}
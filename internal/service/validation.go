@@ -0,0 +1,59 @@
+package service
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrValidation matches every ValidationError.
+var ErrValidation = errors.New("validation failed")
+
+// FieldError says why a single input field was rejected. Field uses the JSON name of the field.
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+func (e FieldError) Error() string {
+	return e.Field + ": " + e.Err.Error()
+}
+
+// ValidationError collects the field errors found while validating one input. It matches
+// ErrValidation and the error of every field via errors.Is.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+// Add records a rejected field.
+func (e *ValidationError) Add(field string, err error) {
+	e.Fields = append(e.Fields, FieldError{Field: field, Err: err})
+}
+
+// OrNil returns e if any field was rejected and nil otherwise.
+func (e *ValidationError) OrNil() error {
+	if len(e.Fields) == 0 {
+		return nil
+	}
+	return e
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		msgs[i] = f.Error()
+	}
+	return ErrValidation.Error() + ": " + strings.Join(msgs, "; ")
+}
+
+func (e *ValidationError) Unwrap() []error {
+	errs := []error{ErrValidation}
+	for _, f := range e.Fields {
+		errs = append(errs, f.Err)
+	}
+	return errs
+}
+
+// InvalidField is a ValidationError with a single rejected field.
+func InvalidField(field string, err error) *ValidationError {
+	return &ValidationError{Fields: []FieldError{{Field: field, Err: err}}}
+}
@@ -0,0 +1,183 @@
+package verification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Ilya-Repin/orchestra_api/internal/config"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/i18n"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/storage"
+	"github.com/Ilya-Repin/orchestra_api/internal/infra/tracing"
+	"github.com/Ilya-Repin/orchestra_api/internal/model"
+	"github.com/Ilya-Repin/orchestra_api/internal/service"
+	"github.com/google/uuid"
+	"log/slog"
+	"time"
+)
+
+type Service struct {
+	log     *slog.Logger
+	storage VerificationStorage
+	mailer  Mailer
+	sms     SMSSender
+	catalog *i18n.Catalog
+	cfg     config.VerificationConfig
+}
+
+type VerificationStorage interface {
+	GetMemberFromPrimary(ctx context.Context, id uuid.UUID) (model.Member, error)
+	SaveVerificationCode(ctx context.Context, code model.VerificationCode, replaceBefore time.Time) error
+	UseVerificationCode(ctx context.Context, memberID uuid.UUID, channel model.VerificationChannel) (model.VerificationCode, error)
+	ConfirmVerification(ctx context.Context, memberID uuid.UUID, channel model.VerificationChannel, at time.Time) error
+}
+
+type Mailer interface {
+	SendEmail(ctx context.Context, to, subject, body string) error
+}
+
+type SMSSender interface {
+	SendSMS(ctx context.Context, phone, text string) error
+}
+
+func New(log *slog.Logger, storage VerificationStorage, mailer Mailer, sms SMSSender, catalog *i18n.Catalog, cfg config.VerificationConfig) *Service {
+	return &Service{
+		log:     log.With("component", "service"),
+		storage: storage,
+		mailer:  mailer,
+		sms:     sms,
+		catalog: catalog,
+		cfg:     cfg,
+	}
+}
+
+// SendCode generates a one-time code for the member's email or phone and delivers it
+// through that channel. It returns the moment the code expires.
+func (s *Service) SendCode(ctx context.Context, memberID uuid.UUID, channel model.VerificationChannel) (time.Time, error) {
+	const op = "verification.Service.SendCode"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.String("member_id", memberID.String()), slog.String("channel", string(channel)))
+	log.Info("sending verification code")
+
+	if channel != model.ChannelEmail && channel != model.ChannelPhone {
+		return time.Time{}, fmt.Errorf("%s: %w", op, service.ErrUnknownChannel)
+	}
+
+	member, err := s.storage.GetMemberFromPrimary(ctx, memberID)
+	if err != nil {
+		if errors.Is(err, storage.ErrMemberNotFound) {
+			log.Warn("member not found", "error", err)
+			return time.Time{}, fmt.Errorf("%s: %w", op, service.ErrMemberNotFound)
+		}
+
+		log.Error("failed to get member", "error", err)
+		return time.Time{}, fmt.Errorf("%s: %w", op, service.ErrFailedToSendCode)
+	}
+
+	if (channel == model.ChannelEmail && member.EmailVerifiedAt != nil) ||
+		(channel == model.ChannelPhone && member.PhoneVerifiedAt != nil) {
+		return time.Time{}, fmt.Errorf("%s: %w", op, service.ErrAlreadyVerified)
+	}
+
+	code, err := service.GenerateCode()
+	if err != nil {
+		log.Error("failed to generate code", "error", err)
+		return time.Time{}, fmt.Errorf("%s: %w", op, service.ErrFailedToSendCode)
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(s.cfg.CodeTTL)
+
+	err = s.storage.SaveVerificationCode(ctx, model.VerificationCode{
+		MemberID:  memberID,
+		Channel:   channel,
+		CodeHash:  service.HashCode(s.cfg.Secret, memberID, string(channel), code),
+		ExpiresAt: expiresAt,
+	}, now.Add(-s.cfg.ResendAfter))
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrCodeRecentlySent):
+			log.Warn("code was sent recently")
+			return time.Time{}, fmt.Errorf("%s: %w", op, service.ErrCodeRecentlySent)
+		case errors.Is(err, storage.ErrMemberNotFound):
+			return time.Time{}, fmt.Errorf("%s: %w", op, service.ErrMemberNotFound)
+		}
+
+		log.Error("failed to save code", "error", err)
+		return time.Time{}, fmt.Errorf("%s: %w", op, service.ErrFailedToSendCode)
+	}
+
+	lang, ok := i18n.Parse(member.Language)
+	if !ok {
+		lang = i18n.Default
+	}
+	loc := s.catalog.Localizer(lang)
+	validFor := loc.N("%d minutes", int(s.cfg.CodeTTL.Minutes()))
+
+	switch channel {
+	case model.ChannelEmail:
+		err = s.mailer.SendEmail(ctx, member.Email, loc.T("Email address confirmation"),
+			loc.T("Hello, %s!\n\nYour confirmation code: %s\nThe code is valid for %s.\n\nIf you did not apply to the orchestra friends club, just ignore this email.\n",
+				member.FullName, code, validFor))
+	case model.ChannelPhone:
+		err = s.sms.SendSMS(ctx, member.Phone, loc.T("Confirmation code: %s. Valid for %s.", code, validFor))
+	}
+	if err != nil {
+		log.Error("failed to deliver code", "error", err)
+		return time.Time{}, fmt.Errorf("%s: %w", op, service.ErrFailedToSendCode)
+	}
+
+	log.Info("verification code sent", "expires_at", expiresAt)
+	return expiresAt, nil
+}
+
+// ConfirmCode checks code against the last one sent to the channel and marks the
+// channel verified on success. Every call counts as an attempt.
+func (s *Service) ConfirmCode(ctx context.Context, memberID uuid.UUID, channel model.VerificationChannel, code string) error {
+	const op = "verification.Service.ConfirmCode"
+	ctx, span := tracing.Start(ctx, op)
+	defer span.End()
+
+	log := tracing.Logger(ctx, s.log).With(slog.String("op", op), slog.String("member_id", memberID.String()), slog.String("channel", string(channel)))
+	log.Info("confirming verification code")
+
+	if channel != model.ChannelEmail && channel != model.ChannelPhone {
+		return fmt.Errorf("%s: %w", op, service.ErrUnknownChannel)
+	}
+
+	stored, err := s.storage.UseVerificationCode(ctx, memberID, channel)
+	if err != nil {
+		if errors.Is(err, storage.ErrCodeNotFound) {
+			log.Warn("no code to confirm")
+			return fmt.Errorf("%s: %w", op, service.ErrCodeNotFound)
+		}
+
+		log.Error("failed to get code", "error", err)
+		return fmt.Errorf("%s: %w", op, service.ErrFailedToVerify)
+	}
+
+	switch {
+	case stored.Attempts > s.cfg.MaxAttempts:
+		log.Warn("too many attempts", "attempts", stored.Attempts)
+		return fmt.Errorf("%s: %w", op, service.ErrTooManyAttempts)
+	case time.Now().After(stored.ExpiresAt):
+		log.Warn("code expired", "expires_at", stored.ExpiresAt)
+		return fmt.Errorf("%s: %w", op, service.ErrCodeExpired)
+	case !service.CodeMatches(stored.CodeHash, s.cfg.Secret, memberID, string(channel), code):
+		log.Warn("invalid code", "attempts", stored.Attempts)
+		return fmt.Errorf("%s: %w", op, service.ErrInvalidCode)
+	}
+
+	if err := s.storage.ConfirmVerification(ctx, memberID, channel, time.Now()); err != nil {
+		if errors.Is(err, storage.ErrMemberNotFound) {
+			return fmt.Errorf("%s: %w", op, service.ErrMemberNotFound)
+		}
+
+		log.Error("failed to confirm verification", "error", err)
+		return fmt.Errorf("%s: %w", op, service.ErrFailedToVerify)
+	}
+
+	log.Info("contact verified")
+	return nil
+}
@@ -0,0 +1,38 @@
+// Package storage embeds the goose migrations of the database, so the server knows which
+// schema version it was built for. The migrations directory itself holds only sql files, as
+// goose reads it as is.
+package storage
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"strconv"
+	"strings"
+)
+
+//go:embed migrations/*.sql
+var Migrations embed.FS
+
+// LatestMigration returns the version of the newest migration, the numeric prefix of its file name.
+func LatestMigration() (int64, error) {
+	const op = "storage.LatestMigration"
+
+	files, err := fs.Glob(Migrations, "migrations/*.sql")
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var latest int64
+	for _, name := range files {
+		prefix, _, _ := strings.Cut(path.Base(name), "_")
+		version, err := strconv.ParseInt(prefix, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %q: %w", op, name, err)
+		}
+		latest = max(latest, version)
+	}
+
+	return latest, nil
+}
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Телефоны хранятся в E.164 (+79123456789), допускаются зарубежные номера
+ALTER TABLE club_members DROP CONSTRAINT phone_number;
+ALTER TABLE club_members DROP CONSTRAINT email_syntax;
+
+UPDATE club_members SET phone = '+' || phone WHERE phone ~ '^7\d{10}$';
+
+-- Адреса приводятся к нижнему регистру, если это не создает дубликат
+UPDATE club_members c
+SET email = lower(c.email)
+WHERE c.email <> lower(c.email)
+  AND NOT EXISTS (SELECT 1 FROM club_members o WHERE o.email = lower(c.email));
+
+ALTER TABLE club_members
+    ADD CONSTRAINT phone_number CHECK (phone ~ '^\+[1-9]\d{6,14}$');
+ALTER TABLE club_members
+    ADD CONSTRAINT email_syntax CHECK (email ~ '^[^@\s]+@[^@\s]+\.[^@\s]+$');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE club_members DROP CONSTRAINT phone_number;
+ALTER TABLE club_members DROP CONSTRAINT email_syntax;
+
+UPDATE club_members SET phone = substr(phone, 2) WHERE phone ~ '^\+7\d{10}$';
+
+-- Зарубежные номера не укладываются в старый формат, поэтому ограничения не проверяются для существующих строк
+ALTER TABLE club_members
+    ADD CONSTRAINT phone_number CHECK (phone ~ '^7\d{10}$') NOT VALID;
+ALTER TABLE club_members
+    ADD CONSTRAINT email_syntax CHECK (email ~ '^[^@]+@[^@]+\.[^@]+$') NOT VALID;
+-- +goose StatementEnd
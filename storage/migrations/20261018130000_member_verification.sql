@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE club_members
+    ADD COLUMN email_verified_at TIMESTAMPTZ,
+    ADD COLUMN phone_verified_at TIMESTAMPTZ;
+
+-- Одноразовые коды подтверждения, по одному активному коду на канал
+CREATE TABLE verification_codes
+(
+    member_id  UUID        NOT NULL REFERENCES club_members (id) ON DELETE CASCADE,
+    channel    TEXT        NOT NULL CHECK (channel IN ('email', 'phone')),
+    code_hash  TEXT        NOT NULL,
+    attempts   INTEGER     NOT NULL DEFAULT 0,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (member_id, channel)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS verification_codes;
+
+ALTER TABLE club_members
+    DROP COLUMN email_verified_at,
+    DROP COLUMN phone_verified_at;
+-- +goose StatementEnd
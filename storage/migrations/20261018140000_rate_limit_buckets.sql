@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Состояние token bucket для ограничения частоты запросов, общее для всех реплик
+CREATE TABLE rate_limit_buckets
+(
+    key        TEXT PRIMARY KEY,
+    tokens     DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMPTZ      NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS rate_limit_buckets;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Ответы на запросы с заголовком Idempotency-Key; status_code пуст, пока первый запрос обрабатывается
+CREATE TABLE idempotency_keys
+(
+    key          TEXT PRIMARY KEY,
+    fingerprint  TEXT        NOT NULL,
+    status_code  INTEGER,
+    content_type TEXT        NOT NULL DEFAULT '',
+    body         BYTEA,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at   TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_idempotency_keys_expires_at ON idempotency_keys (expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS idempotency_keys;
+-- +goose StatementEnd
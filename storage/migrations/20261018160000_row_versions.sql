@@ -0,0 +1,50 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Версии строк для оптимистичной блокировки: растут при каждом изменении и отдаются клиентам как ETag
+ALTER TABLE club_members ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE events ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE locations ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE event_types ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+
+CREATE OR REPLACE FUNCTION bump_version()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.version = OLD.version + 1;
+RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_bump_version_club
+    BEFORE UPDATE ON club_members
+    FOR EACH ROW
+    EXECUTE FUNCTION bump_version();
+
+CREATE TRIGGER trigger_bump_version_events
+    BEFORE UPDATE ON events
+    FOR EACH ROW
+    EXECUTE FUNCTION bump_version();
+
+CREATE TRIGGER trigger_bump_version_locations
+    BEFORE UPDATE ON locations
+    FOR EACH ROW
+    EXECUTE FUNCTION bump_version();
+
+CREATE TRIGGER trigger_bump_version_event_types
+    BEFORE UPDATE ON event_types
+    FOR EACH ROW
+    EXECUTE FUNCTION bump_version();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS trigger_bump_version_event_types ON event_types;
+DROP TRIGGER IF EXISTS trigger_bump_version_locations ON locations;
+DROP TRIGGER IF EXISTS trigger_bump_version_events ON events;
+DROP TRIGGER IF EXISTS trigger_bump_version_club ON club_members;
+DROP FUNCTION IF EXISTS bump_version();
+
+ALTER TABLE event_types DROP COLUMN IF EXISTS version;
+ALTER TABLE locations DROP COLUMN IF EXISTS version;
+ALTER TABLE events DROP COLUMN IF EXISTS version;
+ALTER TABLE club_members DROP COLUMN IF EXISTS version;
+-- +goose StatementEnd
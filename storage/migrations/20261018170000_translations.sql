@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Язык, на котором участник получает уведомления и ответы API; NULL - язык по умолчанию
+ALTER TABLE club_members
+    ADD COLUMN language TEXT CHECK (language IN ('ru', 'en'));
+
+-- Переводы сообщений поверх встроенного каталога; forms - формы множественного числа языка
+CREATE TABLE translations
+(
+    key   TEXT   NOT NULL,
+    lang  TEXT   NOT NULL CHECK (lang IN ('ru', 'en')),
+    forms TEXT[] NOT NULL CHECK (cardinality(forms) > 0),
+    PRIMARY KEY (key, lang)
+);
+
+-- Варианты типов событий и площадок на других языках; основная запись остается значением по умолчанию
+CREATE TABLE event_type_translations
+(
+    event_type_id INTEGER NOT NULL REFERENCES event_types (id) ON DELETE CASCADE,
+    lang          TEXT    NOT NULL CHECK (lang IN ('ru', 'en')),
+    name          TEXT    NOT NULL,
+    description   TEXT    NOT NULL,
+    PRIMARY KEY (event_type_id, lang)
+);
+
+CREATE TABLE location_translations
+(
+    location_id INTEGER NOT NULL REFERENCES locations (id) ON DELETE CASCADE,
+    lang        TEXT    NOT NULL CHECK (lang IN ('ru', 'en')),
+    name        TEXT    NOT NULL,
+    route       TEXT    NOT NULL,
+    features    TEXT    NOT NULL,
+    PRIMARY KEY (location_id, lang)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS location_translations;
+DROP TABLE IF EXISTS event_type_translations;
+DROP TABLE IF EXISTS translations;
+
+ALTER TABLE club_members
+    DROP COLUMN language;
+-- +goose StatementEnd
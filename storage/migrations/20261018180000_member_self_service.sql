@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Настройки уведомлений и отметка об удалении персональных данных
+ALTER TABLE club_members
+    ADD COLUMN notify_email BOOLEAN NOT NULL DEFAULT TRUE,
+    ADD COLUMN notify_sms   BOOLEAN NOT NULL DEFAULT TRUE,
+    ADD COLUMN erased_at    TIMESTAMPTZ;
+
+-- У обезличенного участника вместо контактов хранятся заглушки, уникальные по id
+ALTER TABLE club_members DROP CONSTRAINT phone_number;
+ALTER TABLE club_members
+    ADD CONSTRAINT phone_number CHECK (phone ~ '^\+[1-9]\d{6,14}$' OR erased_at IS NOT NULL);
+
+-- Запросы на удаление данных, подтверждаемые кодом из письма; не больше одного на участника
+CREATE TABLE erasure_requests
+(
+    member_id  UUID PRIMARY KEY REFERENCES club_members (id) ON DELETE CASCADE,
+    code_hash  TEXT        NOT NULL,
+    attempts   INTEGER     NOT NULL DEFAULT 0,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS erasure_requests;
+
+ALTER TABLE club_members DROP CONSTRAINT phone_number;
+ALTER TABLE club_members
+    ADD CONSTRAINT phone_number CHECK (phone ~ '^\+[1-9]\d{6,14}$') NOT VALID;
+
+ALTER TABLE club_members
+    DROP COLUMN notify_email,
+    DROP COLUMN notify_sms,
+    DROP COLUMN erased_at;
+-- +goose StatementEnd
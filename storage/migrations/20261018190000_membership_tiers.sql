@@ -0,0 +1,82 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Ранг уровня членства: чем выше уровень, тем больше событий ему доступно
+CREATE OR REPLACE FUNCTION tier_rank(tier TEXT)
+RETURNS INTEGER AS $$
+BEGIN
+    RETURN CASE tier
+        WHEN 'basic' THEN 1
+        WHEN 'patron' THEN 2
+        WHEN 'benefactor' THEN 3
+        ELSE 0
+    END;
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
+
+-- Текущее членство участника: уровень и срок действия. expired_at отмечает фоновая задача,
+-- когда срок истек, и сбрасывает новый взнос
+CREATE TABLE memberships
+(
+    member_id  UUID PRIMARY KEY REFERENCES club_members (id) ON DELETE CASCADE,
+    tier       TEXT        NOT NULL
+        CONSTRAINT membership_tier CHECK (tier IN ('basic', 'patron', 'benefactor')),
+    starts_at  TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    expired_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT membership_period CHECK (expires_at > starts_at)
+);
+
+CREATE INDEX memberships_expires_at_idx ON memberships (expires_at);
+
+CREATE TRIGGER trigger_update_timestamp_memberships
+    BEFORE UPDATE ON memberships
+    FOR EACH ROW
+    EXECUTE FUNCTION update_timestamp();
+
+-- Ежегодные взносы. Сумма хранится в копейках, reference - номер платежа во внешней системе,
+-- по нему повторный импорт не создает дублей
+CREATE TABLE membership_payments
+(
+    id           SERIAL PRIMARY KEY,
+    member_id    UUID        NOT NULL REFERENCES club_members (id) ON DELETE CASCADE,
+    tier         TEXT        NOT NULL
+        CONSTRAINT payment_tier CHECK (tier IN ('basic', 'patron', 'benefactor')),
+    amount       BIGINT      NOT NULL CONSTRAINT payment_amount CHECK (amount > 0),
+    paid_at      TIMESTAMPTZ NOT NULL,
+    period_start TIMESTAMPTZ NOT NULL,
+    period_end   TIMESTAMPTZ NOT NULL,
+    source       TEXT        NOT NULL DEFAULT 'manual'
+        CONSTRAINT payment_source CHECK (source IN ('manual', 'import')),
+    reference    TEXT UNIQUE,
+    note         TEXT        NOT NULL DEFAULT '',
+    created_at   TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT payment_period CHECK (period_end > period_start)
+);
+
+CREATE INDEX membership_payments_member_idx ON membership_payments (member_id, paid_at DESC);
+
+-- Доступ к событиям: min_tier закрывает событие для участников ниже уровня, priority_tier
+-- до priority_until открывает регистрацию только для участников этого уровня и выше
+ALTER TABLE events
+    ADD COLUMN min_tier TEXT
+        CONSTRAINT event_min_tier CHECK (min_tier IN ('basic', 'patron', 'benefactor')),
+    ADD COLUMN priority_tier TEXT
+        CONSTRAINT event_priority_tier CHECK (priority_tier IN ('basic', 'patron', 'benefactor')),
+    ADD COLUMN priority_until TIMESTAMPTZ,
+    ADD CONSTRAINT event_priority_window CHECK ((priority_tier IS NULL) = (priority_until IS NULL));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE events
+    DROP CONSTRAINT IF EXISTS event_priority_window,
+    DROP COLUMN IF EXISTS priority_until,
+    DROP COLUMN IF EXISTS priority_tier,
+    DROP COLUMN IF EXISTS min_tier;
+
+DROP TABLE IF EXISTS membership_payments;
+DROP TRIGGER IF EXISTS trigger_update_timestamp_memberships ON memberships;
+DROP TABLE IF EXISTS memberships;
+DROP FUNCTION IF EXISTS tier_rank(TEXT);
+-- +goose StatementEnd
@@ -0,0 +1,70 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Цена участия в копейках; NULL - бесплатное событие
+ALTER TABLE events
+    ADD COLUMN price BIGINT CONSTRAINT event_price CHECK (price > 0);
+
+-- Регистрация на платное событие держит место в статусе pending_payment до payment_expires_at
+ALTER TABLE registrations
+    DROP CONSTRAINT IF EXISTS registrations_registration_status_check,
+    ADD CONSTRAINT registration_status_variants
+        CHECK (registration_status IN ('registered', 'pending_payment', 'cancelled')),
+    ADD COLUMN payment_expires_at TIMESTAMPTZ;
+
+CREATE INDEX registrations_payment_expires_idx ON registrations (payment_expires_at)
+    WHERE registration_status = 'pending_payment';
+
+-- Платежи за участие. external_id - идентификатор платежа у провайдера, refund_pending -
+-- регистрация отменена до дедлайна и деньги еще не вернулись
+CREATE TABLE event_payments
+(
+    id               SERIAL PRIMARY KEY,
+    registration_id  INTEGER NOT NULL REFERENCES registrations (id) ON DELETE CASCADE,
+    provider         TEXT    NOT NULL,
+    external_id      TEXT UNIQUE,
+    amount           BIGINT  NOT NULL CONSTRAINT event_payment_amount CHECK (amount > 0),
+    status           TEXT    NOT NULL DEFAULT 'pending'
+        CONSTRAINT event_payment_status
+            CHECK (status IN ('pending', 'succeeded', 'canceled', 'refund_pending', 'refunded')),
+    confirmation_url TEXT    NOT NULL DEFAULT '',
+    refund_id        TEXT,
+    created_at       TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at       TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX event_payments_registration_idx ON event_payments (registration_id, created_at DESC);
+CREATE INDEX event_payments_refund_pending_idx ON event_payments (status) WHERE status = 'refund_pending';
+
+CREATE TRIGGER trigger_update_timestamp_event_payments
+    BEFORE UPDATE ON event_payments
+    FOR EACH ROW
+    EXECUTE FUNCTION update_timestamp();
+
+-- Обработанные уведомления провайдера: повторная доставка того же уведомления ничего не меняет
+CREATE TABLE payment_notifications
+(
+    provider    TEXT NOT NULL,
+    key         TEXT NOT NULL,
+    received_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (provider, key)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS payment_notifications;
+DROP TRIGGER IF EXISTS trigger_update_timestamp_event_payments ON event_payments;
+DROP TABLE IF EXISTS event_payments;
+DROP INDEX IF EXISTS registrations_payment_expires_idx;
+
+UPDATE registrations SET registration_status = 'cancelled' WHERE registration_status = 'pending_payment';
+
+ALTER TABLE registrations
+    DROP COLUMN IF EXISTS payment_expires_at,
+    DROP CONSTRAINT IF EXISTS registration_status_variants,
+    ADD CONSTRAINT registrations_registration_status_check
+        CHECK (registration_status IN ('registered', 'cancelled'));
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS price;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Напоминания о событиях. Строка заводится, когда реплика забирает напоминание на отправку;
+-- event_date - дата события на тот момент, поэтому после переноса события напоминания
+-- отправляются заново. sent_at пуст, пока отправка не удалась
+CREATE TABLE event_reminders
+(
+    id              SERIAL PRIMARY KEY,
+    registration_id INTEGER     NOT NULL REFERENCES registrations (id) ON DELETE CASCADE,
+    offset_seconds  BIGINT      NOT NULL,
+    event_date      TIMESTAMPTZ NOT NULL,
+    attempts        INTEGER     NOT NULL DEFAULT 1,
+    claimed_at      TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    sent_at         TIMESTAMPTZ,
+    UNIQUE (registration_id, offset_seconds, event_date)
+);
+
+CREATE INDEX event_reminders_unsent_idx ON event_reminders (claimed_at) WHERE sent_at IS NULL;
+CREATE INDEX events_event_date_idx ON events (event_date);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS events_event_date_idx;
+DROP TABLE IF EXISTS event_reminders;
+-- +goose StatementEnd
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Очередь фоновых задач. Реплики забирают задачи через FOR UPDATE SKIP LOCKED; задача,
+-- исчерпавшая попытки, остается в статусе dead до ручного перезапуска. dedupe_key не дает
+-- нескольким репликам поставить одно и то же срабатывание расписания дважды
+CREATE TABLE jobs
+(
+    id           BIGSERIAL PRIMARY KEY,
+    kind         TEXT        NOT NULL,
+    payload      JSONB       NOT NULL DEFAULT '{}',
+    status       TEXT        NOT NULL DEFAULT 'pending'
+        CONSTRAINT job_status CHECK (status IN ('pending', 'running', 'succeeded', 'dead')),
+    attempts     INTEGER     NOT NULL DEFAULT 0,
+    max_attempts INTEGER     NOT NULL CONSTRAINT job_max_attempts CHECK (max_attempts > 0),
+    run_at       TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    dedupe_key   TEXT UNIQUE,
+    locked_by    TEXT,
+    locked_at    TIMESTAMPTZ,
+    last_error   TEXT,
+    finished_at  TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at   TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX jobs_pending_idx ON jobs (run_at) WHERE status = 'pending';
+CREATE INDEX jobs_running_idx ON jobs (locked_at) WHERE status = 'running';
+CREATE INDEX jobs_status_idx ON jobs (status, kind, updated_at DESC);
+
+CREATE TRIGGER trigger_update_timestamp_jobs
+    BEFORE UPDATE ON jobs
+    FOR EACH ROW
+    EXECUTE FUNCTION update_timestamp();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS jobs;
+-- +goose StatementEnd
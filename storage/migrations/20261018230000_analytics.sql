@@ -0,0 +1,99 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Вехи события, нужные для аналитики. Хранятся отдельно от events, чтобы не менять версию
+-- события и не упираться в проверку даты у прошедших событий
+CREATE TABLE event_milestones
+(
+    event_id               INTEGER PRIMARY KEY REFERENCES events (id) ON DELETE CASCADE,
+    sold_out_at            TIMESTAMPTZ,
+    attendance_recorded_at TIMESTAMPTZ
+);
+
+-- Посещаемость отмечается после события; NULL - посещаемость не отмечена
+ALTER TABLE registrations ADD COLUMN attended BOOLEAN;
+
+-- Момент решения по заявке в клуб; у импортированных сразу с решением - момент импорта.
+-- У решений, принятых до этой миграции, момент неизвестен и остается NULL: updated_at
+-- меняется при любом изменении профиля и моментом решения не является
+ALTER TABLE club_members ADD COLUMN decided_at TIMESTAMPTZ;
+
+CREATE OR REPLACE FUNCTION set_decided_at()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' OR NEW.status IS DISTINCT FROM OLD.status THEN
+        NEW.decided_at = CASE WHEN NEW.status = 'pending' THEN NULL ELSE CURRENT_TIMESTAMP END;
+    END IF;
+RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_set_decided_at
+    BEFORE INSERT OR UPDATE OF status ON club_members
+    FOR EACH ROW
+    EXECUTE FUNCTION set_decided_at();
+
+-- Событие распродано, когда занято последнее место; отмены после этого момент не сбрасывают
+CREATE OR REPLACE FUNCTION mark_event_sold_out()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF (SELECT COUNT(*) FROM registrations
+        WHERE event_id = NEW.event_id AND registration_status IN ('registered', 'pending_payment'))
+       >= (SELECT capacity FROM events WHERE id = NEW.event_id) THEN
+        INSERT INTO event_milestones (event_id, sold_out_at)
+        VALUES (NEW.event_id, CURRENT_TIMESTAMP)
+        ON CONFLICT (event_id) DO UPDATE SET sold_out_at = EXCLUDED.sold_out_at
+        WHERE event_milestones.sold_out_at IS NULL;
+    END IF;
+RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_mark_event_sold_out
+    AFTER INSERT OR UPDATE OF registration_status ON registrations
+    FOR EACH ROW
+    WHEN (NEW.registration_status <> 'cancelled')
+    EXECUTE FUNCTION mark_event_sold_out();
+
+INSERT INTO event_milestones (event_id, sold_out_at)
+SELECT e.id, MAX(r.updated_at)
+FROM events e
+JOIN registrations r ON r.event_id = e.id AND r.registration_status IN ('registered', 'pending_payment')
+GROUP BY e.id, e.capacity
+HAVING COUNT(*) >= e.capacity;
+
+CREATE INDEX registrations_event_status_idx ON registrations (event_id, registration_status);
+CREATE INDEX club_members_created_at_idx ON club_members (created_at);
+CREATE INDEX club_members_decided_at_idx ON club_members (decided_at) WHERE decided_at IS NOT NULL;
+
+-- Итоги регистраций по событиям. Обновляется фоновой задачей analytics.refresh
+CREATE MATERIALIZED VIEW event_stats AS
+SELECT e.id                                                                               AS event_id,
+       COUNT(r.id) FILTER (WHERE r.registration_status = 'registered')                    AS registered,
+       COUNT(r.id) FILTER (WHERE r.registration_status = 'pending_payment')               AS pending,
+       COUNT(r.id) FILTER (WHERE r.registration_status = 'cancelled')                     AS cancelled,
+       COUNT(r.id) FILTER (WHERE r.registration_status = 'registered' AND r.attended)     AS attended,
+       COUNT(r.id) FILTER (WHERE r.registration_status = 'registered' AND NOT r.attended) AS no_show
+FROM events e
+LEFT JOIN registrations r ON r.event_id = e.id
+GROUP BY e.id;
+
+CREATE UNIQUE INDEX event_stats_event_id_idx ON event_stats (event_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP MATERIALIZED VIEW IF EXISTS event_stats;
+
+DROP INDEX IF EXISTS club_members_decided_at_idx;
+DROP INDEX IF EXISTS club_members_created_at_idx;
+DROP INDEX IF EXISTS registrations_event_status_idx;
+
+DROP TRIGGER IF EXISTS trigger_mark_event_sold_out ON registrations;
+DROP FUNCTION IF EXISTS mark_event_sold_out();
+DROP TRIGGER IF EXISTS trigger_set_decided_at ON club_members;
+DROP FUNCTION IF EXISTS set_decided_at();
+
+ALTER TABLE club_members DROP COLUMN IF EXISTS decided_at;
+ALTER TABLE registrations DROP COLUMN IF EXISTS attended;
+DROP TABLE IF EXISTS event_milestones;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Момент последней регистрации на место. created_at остается от первой регистрации, а при
+-- сокращении мест первыми снимаются те, кто зарегистрировался позже
+ALTER TABLE registrations ADD COLUMN registered_at TIMESTAMPTZ;
+
+-- Момент, когда регистрацию сняли из-за сокращения мест; NULL - не снимали
+ALTER TABLE registrations ADD COLUMN bumped_at TIMESTAMPTZ;
+
+ALTER TABLE registrations DISABLE TRIGGER USER;
+UPDATE registrations SET registered_at = created_at;
+ALTER TABLE registrations ENABLE TRIGGER USER;
+
+ALTER TABLE registrations ALTER COLUMN registered_at SET DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE registrations ALTER COLUMN registered_at SET NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE registrations DROP COLUMN IF EXISTS bumped_at;
+ALTER TABLE registrations DROP COLUMN IF EXISTS registered_at;
+-- +goose StatementEnd
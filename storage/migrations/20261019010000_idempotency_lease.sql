@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Срок, до которого первый запрос держит ключ; после него ключ без ответа может занять повтор,
+-- если реплика, обрабатывавшая запрос, упала
+ALTER TABLE idempotency_keys ADD COLUMN locked_until TIMESTAMPTZ;
+
+-- Заголовки Location и ETag сохраненного ответа
+ALTER TABLE idempotency_keys ADD COLUMN location TEXT NOT NULL DEFAULT '';
+ALTER TABLE idempotency_keys ADD COLUMN etag TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS etag;
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS location;
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS locked_until;
+-- +goose StatementEnd
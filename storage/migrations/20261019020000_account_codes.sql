@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Коды из письма, которыми участник подтверждает удаление или выгрузку своих данных;
+-- не больше одного действующего кода на участника для каждого назначения
+ALTER TABLE erasure_requests RENAME TO account_codes;
+ALTER TABLE account_codes RENAME CONSTRAINT erasure_requests_member_id_fkey TO account_codes_member_id_fkey;
+
+ALTER TABLE account_codes ADD COLUMN purpose TEXT NOT NULL DEFAULT 'erasure';
+ALTER TABLE account_codes ALTER COLUMN purpose DROP DEFAULT;
+
+ALTER TABLE account_codes DROP CONSTRAINT erasure_requests_pkey;
+ALTER TABLE account_codes ADD CONSTRAINT account_codes_pkey PRIMARY KEY (member_id, purpose);
+
+-- Коды хешируются с секретом сервера; старые хеши без секрета больше не проверить
+DELETE FROM account_codes;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM account_codes WHERE purpose <> 'erasure';
+
+ALTER TABLE account_codes DROP CONSTRAINT account_codes_pkey;
+ALTER TABLE account_codes ADD CONSTRAINT erasure_requests_pkey PRIMARY KEY (member_id);
+ALTER TABLE account_codes DROP COLUMN purpose;
+
+ALTER TABLE account_codes RENAME CONSTRAINT account_codes_member_id_fkey TO erasure_requests_member_id_fkey;
+ALTER TABLE account_codes RENAME TO erasure_requests;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Коды подтверждения теперь хешируются с секретом сервера; выданные раньше коды больше не
+-- проверить, участники запросят новые
+DELETE FROM verification_codes;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 1;
+-- +goose StatementEnd